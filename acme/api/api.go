@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -33,9 +34,30 @@ type Core struct {
 	Orders         *OrderService
 }
 
+// Option configures a Core.
+type Option func(*options)
+
+type options struct {
+	doer []sender.DoerOption
+}
+
+// WithHTTPDebug logs the HTTP exchanges with the ACME server at the debug level.
+// The JWS are decoded, and the secrets (keys, signatures, EAB HMAC, bearer tokens, CSR) are redacted.
+// If transcript is not nil, each exchange is also written to it as a JSON line.
+func WithHTTPDebug(transcript io.Writer) Option {
+	return func(o *options) {
+		o.doer = append(o.doer, sender.WithDebug(transcript))
+	}
+}
+
 // New Creates a new Core.
-func New(httpClient *http.Client, userAgent, caDirURL, kid string, privateKey crypto.PrivateKey) (*Core, error) {
-	doer := sender.NewDoer(httpClient, userAgent)
+func New(httpClient *http.Client, userAgent, caDirURL, kid string, privateKey crypto.PrivateKey, opts ...Option) (*Core, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	doer := sender.NewDoer(httpClient, userAgent, o.doer...)
 
 	dir, err := getDirectory(doer, caDirURL)
 	if err != nil {
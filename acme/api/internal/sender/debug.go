@@ -0,0 +1,405 @@
+package sender
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/log"
+)
+
+const redacted = "[REDACTED]"
+
+// Headers with a sensitive value.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// JSON fields with a sensitive value.
+// - "jwk"/"oldKey": account keys (inside the JWS protected headers, or inside the payload of the key rollover).
+// - "signature": JWS signatures (and the HMAC of the External Account Binding).
+// - "mac_key"/"hmac": EAB HMAC keys.
+var sensitiveFields = []string{"jwk", "oldKey", "signature", "mac_key", "hmac"}
+
+// DebugTransport is an http.RoundTripper that logs the HTTP exchanges with the ACME server.
+// The JWS are decoded and the secrets (keys, signatures, EAB HMAC, bearer tokens) are redacted.
+// If a transcript is defined, each exchange is also written to it as a JSON line.
+type DebugTransport struct {
+	next       http.RoundTripper
+	transcript io.Writer
+	mu         sync.Mutex
+}
+
+// NewDebugTransport creates a new DebugTransport.
+// If next is nil, http.DefaultTransport is used.
+func NewDebugTransport(next http.RoundTripper, transcript io.Writer) *DebugTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &DebugTransport{next: next, transcript: transcript}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	entry := transcriptEntry{
+		Time: time.Now().UTC(),
+		Request: transcriptRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: redactHeaders(req.Header),
+			Body:    decodeRequestBody(reqBody),
+		},
+	}
+
+	log.Debug(fmt.Sprintf("acme: HTTP request\n--> %s %s\n%s", req.Method, req.URL, dumpBody(entry.Request.Headers, entry.Request.Body)))
+
+	start := time.Now()
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+		log.Debug(fmt.Sprintf("acme: HTTP error\n<-- %s %s: %v", req.Method, req.URL, err))
+		t.write(entry)
+
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	entry.Response = &transcriptResponse{
+		Status:   resp.StatusCode,
+		Headers:  redactHeaders(resp.Header),
+		Body:     decodeResponseBody(respBody),
+		Duration: time.Since(start).String(),
+	}
+
+	log.Debug(fmt.Sprintf("acme: HTTP response\n<-- %s %s %s (%s)\n%s",
+		resp.Status, req.Method, req.URL, entry.Response.Duration, dumpBody(entry.Response.Headers, entry.Response.Body)))
+
+	t.write(entry)
+
+	return resp, nil
+}
+
+func (t *DebugTransport) write(entry transcriptEntry) {
+	if t.transcript == nil {
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Warnf("acme: unable to marshal the HTTP transcript entry: %v", err)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, err = t.transcript.Write(append(data, '\n'))
+	if err != nil {
+		log.Warnf("acme: unable to write the HTTP transcript: %v", err)
+	}
+}
+
+// transcriptEntry is an HTTP exchange written to the transcript.
+type transcriptEntry struct {
+	Time     time.Time           `json:"time"`
+	Request  transcriptRequest   `json:"request"`
+	Response *transcriptResponse `json:"response,omitempty"`
+	Error    string              `json:"error,omitempty"`
+}
+
+type transcriptRequest struct {
+	Method  string              `json:"method"`
+	URL     string              `json:"url"`
+	Headers map[string][]string `json:"headers,omitempty"`
+	Body    any                 `json:"body,omitempty"`
+}
+
+type transcriptResponse struct {
+	Status   int                 `json:"status"`
+	Headers  map[string][]string `json:"headers,omitempty"`
+	Body     any                 `json:"body,omitempty"`
+	Duration string              `json:"duration"`
+}
+
+// decodedJWS is a JWS (flattened JSON serialization) with decoded protected header and payload.
+type decodedJWS struct {
+	Protected any    `json:"protected"`
+	Payload   any    `json:"payload"`
+	Signature string `json:"signature"`
+}
+
+func redactHeaders(headers http.Header) map[string][]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]string, len(headers))
+
+	for k, values := range headers {
+		result[k] = append([]string(nil), values...)
+	}
+
+	for _, name := range sensitiveHeaders {
+		values, ok := result[http.CanonicalHeaderKey(name)]
+		if !ok {
+			continue
+		}
+
+		for i, value := range values {
+			scheme, _, found := strings.Cut(value, " ")
+			if found {
+				values[i] = scheme + " " + redacted
+			} else {
+				values[i] = redacted
+			}
+		}
+	}
+
+	return result
+}
+
+func decodeRequestBody(body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var raw map[string]any
+	err := json.Unmarshal(body, &raw)
+	if err != nil {
+		return string(body)
+	}
+
+	if jws, ok := decodeJWS(raw); ok {
+		return jws
+	}
+
+	return redactJSON(raw)
+}
+
+func decodeResponseBody(body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var raw any
+	err := json.Unmarshal(body, &raw)
+	if err != nil {
+		// certificates (PEM), etc.
+		return string(body)
+	}
+
+	return redactJSON(raw)
+}
+
+// decodeJWS decodes a JWS (flattened JSON serialization).
+// The payload can contain another JWS (External Account Binding, key rollover).
+func decodeJWS(raw map[string]any) (*decodedJWS, bool) {
+	protectedB64, ok := raw["protected"].(string)
+	if !ok {
+		return nil, false
+	}
+
+	// The payload can be omitted for POST-as-GET requests.
+	payloadB64, _ := raw["payload"].(string)
+
+	if _, ok = raw["signature"].(string); !ok {
+		return nil, false
+	}
+
+	jws := &decodedJWS{Signature: redacted}
+
+	protected, err := base64.RawURLEncoding.DecodeString(protectedB64)
+	if err != nil {
+		return nil, false
+	}
+
+	var header any
+	if err = json.Unmarshal(protected, &header); err != nil {
+		return nil, false
+	}
+
+	jws.Protected = redactJSON(header)
+
+	payload, err := base64.RawURLEncoding.DecodeString(payloadB64)
+	if err != nil {
+		return nil, false
+	}
+
+	jws.Payload = decodePayload(payload)
+
+	return jws, true
+}
+
+func decodePayload(payload []byte) any {
+	if len(payload) == 0 {
+		// POST-as-GET
+		return ""
+	}
+
+	var content any
+	if err := json.Unmarshal(payload, &content); err != nil {
+		return redacted
+	}
+
+	obj, ok := content.(map[string]any)
+	if !ok {
+		return redactJSON(content)
+	}
+
+	for k, v := range obj {
+		switch k {
+		case "externalAccountBinding":
+			inner, isObj := v.(map[string]any)
+			if !isObj {
+				obj[k] = redacted
+				continue
+			}
+
+			if jws, isJWS := decodeJWS(inner); isJWS {
+				// The payload of the EAB is the account key.
+				jws.Payload = redacted
+				obj[k] = jws
+			} else {
+				obj[k] = redacted
+			}
+
+		case "csr":
+			obj[k] = describeCSR(v)
+
+		default:
+			if inner, isObj := v.(map[string]any); isObj {
+				if jws, isJWS := decodeJWS(inner); isJWS {
+					obj[k] = jws
+					continue
+				}
+			}
+
+			obj[k] = redactJSON(v)
+		}
+	}
+
+	return obj
+}
+
+// describeCSR replaces the CSR by its identifiers.
+func describeCSR(v any) any {
+	s, ok := v.(string)
+	if !ok {
+		return redacted
+	}
+
+	der, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return redacted
+	}
+
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return redacted
+	}
+
+	var ips []string
+	for _, ip := range csr.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+
+	return map[string]any{
+		"subject":   csr.Subject.String(),
+		"dnsNames":  csr.DNSNames,
+		"ips":       ips,
+		"publicKey": csr.PublicKeyAlgorithm.String() + " " + redacted,
+	}
+}
+
+func redactJSON(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for k, child := range value {
+			if isSensitiveField(k) {
+				value[k] = redacted
+				continue
+			}
+
+			value[k] = redactJSON(child)
+		}
+
+		return value
+
+	case []any:
+		for i, child := range value {
+			value[i] = redactJSON(child)
+		}
+
+		return value
+
+	default:
+		return value
+	}
+}
+
+func isSensitiveField(name string) bool {
+	for _, field := range sensitiveFields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func dumpBody(headers map[string][]string, body any) string {
+	var b strings.Builder
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(&b, "%s: %s\n", k, strings.Join(headers[k], ", "))
+	}
+
+	switch value := body.(type) {
+	case nil:
+	case string:
+		b.WriteString("\n" + value)
+	default:
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			b.WriteString("\n" + fmt.Sprint(value))
+		} else {
+			b.WriteString("\n" + string(data))
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
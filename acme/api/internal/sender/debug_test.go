@@ -0,0 +1,170 @@
+package sender
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugTransport(t *testing.T) {
+	var received []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		received, _ = io.ReadAll(req.Body)
+
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("Set-Cookie", "session=secret")
+		_, _ = rw.Write([]byte(`{"status":"valid","key":{"jwk":{"kty":"EC"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	hmac := []byte("this-is-a-very-secret-hmac-value")
+
+	eab := signJWS(t, jose.SigningKey{Algorithm: jose.HS256, Key: hmac},
+		map[jose.HeaderKey]any{"kid": "eab-kid", "url": server.URL},
+		mustMarshal(t, jose.JSONWebKey{Key: privateKey.Public()}))
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: []string{"example.com"}}, privateKey)
+	require.NoError(t, err)
+
+	payload := mustMarshal(t, map[string]any{
+		"termsOfServiceAgreed":   true,
+		"externalAccountBinding": json.RawMessage(eab),
+		"csr":                    base64.RawURLEncoding.EncodeToString(csr),
+	})
+
+	body := signJWS(t, jose.SigningKey{Algorithm: jose.ES256, Key: privateKey},
+		map[jose.HeaderKey]any{"jwk": jose.JSONWebKey{Key: privateKey.Public()}, "nonce": "abc", "url": server.URL},
+		payload)
+
+	transcript := &bytes.Buffer{}
+
+	doer := NewDoer(http.DefaultClient, "", WithDebug(transcript))
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer my-token")
+
+	resp, err := doer.httpClient.Do(req)
+	require.NoError(t, err)
+
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// The exchange is not altered.
+	assert.Equal(t, body, received)
+	assert.JSONEq(t, `{"status":"valid","key":{"jwk":{"kty":"EC"}}}`, string(respBody))
+
+	line := transcript.String()
+	require.True(t, strings.HasSuffix(line, "\n"))
+	require.Equal(t, 1, strings.Count(line, "\n"))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal([]byte(line), &entry))
+
+	// Secrets.
+	assert.NotContains(t, line, "my-token")
+	assert.NotContains(t, line, "session=secret")
+	assert.NotContains(t, line, base64.RawURLEncoding.EncodeToString(hmac))
+	assert.NotContains(t, line, base64.RawURLEncoding.EncodeToString(csr))
+	assert.NotContains(t, line, base64.RawURLEncoding.EncodeToString(privateKey.X.Bytes()))
+	assert.NotContains(t, line, `"kty"`)
+
+	request := entry["request"].(map[string]any)
+	assert.Equal(t, http.MethodPost, request["method"])
+	assert.Equal(t, []any{"Bearer [REDACTED]"}, request["headers"].(map[string]any)["Authorization"])
+
+	jws := request["body"].(map[string]any)
+	assert.Equal(t, redacted, jws["signature"])
+
+	protected := jws["protected"].(map[string]any)
+	assert.Equal(t, "ES256", protected["alg"])
+	assert.Equal(t, "abc", protected["nonce"])
+	assert.Equal(t, redacted, protected["jwk"])
+
+	decoded := jws["payload"].(map[string]any)
+	assert.Equal(t, true, decoded["termsOfServiceAgreed"])
+	assert.Equal(t, []any{"example.com"}, decoded["csr"].(map[string]any)["dnsNames"])
+
+	eabDecoded := decoded["externalAccountBinding"].(map[string]any)
+	assert.Equal(t, redacted, eabDecoded["signature"])
+	assert.Equal(t, "eab-kid", eabDecoded["protected"].(map[string]any)["kid"])
+
+	response := entry["response"].(map[string]any)
+	assert.InDelta(t, http.StatusOK, response["status"], 0)
+	assert.Equal(t, []any{redacted}, response["headers"].(map[string]any)["Set-Cookie"])
+	assert.Equal(t, redacted, response["body"].(map[string]any)["key"].(map[string]any)["jwk"])
+}
+
+func TestDebugTransport_postAsGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte("-----BEGIN CERTIFICATE-----"))
+	}))
+	t.Cleanup(server.Close)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	body := signJWS(t, jose.SigningKey{Algorithm: jose.ES256, Key: privateKey},
+		map[jose.HeaderKey]any{"kid": "https://example.com/acct/1", "url": server.URL}, nil)
+
+	transcript := &bytes.Buffer{}
+
+	doer := NewDoer(http.DefaultClient, "", WithDebug(transcript))
+
+	_, err = doer.Post(server.URL, bytes.NewReader(body), "application/jose+json", nil)
+	require.NoError(t, err)
+
+	var entry struct {
+		Request struct {
+			Body decodedJWS `json:"body"`
+		} `json:"request"`
+		Response struct {
+			Body string `json:"body"`
+		} `json:"response"`
+	}
+	require.NoError(t, json.Unmarshal(transcript.Bytes(), &entry))
+
+	assert.Equal(t, "", entry.Request.Body.Payload)
+	assert.Equal(t, "-----BEGIN CERTIFICATE-----", entry.Response.Body)
+}
+
+func signJWS(t *testing.T, key jose.SigningKey, headers map[jose.HeaderKey]any, payload []byte) []byte {
+	t.Helper()
+
+	signer, err := jose.NewSigner(key, &jose.SignerOptions{ExtraHeaders: headers})
+	require.NoError(t, err)
+
+	signed, err := signer.Sign(payload)
+	require.NoError(t, err)
+
+	return []byte(signed.FullSerialize())
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return data
+}
@@ -25,12 +25,31 @@ type Doer struct {
 	userAgent  string
 }
 
+// DoerOption configures a Doer.
+type DoerOption func(*Doer)
+
+// WithDebug enables the debug of the HTTP exchanges (see DebugTransport).
+// If transcript is not nil, each exchange is also written to it as a JSON line.
+func WithDebug(transcript io.Writer) DoerOption {
+	return func(d *Doer) {
+		client := *d.httpClient
+		client.Transport = NewDebugTransport(client.Transport, transcript)
+		d.httpClient = &client
+	}
+}
+
 // NewDoer Creates a new Doer.
-func NewDoer(client *http.Client, userAgent string) *Doer {
-	return &Doer{
+func NewDoer(client *http.Client, userAgent string, opts ...DoerOption) *Doer {
+	d := &Doer{
 		httpClient: client,
 		userAgent:  userAgent,
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 // Get performs a GET request with a proper User-Agent string.
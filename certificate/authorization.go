@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/log"
 )
 
@@ -39,7 +40,7 @@ func (c *Certifier) getAuthorizations(order acme.ExtendedOrder) ([]acme.Authoriz
 	}
 
 	for i, auth := range order.Authorizations {
-		log.Infof("[%s] AuthURL: %s", order.Identifiers[i].Value, auth)
+		log.Info("AuthURL: "+auth, log.Domain(order.Identifiers[i].Value), log.AuthzURL(auth), log.OrderURL(order.Location))
 	}
 
 	close(resc)
@@ -52,18 +53,18 @@ func (c *Certifier) deactivateAuthorizations(order acme.ExtendedOrder, force boo
 	for _, authzURL := range order.Authorizations {
 		auth, err := c.core.Authorizations.Get(authzURL)
 		if err != nil {
-			log.Infof("Unable to get the authorization for: %s", authzURL)
+			log.Info("Unable to get the authorization for: "+authzURL, log.AuthzURL(authzURL))
 			continue
 		}
 
 		if auth.Status == acme.StatusValid && !force {
-			log.Infof("Skipping deactivating of valid auth: %s", authzURL)
+			log.Info("Skipping deactivating of valid auth: "+authzURL, log.Domain(challenge.GetTargetedDomain(auth)), log.AuthzURL(authzURL))
 			continue
 		}
 
-		log.Infof("Deactivating auth: %s", authzURL)
+		log.Info("Deactivating auth: "+authzURL, log.Domain(challenge.GetTargetedDomain(auth)), log.AuthzURL(authzURL))
 		if c.core.Authorizations.Deactivate(authzURL) != nil {
-			log.Infof("Unable to deactivate the authorization: %s", authzURL)
+			log.Warn("Unable to deactivate the authorization: "+authzURL, log.Domain(challenge.GetTargetedDomain(auth)), log.AuthzURL(authzURL))
 		}
 	}
 }
@@ -118,6 +118,12 @@ type resolver interface {
 	Solve(authorizations []acme.Authorization) error
 }
 
+// fallbackResolver is a resolver that can retry the failed authorizations with another challenge type.
+// renew is called to get fresh authorizations before a retry.
+type fallbackResolver interface {
+	SolveWithFallback(authorizations []acme.Authorization, renew func() ([]acme.Authorization, error)) error
+}
+
 type CertifierOptions struct {
 	KeyType             certcrypto.KeyType
 	Timeout             time.Duration
@@ -160,9 +166,9 @@ func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
 	domains := sanitizeDomain(request.Domains)
 
 	if request.Bundle {
-		log.Infof("[%s] acme: Obtaining bundled SAN certificate", strings.Join(domains, ", "))
+		log.Info("acme: Obtaining bundled SAN certificate", log.Domain(strings.Join(domains, ", ")))
 	} else {
-		log.Infof("[%s] acme: Obtaining SAN certificate", strings.Join(domains, ", "))
+		log.Info("acme: Obtaining SAN certificate", log.Domain(strings.Join(domains, ", ")))
 	}
 
 	orderOpts := &api.OrderOptions{
@@ -184,14 +190,14 @@ func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
 		return nil, err
 	}
 
-	err = c.resolver.Solve(authz)
+	err = c.solve(&order, authz, domains, orderOpts)
 	if err != nil {
 		// If any challenge fails, return. Do not generate partial SAN certificates.
 		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
-	log.Infof("[%s] acme: Validations succeeded; requesting certificates", strings.Join(domains, ", "))
+	log.Info("acme: Validations succeeded; requesting certificates", log.Domain(strings.Join(domains, ", ")), log.OrderURL(order.Location))
 
 	failures := newObtainError()
 	cert, err := c.getForOrder(domains, order, request.Bundle, request.PrivateKey, request.MustStaple, request.PreferredChain)
@@ -227,9 +233,9 @@ func (c *Certifier) ObtainForCSR(request ObtainForCSRRequest) (*Resource, error)
 	domains := certcrypto.ExtractDomainsCSR(request.CSR)
 
 	if request.Bundle {
-		log.Infof("[%s] acme: Obtaining bundled SAN certificate given a CSR", strings.Join(domains, ", "))
+		log.Info("acme: Obtaining bundled SAN certificate given a CSR", log.Domain(strings.Join(domains, ", ")))
 	} else {
-		log.Infof("[%s] acme: Obtaining SAN certificate given a CSR", strings.Join(domains, ", "))
+		log.Info("acme: Obtaining SAN certificate given a CSR", log.Domain(strings.Join(domains, ", ")))
 	}
 
 	orderOpts := &api.OrderOptions{
@@ -251,14 +257,14 @@ func (c *Certifier) ObtainForCSR(request ObtainForCSRRequest) (*Resource, error)
 		return nil, err
 	}
 
-	err = c.resolver.Solve(authz)
+	err = c.solve(&order, authz, domains, orderOpts)
 	if err != nil {
 		// If any challenge fails, return. Do not generate partial SAN certificates.
 		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
-	log.Infof("[%s] acme: Validations succeeded; requesting certificates", strings.Join(domains, ", "))
+	log.Info("acme: Validations succeeded; requesting certificates", log.Domain(strings.Join(domains, ", ")), log.OrderURL(order.Location))
 
 	failures := newObtainError()
 	cert, err := c.getForCSR(domains, order, request.Bundle, request.CSR.Raw, nil, request.PreferredChain)
@@ -280,6 +286,32 @@ func (c *Certifier) ObtainForCSR(request ObtainForCSRRequest) (*Resource, error)
 	return cert, failures.Join()
 }
 
+// solve solves the authorizations of the order.
+// If the resolver supports it, the failed authorizations are retried with another challenge type:
+// as the order is invalid after a failed challenge, a new order is created, and replaces the current one.
+func (c *Certifier) solve(order *acme.ExtendedOrder, authz []acme.Authorization, domains []string, orderOpts *api.OrderOptions) error {
+	fr, ok := c.resolver.(fallbackResolver)
+	if !ok {
+		return c.resolver.Solve(authz)
+	}
+
+	return fr.SolveWithFallback(authz, func() ([]acme.Authorization, error) {
+		// Deactivates the remaining pending authorizations of the invalid order.
+		c.deactivateAuthorizations(*order, false)
+
+		newOrder, err := c.core.Orders.NewWithOptions(domains, orderOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("acme: New order to retry the failed authorizations", log.Domain(strings.Join(domains, ", ")), log.OrderURL(newOrder.Location))
+
+		*order = newOrder
+
+		return c.getAuthorizations(newOrder)
+	})
+}
+
 func (c *Certifier) getForOrder(domains []string, order acme.ExtendedOrder, bundle bool, privateKey crypto.PrivateKey, mustStaple bool, preferredChain string) (*Resource, error) {
 	if privateKey == nil {
 		var err error
@@ -393,7 +425,7 @@ func (c *Certifier) checkResponse(order acme.ExtendedOrder, certRes *Resource, b
 	certRes.CertStableURL = order.Certificate
 
 	if preferredChain == "" {
-		log.Infof("[%s] Server responded with a certificate.", certRes.Domain)
+		log.Info("Server responded with a certificate.", log.Domain(certRes.Domain))
 
 		return true, nil
 	}
@@ -405,7 +437,7 @@ func (c *Certifier) checkResponse(order acme.ExtendedOrder, certRes *Resource, b
 		}
 
 		if ok {
-			log.Infof("[%s] Server responded with a certificate for the preferred certificate chains %q.", certRes.Domain, preferredChain)
+			log.Info(fmt.Sprintf("Server responded with a certificate for the preferred certificate chains %q.", preferredChain), log.Domain(certRes.Domain))
 
 			certRes.IssuerCertificate = cert.Issuer
 			certRes.Certificate = cert.Cert
@@ -504,7 +536,7 @@ func (c *Certifier) RenewWithOptions(certRes Resource, options *RenewOptions) (*
 
 	// This is just meant to be informal for the user.
 	timeLeft := x509Cert.NotAfter.Sub(time.Now().UTC())
-	log.Infof("[%s] acme: Trying renewal with %d hours remaining", certRes.Domain, int(timeLeft.Hours()))
+	log.Info(fmt.Sprintf("acme: Trying renewal with %d hours remaining", int(timeLeft.Hours())), log.Domain(certRes.Domain))
 
 	// We always need to request a new certificate to renew.
 	// Start by checking to see if the certificate was based off a CSR,
@@ -3,8 +3,10 @@ package dns01
 import (
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -75,7 +77,7 @@ func NewChallenge(core *api.Core, validate ValidateFunc, provider challenge.Prov
 // It does not validate record propagation, or do anything at all with the acme server.
 func (c *Challenge) PreSolve(authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
-	log.Infof("[%s] acme: Preparing to solve DNS-01", domain)
+	log.Info("acme: Preparing to solve DNS-01", log.Domain(domain), log.ChallengeType(string(challenge.DNS01)))
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -102,7 +104,7 @@ func (c *Challenge) PreSolve(authz acme.Authorization) error {
 
 func (c *Challenge) Solve(authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
-	log.Infof("[%s] acme: Trying to solve DNS-01", domain)
+	log.Info("acme: Trying to solve DNS-01", log.Domain(domain), log.ChallengeType(string(challenge.DNS01)))
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -117,22 +119,16 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 
 	info := GetChallengeInfo(authz.Identifier.Value, keyAuth)
 
-	var timeout, interval time.Duration
-	switch provider := c.provider.(type) {
-	case challenge.ProviderTimeout:
-		timeout, interval = provider.Timeout()
-	default:
-		timeout, interval = DefaultPropagationTimeout, DefaultPollingInterval
-	}
+	timeout, interval := c.propagationTimeout()
 
-	log.Infof("[%s] acme: Checking DNS record propagation. [nameservers=%s]", domain, strings.Join(recursiveNameservers, ","))
+	log.Info(fmt.Sprintf("acme: Checking DNS record propagation. [nameservers=%s]", strings.Join(recursiveNameservers, ",")), log.Domain(domain), log.ChallengeType(string(challenge.DNS01)))
 
 	time.Sleep(interval)
 
 	err = wait.For("propagation", timeout, interval, func() (bool, error) {
 		stop, errP := c.preCheck.call(domain, info.EffectiveFQDN, info.Value)
 		if !stop || errP != nil {
-			log.Infof("[%s] acme: Waiting for DNS record propagation.", domain)
+			log.Info("acme: Waiting for DNS record propagation.", log.Domain(domain), log.ChallengeType(string(challenge.DNS01)))
 		}
 		return stop, errP
 	})
@@ -144,9 +140,94 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 	return c.validate(c.core, domain, chlng)
 }
 
+// SolveBatch waits for the propagation of the TXT records of several authorizations,
+// with one wait for all the records, then validates the challenges.
+// It returns the errors by domain.
+func (c *Challenge) SolveBatch(authzs []acme.Authorization) map[string]error {
+	type batchChallenge struct {
+		domain string
+		chlng  acme.Challenge
+		info   ChallengeInfo
+	}
+
+	failures := make(map[string]error)
+
+	var challenges []batchChallenge
+
+	for _, authz := range authzs {
+		domain := challenge.GetTargetedDomain(authz)
+		log.Info("acme: Trying to solve DNS-01 (batch)", log.Domain(domain), log.ChallengeType(string(challenge.DNS01)))
+
+		chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
+		if err != nil {
+			failures[domain] = err
+			continue
+		}
+
+		keyAuth, err := c.core.GetKeyAuthorization(chlng.Token)
+		if err != nil {
+			failures[domain] = err
+			continue
+		}
+
+		chlng.KeyAuthorization = keyAuth
+
+		challenges = append(challenges, batchChallenge{domain: domain, chlng: chlng, info: GetChallengeInfo(authz.Identifier.Value, keyAuth)})
+	}
+
+	if len(challenges) == 0 {
+		return failures
+	}
+
+	timeout, interval := c.propagationTimeout()
+
+	log.Infof("acme: Checking DNS record propagation of %d records. [nameservers=%s]", len(challenges), strings.Join(recursiveNameservers, ","))
+
+	time.Sleep(interval)
+
+	pending := slices.Clone(challenges)
+
+	err := wait.For("propagation", timeout, interval, func() (bool, error) {
+		var errs []error
+
+		pending = slices.DeleteFunc(pending, func(bc batchChallenge) bool {
+			stop, errP := c.preCheck.call(bc.domain, bc.info.EffectiveFQDN, bc.info.Value)
+			if !stop || errP != nil {
+				log.Info("acme: Waiting for DNS record propagation.", log.Domain(bc.domain), log.ChallengeType(string(challenge.DNS01)))
+			}
+
+			if errP != nil {
+				errs = append(errs, errP)
+			}
+
+			return stop
+		})
+
+		return len(pending) == 0, errors.Join(errs...)
+	})
+	if err != nil {
+		for _, bc := range pending {
+			failures[bc.domain] = err
+		}
+	}
+
+	for _, bc := range challenges {
+		if failures[bc.domain] != nil {
+			continue
+		}
+
+		err := c.validate(c.core, bc.domain, bc.chlng)
+		if err != nil {
+			failures[bc.domain] = err
+		}
+	}
+
+	return failures
+}
+
 // CleanUp cleans the challenge.
 func (c *Challenge) CleanUp(authz acme.Authorization) error {
-	log.Infof("[%s] acme: Cleaning DNS-01 challenge", challenge.GetTargetedDomain(authz))
+	log.Info("acme: Cleaning DNS-01 challenge", log.Domain(challenge.GetTargetedDomain(authz)), log.ChallengeType(string(challenge.DNS01)))
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -161,6 +242,76 @@ func (c *Challenge) CleanUp(authz acme.Authorization) error {
 	return c.provider.CleanUp(authz.Identifier.Value, chlng.Token, keyAuth)
 }
 
+// Batch returns true if the provider can present and clean up several challenges in one call.
+func (c *Challenge) Batch() bool {
+	_, ok := c.provider.(challenge.BatchProvider)
+	return ok
+}
+
+// PreSolveBatch submits the TXT records of several authorizations to the DNS provider in one call.
+// It does not validate record propagation, or do anything at all with the acme server.
+func (c *Challenge) PreSolveBatch(authzs []acme.Authorization) error {
+	provider, ok := c.provider.(challenge.BatchProvider)
+	if !ok {
+		return errors.New("acme: the DNS provider does not support batches")
+	}
+
+	for _, authz := range authzs {
+		log.Info("acme: Preparing to solve DNS-01 (batch)", log.Domain(challenge.GetTargetedDomain(authz)), log.ChallengeType(string(challenge.DNS01)))
+	}
+
+	records, err := c.batchRecords(authzs)
+	if err != nil {
+		return err
+	}
+
+	err = provider.PresentBatch(records)
+	if err != nil {
+		return fmt.Errorf("acme: error presenting tokens: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUpBatch cleans the challenges of several authorizations in one call.
+func (c *Challenge) CleanUpBatch(authzs []acme.Authorization) error {
+	provider, ok := c.provider.(challenge.BatchProvider)
+	if !ok {
+		return errors.New("acme: the DNS provider does not support batches")
+	}
+
+	for _, authz := range authzs {
+		log.Info("acme: Cleaning DNS-01 challenge (batch)", log.Domain(challenge.GetTargetedDomain(authz)), log.ChallengeType(string(challenge.DNS01)))
+	}
+
+	records, err := c.batchRecords(authzs)
+	if err != nil {
+		return err
+	}
+
+	return provider.CleanUpBatch(records)
+}
+
+func (c *Challenge) batchRecords(authzs []acme.Authorization) ([]challenge.BatchRecord, error) {
+	var records []challenge.BatchRecord
+
+	for _, authz := range authzs {
+		chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
+		if err != nil {
+			return nil, err
+		}
+
+		keyAuth, err := c.core.GetKeyAuthorization(chlng.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, challenge.BatchRecord{Domain: authz.Identifier.Value, Token: chlng.Token, KeyAuth: keyAuth})
+	}
+
+	return records, nil
+}
+
 func (c *Challenge) Sequential() (bool, time.Duration) {
 	if p, ok := c.provider.(sequential); ok {
 		return ok, p.Sequential()
@@ -230,10 +381,18 @@ func getChallengeFQDN(domain string, followCNAME bool) string {
 			break
 		}
 
-		log.Infof("Found CNAME entry for %q: %q", fqdn, cname)
+		log.Debugf("Found CNAME entry for %q: %q", fqdn, cname)
 
 		fqdn = cname
 	}
 
 	return fqdn
 }
+
+func (c *Challenge) propagationTimeout() (timeout, interval time.Duration) {
+	if provider, ok := c.provider.(challenge.ProviderTimeout); ok {
+		return provider.Timeout()
+	}
+
+	return DefaultPropagationTimeout, DefaultPollingInterval
+}
@@ -0,0 +1,150 @@
+package dns01
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/miekg/dns"
+)
+
+// ZoneRouter is a DNS provider that routes the calls to a provider according to the zone of the domain.
+//
+// The provider of a domain is selected by:
+//  1. the authoritative zone of the challenge FQDN (found with FindZoneByFqdn), if a route exists for this zone.
+//  2. the longest route zone that is a parent of the challenge FQDN (explicit mapping).
+//     A route for "." is used as the default route.
+type ZoneRouter struct {
+	routes   map[string]challenge.Provider
+	findZone func(fqdn string) (string, error)
+}
+
+// sequentialZoneRouter is a ZoneRouter with at least one sequential provider.
+type sequentialZoneRouter struct {
+	*ZoneRouter
+}
+
+// Sequential returns the longest interval of the sequential providers.
+func (r *sequentialZoneRouter) Sequential() time.Duration {
+	var interval time.Duration
+
+	for _, provider := range r.routes {
+		if p, ok := provider.(sequential); ok {
+			interval = max(interval, p.Sequential())
+		}
+	}
+
+	return interval
+}
+
+// NewZoneRouter creates a DNS provider that routes the calls to the providers by zone.
+// The keys of routes are the zones (e.g. "example.com", or "." for the default route).
+// The returned provider is sequential if at least one of the providers is sequential.
+func NewZoneRouter(routes map[string]challenge.Provider) (challenge.ProviderTimeout, error) {
+	if len(routes) == 0 {
+		return nil, errors.New("zone router: no routes")
+	}
+
+	r := &ZoneRouter{
+		routes:   make(map[string]challenge.Provider, len(routes)),
+		findZone: FindZoneByFqdn,
+	}
+
+	for zone, provider := range routes {
+		if provider == nil {
+			return nil, fmt.Errorf("zone router: nil provider for the zone %q", zone)
+		}
+
+		r.routes[strings.ToLower(ToFqdn(zone))] = provider
+	}
+
+	for _, provider := range r.routes {
+		if _, ok := provider.(sequential); ok {
+			return &sequentialZoneRouter{ZoneRouter: r}, nil
+		}
+	}
+
+	return r, nil
+}
+
+// Present routes the call to the provider of the domain.
+func (r *ZoneRouter) Present(domain, token, keyAuth string) error {
+	provider, err := r.route(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	return provider.Present(domain, token, keyAuth)
+}
+
+// CleanUp routes the call to the provider of the domain.
+func (r *ZoneRouter) CleanUp(domain, token, keyAuth string) error {
+	provider, err := r.route(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	return provider.CleanUp(domain, token, keyAuth)
+}
+
+// Check runs the check of the provider of the domain, if the provider supports it.
+func (r *ZoneRouter) Check(domain string) error {
+	provider, err := r.route(domain, "")
+	if err != nil {
+		return err
+	}
+
+	if checker, ok := provider.(challenge.Checker); ok {
+		return checker.Check(domain)
+	}
+
+	return nil
+}
+
+// Timeout returns the longest timeout and the shortest interval of the providers.
+func (r *ZoneRouter) Timeout() (timeout, interval time.Duration) {
+	for _, provider := range r.routes {
+		t, in := DefaultPropagationTimeout, DefaultPollingInterval
+		if p, ok := provider.(challenge.ProviderTimeout); ok {
+			t, in = p.Timeout()
+		}
+
+		if timeout == 0 {
+			timeout, interval = t, in
+			continue
+		}
+
+		timeout, interval = max(timeout, t), min(interval, in)
+	}
+
+	return timeout, interval
+}
+
+// route returns the provider of a domain.
+func (r *ZoneRouter) route(domain, keyAuth string) (challenge.Provider, error) {
+	fqdn := strings.ToLower(GetChallengeInfo(domain, keyAuth).EffectiveFQDN)
+
+	zone, err := r.findZone(fqdn)
+	if err != nil {
+		log.Debug("zone router: could not find the authoritative zone.", log.Domain(domain), "error", err)
+	} else if provider, ok := r.routes[strings.ToLower(ToFqdn(zone))]; ok {
+		return provider, nil
+	}
+
+	best, found := "", false
+
+	for zone := range r.routes {
+		if dns.IsSubDomain(zone, fqdn) && (!found || dns.CountLabel(zone) > dns.CountLabel(best)) {
+			best, found = zone, true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("zone router: no provider for %s", fqdn)
+	}
+
+	return r.routes[best], nil
+}
@@ -0,0 +1,186 @@
+package dns01
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type routedProvider struct {
+	name       string
+	timeout    time.Duration
+	interval   time.Duration
+	presented  []string
+	cleanedUp  []string
+	sequential time.Duration
+}
+
+func (p *routedProvider) Present(domain, _, _ string) error {
+	p.presented = append(p.presented, domain)
+	return nil
+}
+
+func (p *routedProvider) CleanUp(domain, _, _ string) error {
+	p.cleanedUp = append(p.cleanedUp, domain)
+	return nil
+}
+
+type timeoutProvider struct{ *routedProvider }
+
+func (p timeoutProvider) Timeout() (timeout, interval time.Duration) {
+	return p.timeout, p.interval
+}
+
+type sequentialProvider struct{ *routedProvider }
+
+func (p sequentialProvider) Sequential() time.Duration {
+	return p.sequential
+}
+
+func TestZoneRouter_route(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	route53 := &routedProvider{name: "route53"}
+	cloudflare := &routedProvider{name: "cloudflare"}
+	rfc2136 := &routedProvider{name: "rfc2136"}
+	fallback := &routedProvider{name: "fallback"}
+
+	provider, err := NewZoneRouter(map[string]challenge.Provider{
+		"example.com":          route53,
+		"Example.org.":         cloudflare,
+		"internal.example.com": rfc2136,
+		".":                    fallback,
+	})
+	require.NoError(t, err)
+
+	router := provider.(*ZoneRouter)
+
+	// Authoritative zones.
+	router.findZone = func(fqdn string) (string, error) {
+		switch fqdn {
+		case "_acme-challenge.a.internal.example.com.":
+			// Not delegated.
+			return "example.com.", nil
+		case "_acme-challenge.example.net.":
+			return "", errors.New("SOA not found")
+		default:
+			return "unknown.", nil
+		}
+	}
+
+	testCases := []struct {
+		domain   string
+		expected *routedProvider
+	}{
+		{domain: "example.com", expected: route53},
+		{domain: "www.example.com", expected: route53},
+		{domain: "WWW.EXAMPLE.ORG", expected: cloudflare},
+		{domain: "internal.example.com", expected: rfc2136},
+		{domain: "a.internal.example.com", expected: route53},
+		{domain: "example.net", expected: fallback},
+	}
+
+	for _, test := range testCases {
+		require.NoError(t, router.Present(test.domain, "token", "keyAuth"))
+		require.NoError(t, router.CleanUp(test.domain, "token", "keyAuth"))
+
+		assert.Contains(t, test.expected.presented, test.domain, test.domain)
+		assert.Contains(t, test.expected.cleanedUp, test.domain, test.domain)
+	}
+}
+
+type checkerProvider struct {
+	*routedProvider
+
+	checked []string
+}
+
+func (p *checkerProvider) Check(domain string) error {
+	p.checked = append(p.checked, domain)
+	return nil
+}
+
+func TestZoneRouter_Check(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	route53 := &checkerProvider{routedProvider: &routedProvider{name: "route53"}}
+	fallback := &routedProvider{name: "fallback"}
+
+	provider, err := NewZoneRouter(map[string]challenge.Provider{
+		"example.com": route53,
+		".":           fallback,
+	})
+	require.NoError(t, err)
+
+	router := provider.(*ZoneRouter)
+
+	router.findZone = func(fqdn string) (string, error) {
+		return "unknown.", nil
+	}
+
+	require.NoError(t, router.Check("www.example.com"))
+	require.NoError(t, router.Check("example.net"))
+
+	assert.Equal(t, []string{"www.example.com"}, route53.checked)
+}
+
+func TestZoneRouter_route_noProvider(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, err := NewZoneRouter(map[string]challenge.Provider{"example.com": &routedProvider{}})
+	require.NoError(t, err)
+
+	router := provider.(*ZoneRouter)
+	router.findZone = func(_ string) (string, error) { return "example.org.", nil }
+
+	err = router.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, "zone router: no provider for _acme-challenge.example.org.")
+}
+
+func TestNewZoneRouter_Timeout(t *testing.T) {
+	provider, err := NewZoneRouter(map[string]challenge.Provider{
+		"example.com": timeoutProvider{&routedProvider{timeout: 5 * time.Minute, interval: 10 * time.Second}},
+		"example.org": timeoutProvider{&routedProvider{timeout: 30 * time.Second, interval: time.Second}},
+		"example.net": &routedProvider{},
+	})
+	require.NoError(t, err)
+
+	timeout, interval := provider.Timeout()
+
+	assert.Equal(t, 5*time.Minute, timeout)
+	assert.Equal(t, time.Second, interval)
+
+	_, ok := provider.(sequential)
+	assert.False(t, ok)
+}
+
+func TestNewZoneRouter_Sequential(t *testing.T) {
+	provider, err := NewZoneRouter(map[string]challenge.Provider{
+		"example.com": sequentialProvider{&routedProvider{sequential: time.Minute}},
+		"example.org": sequentialProvider{&routedProvider{sequential: 2 * time.Minute}},
+		"example.net": &routedProvider{},
+	})
+	require.NoError(t, err)
+
+	s, ok := provider.(sequential)
+	require.True(t, ok)
+
+	assert.Equal(t, 2*time.Minute, s.Sequential())
+
+	timeout, interval := provider.Timeout()
+
+	assert.Equal(t, DefaultPropagationTimeout, timeout)
+	assert.Equal(t, DefaultPollingInterval, interval)
+}
+
+func TestNewZoneRouter_errors(t *testing.T) {
+	_, err := NewZoneRouter(nil)
+	require.EqualError(t, err, "zone router: no routes")
+
+	_, err = NewZoneRouter(map[string]challenge.Provider{"example.com": nil})
+	require.EqualError(t, err, `zone router: nil provider for the zone "example.com"`)
+}
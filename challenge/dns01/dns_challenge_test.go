@@ -12,6 +12,7 @@ import (
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +32,19 @@ func (p *providerTimeoutMock) Present(domain, token, keyAuth string) error { ret
 func (p *providerTimeoutMock) CleanUp(domain, token, keyAuth string) error { return p.cleanUp }
 func (p *providerTimeoutMock) Timeout() (time.Duration, time.Duration)     { return p.timeout, p.interval }
 
+type providerBatchMock struct {
+	providerMock
+
+	presented []challenge.BatchRecord
+}
+
+func (p *providerBatchMock) PresentBatch(records []challenge.BatchRecord) error {
+	p.presented = append(p.presented, records...)
+	return p.present
+}
+
+func (p *providerBatchMock) CleanUpBatch(records []challenge.BatchRecord) error { return p.cleanUp }
+
 func TestChallenge_PreSolve(t *testing.T) {
 	_, apiURL := tester.SetupFakeAPI(t)
 
@@ -200,6 +214,69 @@ func TestChallenge_Solve(t *testing.T) {
 	}
 }
 
+func TestChallenge_SolveBatch(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	authzs := []acme.Authorization{
+		{
+			Identifier: acme.Identifier{Value: "example.com"},
+			Challenges: []acme.Challenge{{Type: challenge.DNS01.String(), Token: "a"}},
+		},
+		{
+			Identifier: acme.Identifier{Value: "example.org"},
+			Challenges: []acme.Challenge{{Type: challenge.DNS01.String(), Token: "b"}},
+		},
+		{
+			Identifier: acme.Identifier{Value: "example.net"},
+			Challenges: []acme.Challenge{{Type: challenge.DNS01.String(), Token: "c"}},
+		},
+	}
+
+	checks := map[string]int{}
+
+	// example.com is propagated immediately, example.org after 3 checks, and example.net never.
+	preCheck := func(_, fqdn, _ string, _ PreCheckFunc) (bool, error) {
+		checks[fqdn]++
+
+		switch fqdn {
+		case "_acme-challenge.example.com.":
+			return true, nil
+		case "_acme-challenge.example.org.":
+			return checks[fqdn] >= 3, nil
+		default:
+			return false, errors.New("OOPS")
+		}
+	}
+
+	var validated []string
+
+	validate := func(_ *api.Core, domain string, _ acme.Challenge) error {
+		validated = append(validated, domain)
+		return nil
+	}
+
+	provider := &providerTimeoutMock{timeout: 200 * time.Millisecond, interval: 10 * time.Millisecond}
+
+	chlg := NewChallenge(core, validate, provider, WrapPreCheck(preCheck))
+
+	failures := chlg.SolveBatch(authzs)
+
+	require.Len(t, failures, 1)
+	require.ErrorContains(t, failures["example.net"], "propagation: time limit exceeded: last error: OOPS")
+
+	assert.Equal(t, []string{"example.com", "example.org"}, validated)
+
+	// The propagated records are not checked again.
+	assert.Equal(t, 1, checks["_acme-challenge.example.com."])
+	assert.Equal(t, 3, checks["_acme-challenge.example.org."])
+}
+
 func TestChallenge_CleanUp(t *testing.T) {
 	_, apiURL := tester.SetupFakeAPI(t)
 
@@ -281,3 +358,45 @@ func TestChallenge_CleanUp(t *testing.T) {
 		})
 	}
 }
+
+func TestChallenge_PreSolveBatch(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	authzs := []acme.Authorization{
+		{
+			Identifier: acme.Identifier{Value: "example.com"},
+			Challenges: []acme.Challenge{{Type: challenge.DNS01.String(), Token: "a"}},
+		},
+		{
+			Identifier: acme.Identifier{Value: "example.org"},
+			Challenges: []acme.Challenge{{Type: challenge.DNS01.String(), Token: "b"}},
+		},
+	}
+
+	provider := &providerBatchMock{}
+
+	chlg := NewChallenge(core, nil, provider)
+	require.True(t, chlg.Batch())
+
+	err = chlg.PreSolveBatch(authzs)
+	require.NoError(t, err)
+
+	require.Len(t, provider.presented, 2)
+	assert.Equal(t, "example.com", provider.presented[0].Domain)
+	assert.Equal(t, "a", provider.presented[0].Token)
+	assert.Equal(t, "example.org", provider.presented[1].Domain)
+	assert.Equal(t, "b", provider.presented[1].Token)
+
+	provider.present = errors.New("OOPS")
+
+	err = chlg.PreSolveBatch(authzs)
+	require.EqualError(t, err, "acme: error presenting tokens: OOPS")
+
+	require.False(t, NewChallenge(core, nil, &providerMock{}).Batch())
+}
@@ -0,0 +1,317 @@
+package dns01
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+var (
+	// ErrDNSSECBogus is returned when the DNSSEC validation fails (missing, invalid, or expired signatures).
+	ErrDNSSECBogus = errors.New("bogus answer")
+
+	// ErrDNSSECInsecure is returned when there is no chain of trust to a trust anchor (unsigned zone or delegation).
+	ErrDNSSECInsecure = errors.New("insecure answer")
+)
+
+// rootTrustAnchors are the DS records of the root zone KSKs (KSK-2017 and KSK-2024).
+// https://data.iana.org/root-anchors/root-anchors.xml
+var rootTrustAnchors = []string{
+	". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	". IN DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16",
+}
+
+// DNSSECValidation enables the DNSSEC validation of the TXT records during the propagation checks.
+// The RRSIG chain of the TXT records is validated up to one of the trust anchors.
+// If no trust anchors are provided, the trust anchors of the root zone are used.
+//
+// The DNS queries of the validation (DNSKEY, DS) are sent to the recursive nameservers.
+func DNSSECValidation(anchors ...*dns.DS) ChallengeOption {
+	return func(chlg *Challenge) error {
+		if len(anchors) == 0 {
+			var err error
+
+			anchors, err = ParseTrustAnchors(rootTrustAnchors)
+			if err != nil {
+				return err
+			}
+		}
+
+		chlg.preCheck.dnssec = &dnssecValidator{anchors: anchors}
+
+		return nil
+	}
+}
+
+// ParseTrustAnchors parses DS records in the presentation format (e.g. ". IN DS 20326 8 2 E06D...").
+func ParseTrustAnchors(records []string) ([]*dns.DS, error) {
+	var anchors []*dns.DS
+
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trust anchor %q: %w", record, err)
+		}
+
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			return nil, fmt.Errorf("invalid trust anchor %q: not a DS record", record)
+		}
+
+		anchors = append(anchors, ds)
+	}
+
+	return anchors, nil
+}
+
+// dnssecValidator validates the RRSIG chain of the answers up to the trust anchors.
+type dnssecValidator struct {
+	anchors []*dns.DS
+}
+
+// validate validates the TXT records of an answer.
+// The returned error is a DNSError wrapping ErrDNSSECBogus or ErrDNSSECInsecure.
+func (v *dnssecValidator) validate(fqdn string, r *dns.Msg, ns string) error {
+	err := v.validateTXT(fqdn, r)
+	if err != nil {
+		return &DNSError{Message: "DNSSEC validation error", NS: ns, MsgOut: r, Err: err}
+	}
+
+	return nil
+}
+
+func (v *dnssecValidator) validateTXT(fqdn string, r *dns.Msg) error {
+	cache := make(map[string][]*dns.DNSKEY)
+
+	rrset, sigs := extractRRset(r.Answer, fqdn, dns.TypeTXT)
+
+	if len(sigs) == 0 {
+		// Distinguishes an unsigned zone (insecure) from a missing signature (bogus).
+		zone, err := FindZoneByFqdn(fqdn)
+		if err != nil {
+			return err
+		}
+
+		_, err = v.zoneKeys(zone, cache)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("%w: TXT records of %s: no RRSIG", ErrDNSSECBogus, fqdn)
+	}
+
+	signer := strings.ToLower(sigs[0].SignerName)
+	if !dns.IsSubDomain(signer, strings.ToLower(fqdn)) {
+		return fmt.Errorf("%w: TXT records of %s: invalid signer %s", ErrDNSSECBogus, fqdn, signer)
+	}
+
+	keys, err := v.zoneKeys(signer, cache)
+	if err != nil {
+		return err
+	}
+
+	return verifyRRset(fqdn, dns.TypeTXT, rrset, sigs, keys)
+}
+
+// zoneKeys returns the validated zone keys (DNSKEY) of a zone.
+func (v *dnssecValidator) zoneKeys(zone string, cache map[string][]*dns.DNSKEY) ([]*dns.DNSKEY, error) {
+	zone = strings.ToLower(dns.Fqdn(zone))
+
+	if keys, ok := cache[zone]; ok {
+		return keys, nil
+	}
+
+	dss := v.anchorsFor(zone)
+	if len(dss) == 0 {
+		var err error
+
+		dss, err = v.delegationSigners(zone, cache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := dnsQueryMsg(createDNSSECMsg(zone, dns.TypeDNSKEY, true), recursiveNameservers)
+	if err != nil {
+		return nil, err
+	}
+
+	rrset, sigs := extractRRset(r.Answer, zone, dns.TypeDNSKEY)
+
+	var keys, entryKeys []*dns.DNSKEY
+
+	for _, rr := range rrset {
+		key, ok := rr.(*dns.DNSKEY)
+		if !ok || key.Flags&dns.ZONE == 0 {
+			continue
+		}
+
+		keys = append(keys, key)
+
+		if matchesDS(key, dss) {
+			entryKeys = append(entryKeys, key)
+		}
+	}
+
+	if len(entryKeys) == 0 {
+		return nil, fmt.Errorf("%w: DNSKEY records of %s: no key matching the DS records", ErrDNSSECBogus, zone)
+	}
+
+	err = verifyRRset(zone, dns.TypeDNSKEY, rrset, sigs, entryKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	cache[zone] = keys
+
+	return keys, nil
+}
+
+// delegationSigners returns the validated DS records of a zone.
+func (v *dnssecValidator) delegationSigners(zone string, cache map[string][]*dns.DNSKEY) ([]*dns.DS, error) {
+	if zone == "." {
+		return nil, fmt.Errorf("%w: no trust anchor", ErrDNSSECInsecure)
+	}
+
+	r, err := dnsQueryMsg(createDNSSECMsg(zone, dns.TypeDS, true), recursiveNameservers)
+	if err != nil {
+		return nil, err
+	}
+
+	rrset, sigs := extractRRset(r.Answer, zone, dns.TypeDS)
+
+	if len(rrset) == 0 {
+		return nil, fmt.Errorf("%w: %s: no DS records (unsigned delegation)", ErrDNSSECInsecure, zone)
+	}
+
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("%w: DS records of %s: no RRSIG", ErrDNSSECBogus, zone)
+	}
+
+	// The DS records are signed by the parent zone.
+	parent := strings.ToLower(sigs[0].SignerName)
+	if parent == zone || !dns.IsSubDomain(parent, zone) {
+		return nil, fmt.Errorf("%w: DS records of %s: invalid signer %s", ErrDNSSECBogus, zone, parent)
+	}
+
+	keys, err := v.zoneKeys(parent, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyRRset(zone, dns.TypeDS, rrset, sigs, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var dss []*dns.DS
+
+	for _, rr := range rrset {
+		if ds, ok := rr.(*dns.DS); ok {
+			dss = append(dss, ds)
+		}
+	}
+
+	return dss, nil
+}
+
+func (v *dnssecValidator) anchorsFor(zone string) []*dns.DS {
+	var dss []*dns.DS
+
+	for _, anchor := range v.anchors {
+		if strings.EqualFold(dns.Fqdn(anchor.Hdr.Name), zone) {
+			dss = append(dss, anchor)
+		}
+	}
+
+	return dss
+}
+
+// verifyRRset checks that at least one RRSIG of the RRset is valid and made by one of the keys.
+func verifyRRset(name string, rtype uint16, rrset []dns.RR, sigs []*dns.RRSIG, keys []*dns.DNSKEY) error {
+	rrsetName := fmt.Sprintf("%s records of %s", dns.TypeToString[rtype], name)
+
+	if len(sigs) == 0 {
+		return fmt.Errorf("%w: %s: no RRSIG", ErrDNSSECBogus, rrsetName)
+	}
+
+	var expired bool
+
+	for _, sig := range sigs {
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm || !strings.EqualFold(key.Hdr.Name, sig.SignerName) {
+				continue
+			}
+
+			if sig.Verify(key, rrset) != nil {
+				continue
+			}
+
+			if !sig.ValidityPeriod(time.Now()) {
+				expired = true
+				continue
+			}
+
+			return nil
+		}
+	}
+
+	if expired {
+		return fmt.Errorf("%w: %s: expired RRSIG", ErrDNSSECBogus, rrsetName)
+	}
+
+	return fmt.Errorf("%w: %s: no valid RRSIG", ErrDNSSECBogus, rrsetName)
+}
+
+// matchesDS checks if a key matches one of the DS records.
+func matchesDS(key *dns.DNSKEY, dss []*dns.DS) bool {
+	for _, ds := range dss {
+		if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+			continue
+		}
+
+		if d := key.ToDS(ds.DigestType); d != nil && strings.EqualFold(d.Digest, ds.Digest) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// extractRRset returns the records and the signatures of an RRset.
+func extractRRset(rrs []dns.RR, name string, rtype uint16) ([]dns.RR, []*dns.RRSIG) {
+	var rrset []dns.RR
+	var sigs []*dns.RRSIG
+
+	for _, rr := range rrs {
+		if !strings.EqualFold(rr.Header().Name, name) {
+			continue
+		}
+
+		switch v := rr.(type) {
+		case *dns.RRSIG:
+			if v.TypeCovered == rtype {
+				sigs = append(sigs, v)
+			}
+		default:
+			if rr.Header().Rrtype == rtype {
+				rrset = append(rrset, rr)
+			}
+		}
+	}
+
+	return rrset, sigs
+}
+
+// createDNSSECMsg creates a query with the DO bit (RFC 3225),
+// and the CD bit to get the records even if the resolver considers them bogus.
+func createDNSSECMsg(fqdn string, rtype uint16, recursive bool) *dns.Msg {
+	m := createDNSMsg(fqdn, rtype, recursive)
+	m.IsEdns0().SetDo()
+	m.CheckingDisabled = true
+
+	return m
+}
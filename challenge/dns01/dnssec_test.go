@@ -0,0 +1,300 @@
+package dns01
+
+import (
+	"crypto"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustAnchors(t *testing.T) {
+	anchors, err := ParseTrustAnchors(rootTrustAnchors)
+	require.NoError(t, err)
+
+	require.Len(t, anchors, 2)
+	assert.Equal(t, uint16(20326), anchors[0].KeyTag)
+	assert.Equal(t, uint16(38696), anchors[1].KeyTag)
+
+	_, err = ParseTrustAnchors([]string{"example.com. IN A 127.0.0.1"})
+	require.EqualError(t, err, `invalid trust anchor "example.com. IN A 127.0.0.1": not a DS record`)
+}
+
+func TestPreCheck_checkDNSPropagation_dnssec(t *testing.T) {
+	server := newDNSSECTestServer(t)
+
+	parent := server.addZone(t, "example.com.")
+	child := server.addZone(t, "sub.example.com.")
+	server.addZone(t, "unsigned.example.com.")
+
+	server.addDS(t, parent, child)
+
+	now := time.Now()
+
+	server.addTXT(t, parent, "_acme-challenge.www.example.com.", "value", now.Add(-time.Hour), now.Add(time.Hour))
+	server.addTXT(t, child, "_acme-challenge.sub.example.com.", "value", now.Add(-time.Hour), now.Add(time.Hour))
+	server.addTXT(t, nil, "_acme-challenge.unsigned.example.com.", "value", time.Time{}, time.Time{})
+	server.addTXT(t, parent, "_acme-challenge.expired.example.com.", "value", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	server.addTXT(t, nil, "_acme-challenge.nosig.example.com.", "value", time.Time{}, time.Time{})
+	server.addTXT(t, parent, "_acme-challenge.tampered.example.com.", "value", now.Add(-time.Hour), now.Add(time.Hour))
+	server.tamper("_acme-challenge.tampered.example.com.", "value")
+
+	backup := recursiveNameservers
+	recursiveNameservers = []string{server.addr}
+
+	t.Cleanup(func() {
+		recursiveNameservers = backup
+		ClearFqdnCache()
+	})
+
+	testCases := []struct {
+		desc          string
+		fqdn          string
+		anchors       []*dns.DS
+		expectedErr   error
+		expectedError string
+	}{
+		{
+			desc: "signed zone",
+			fqdn: "_acme-challenge.www.example.com.",
+		},
+		{
+			desc: "signed delegation",
+			fqdn: "_acme-challenge.sub.example.com.",
+		},
+		{
+			desc:          "unsigned zone",
+			fqdn:          "_acme-challenge.unsigned.example.com.",
+			expectedErr:   ErrDNSSECInsecure,
+			expectedError: "insecure answer: unsigned.example.com.: no DS records (unsigned delegation)",
+		},
+		{
+			desc:          "expired signature",
+			fqdn:          "_acme-challenge.expired.example.com.",
+			expectedErr:   ErrDNSSECBogus,
+			expectedError: "bogus answer: TXT records of _acme-challenge.expired.example.com.: expired RRSIG",
+		},
+		{
+			desc:          "missing signature",
+			fqdn:          "_acme-challenge.nosig.example.com.",
+			expectedErr:   ErrDNSSECBogus,
+			expectedError: "bogus answer: TXT records of _acme-challenge.nosig.example.com.: no RRSIG",
+		},
+		{
+			desc:          "invalid signature",
+			fqdn:          "_acme-challenge.tampered.example.com.",
+			expectedErr:   ErrDNSSECBogus,
+			expectedError: "bogus answer: TXT records of _acme-challenge.tampered.example.com.: no valid RRSIG",
+		},
+		{
+			desc:          "no trust anchor",
+			fqdn:          "_acme-challenge.www.example.com.",
+			anchors:       []*dns.DS{{Hdr: dns.RR_Header{Name: "example.org."}}},
+			expectedErr:   ErrDNSSECInsecure,
+			expectedError: "insecure answer: example.com.: no DS records (unsigned delegation)",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			ClearFqdnCache()
+
+			anchors := test.anchors
+			if anchors == nil {
+				anchors = []*dns.DS{parent.ds(t)}
+			}
+
+			p := preCheck{
+				requireRecursiveNssPropagation: true,
+				dnssec:                         &dnssecValidator{anchors: anchors},
+			}
+
+			found, err := p.checkDNSPropagation(test.fqdn, "value")
+
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+				assert.True(t, found)
+
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+			assert.ErrorContains(t, err, test.expectedError)
+
+			var dnsErr *DNSError
+			require.ErrorAs(t, err, &dnsErr)
+			assert.Equal(t, server.addr, dnsErr.NS)
+
+			assert.False(t, found)
+		})
+	}
+}
+
+type dnssecTestZone struct {
+	name string
+	key  *dns.DNSKEY
+	priv crypto.Signer
+}
+
+func (z *dnssecTestZone) ds(t *testing.T) *dns.DS {
+	t.Helper()
+
+	ds := z.key.ToDS(dns.SHA256)
+	require.NotNil(t, ds)
+
+	return ds
+}
+
+func (z *dnssecTestZone) sign(t *testing.T, rrset []dns.RR, inception, expiration time.Time) *dns.RRSIG {
+	t.Helper()
+
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: rrset[0].Header().Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: 300},
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.name,
+		Algorithm:  z.key.Algorithm,
+		Inception:  uint32(inception.Unix()),
+		Expiration: uint32(expiration.Unix()),
+	}
+
+	require.NoError(t, sig.Sign(z.priv, rrset))
+
+	return sig
+}
+
+// dnssecTestServer is a recursive resolver stand-in serving signed zones.
+type dnssecTestServer struct {
+	addr string
+
+	mu      sync.Mutex
+	records map[string][]dns.RR
+}
+
+func newDNSSECTestServer(t *testing.T) *dnssecTestServer {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &dnssecTestServer{
+		addr:    pc.LocalAddr().String(),
+		records: make(map[string][]dns.RR),
+	}
+
+	server := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(s.serveDNS)}
+
+	started := make(chan struct{})
+	server.NotifyStartedFunc = func() { close(started) }
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	<-started
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	return s
+}
+
+func (s *dnssecTestServer) serveDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(req)
+	m.SetEdns0(4096, true)
+
+	q := req.Question[0]
+
+	s.mu.Lock()
+	for _, rr := range s.records[strings.ToLower(q.Name)] {
+		switch v := rr.(type) {
+		case *dns.RRSIG:
+			if v.TypeCovered == q.Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		default:
+			if rr.Header().Rrtype == q.Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	_ = w.WriteMsg(m)
+}
+
+func (s *dnssecTestServer) add(rrs ...dns.RR) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, rr := range rrs {
+		name := strings.ToLower(rr.Header().Name)
+		s.records[name] = append(s.records[name], rr)
+	}
+}
+
+// addZone adds a zone (SOA), and its signed DNSKEY records.
+func (s *dnssecTestServer) addZone(t *testing.T, name string) *dnssecTestZone {
+	t.Helper()
+
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 300},
+		Flags:     dns.ZONE | dns.SEP,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+
+	priv, err := key.Generate(256)
+	require.NoError(t, err)
+
+	zone := &dnssecTestZone{name: name, key: key, priv: priv.(crypto.Signer)}
+
+	s.add(&dns.SOA{
+		Hdr:     dns.RR_Header{Name: name, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+		Ns:      "ns1." + name,
+		Mbox:    "hostmaster." + name,
+		Refresh: 3600,
+	})
+
+	now := time.Now()
+	s.add(key, zone.sign(t, []dns.RR{key}, now.Add(-time.Hour), now.Add(time.Hour)))
+
+	return zone
+}
+
+// addDS adds the signed DS record of the child zone in the parent zone.
+func (s *dnssecTestServer) addDS(t *testing.T, parent, child *dnssecTestZone) {
+	t.Helper()
+
+	ds := child.ds(t)
+	ds.Hdr = dns.RR_Header{Name: child.name, Rrtype: dns.TypeDS, Class: dns.ClassINET, Ttl: 300}
+
+	now := time.Now()
+	s.add(ds, parent.sign(t, []dns.RR{ds}, now.Add(-time.Hour), now.Add(time.Hour)))
+}
+
+// addTXT adds a TXT record, signed by the zone if not nil.
+func (s *dnssecTestServer) addTXT(t *testing.T, zone *dnssecTestZone, fqdn, value string, inception, expiration time.Time) {
+	t.Helper()
+
+	txt := &dns.TXT{
+		Hdr: dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 120},
+		Txt: []string{value},
+	}
+
+	s.add(txt)
+
+	if zone != nil {
+		s.add(zone.sign(t, []dns.RR{txt}, inception, expiration))
+	}
+}
+
+// tamper adds a value to the TXT RRset after its signature.
+func (s *dnssecTestServer) tamper(fqdn, value string) {
+	s.add(&dns.TXT{
+		Hdr: dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 120},
+		Txt: []string{value + "-tampered"},
+	})
+}
@@ -69,6 +69,12 @@ func AddRecursiveNameservers(nameservers []string) ChallengeOption {
 	}
 }
 
+// RecursiveNameservers returns the nameservers used to find the zones and to check the propagation.
+// Primarily used in testing, to restore the nameservers defined by AddRecursiveNameservers.
+func RecursiveNameservers() []string {
+	return slices.Clone(recursiveNameservers)
+}
+
 // getNameservers attempts to get systems nameservers before falling back to the defaults.
 func getNameservers(path string, defaults []string) []string {
 	config, err := dns.ClientConfigFromFile(path)
@@ -79,9 +85,29 @@ func getNameservers(path string, defaults []string) []string {
 	return ParseNameservers(config.Servers)
 }
 
+// ParseNameservers ensures all the nameservers have a port number.
+// The supported formats are:
+//   - "host" or "host:port": plain DNS (UDP, with a TCP fallback).
+//   - "tls://host" or "tls://host:port": DNS-over-TLS (RFC 7858), the default port is 853.
+//   - "https://host/path": DNS-over-HTTPS (RFC 8484).
 func ParseNameservers(servers []string) []string {
 	var resolvers []string
 	for _, resolver := range servers {
+		if strings.HasPrefix(resolver, schemeHTTPS) {
+			resolvers = append(resolvers, resolver)
+			continue
+		}
+
+		if host, ok := strings.CutPrefix(resolver, schemeTLS); ok {
+			if _, _, err := net.SplitHostPort(host); err != nil {
+				resolvers = append(resolvers, schemeTLS+net.JoinHostPort(host, "853"))
+			} else {
+				resolvers = append(resolvers, resolver)
+			}
+
+			continue
+		}
+
 		// ensure all servers have a port number
 		if _, _, err := net.SplitHostPort(resolver); err != nil {
 			resolvers = append(resolvers, net.JoinHostPort(resolver, "53"))
@@ -226,8 +252,11 @@ func dnsMsgContainsCNAME(msg *dns.Msg) bool {
 }
 
 func dnsQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (*dns.Msg, error) {
-	m := createDNSMsg(fqdn, rtype, recursive)
+	return dnsQueryMsg(createDNSMsg(fqdn, rtype, recursive), nameservers)
+}
 
+// dnsQueryMsg sends the message to the nameservers until one of them returns an answer.
+func dnsQueryMsg(m *dns.Msg, nameservers []string) (*dns.Msg, error) {
 	if len(nameservers) == 0 {
 		return nil, &DNSError{Message: "empty list of nameservers"}
 	}
@@ -265,6 +294,13 @@ func createDNSMsg(fqdn string, rtype uint16, recursive bool) *dns.Msg {
 }
 
 func sendDNSQuery(m *dns.Msg, ns string) (*dns.Msg, error) {
+	switch {
+	case strings.HasPrefix(ns, schemeHTTPS):
+		return sendDoHQuery(m, ns)
+	case strings.HasPrefix(ns, schemeTLS):
+		return sendDoTQuery(m, ns)
+	}
+
 	if ok, _ := strconv.ParseBool(os.Getenv("LEGO_EXPERIMENTAL_DNS_TCP_ONLY")); ok {
 		tcp := &dns.Client{Net: "tcp", Timeout: dnsTimeout}
 		r, _, err := tcp.Exchange(m, ns)
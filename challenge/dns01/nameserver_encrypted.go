@@ -0,0 +1,128 @@
+package dns01
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	schemeHTTPS = "https://"
+	schemeTLS   = "tls://"
+)
+
+const dnsMessageContentType = "application/dns-message"
+
+// encryptedDNSTLSConfig is the TLS configuration used by the DNS-over-TLS and DNS-over-HTTPS clients.
+var encryptedDNSTLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+// dohClient is the HTTP client shared by the DNS-over-HTTPS queries:
+// the connections to a server are reused between the queries, and closed when they are idle.
+var dohClient = newDoHClient()
+
+func newDoHClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSClientConfig:     encryptedDNSTLSConfig.Clone(),
+			ForceAttemptHTTP2:   true,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     30 * time.Second,
+		},
+	}
+}
+
+// sendDoTQuery sends a DNS query to a DNS-over-TLS server (RFC 7858).
+// ns is in the form "tls://host:port".
+func sendDoTQuery(m *dns.Msg, ns string) (*dns.Msg, error) {
+	addr := strings.TrimPrefix(ns, schemeTLS)
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, &DNSError{Message: "DNS call error", MsgIn: m, NS: ns, Err: err}
+	}
+
+	tlsConfig := encryptedDNSTLSConfig.Clone()
+	tlsConfig.ServerName = host
+
+	client := &dns.Client{Net: "tcp-tls", Timeout: dnsTimeout, TLSConfig: tlsConfig}
+
+	r, _, err := client.Exchange(m, addr)
+	if err != nil {
+		return r, &DNSError{Message: "DNS call error", MsgIn: m, NS: ns, Err: err}
+	}
+
+	return r, nil
+}
+
+// sendDoHQuery sends a DNS query to a DNS-over-HTTPS server (RFC 8484), with the POST method.
+// ns is the URL of the server (e.g. "https://dns.google/dns-query").
+func sendDoHQuery(m *dns.Msg, ns string) (*dns.Msg, error) {
+	r, err := exchangeDoH(m, ns)
+	if err != nil {
+		return r, &DNSError{Message: "DNS call error", MsgIn: m, NS: ns, Err: err}
+	}
+
+	return r, nil
+}
+
+func exchangeDoH(m *dns.Msg, ns string) (*dns.Msg, error) {
+	// The ID should be 0 to maximize the HTTP cache friendliness (RFC 8484 Section 4.1).
+	q := m.Copy()
+	q.Id = 0
+
+	raw, err := q.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("pack message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ns, bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", dnsMessageContentType)
+	req.Header.Set("Accept", dnsMessageContentType)
+
+	resp, err := dohClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, dnsMessageContentType) {
+		return nil, fmt.Errorf("unexpected content type: %q", ct)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	r := new(dns.Msg)
+
+	err = r.Unpack(body)
+	if err != nil {
+		return nil, fmt.Errorf("unpack message: %w", err)
+	}
+
+	r.Id = m.Id
+
+	return r, nil
+}
@@ -0,0 +1,285 @@
+package dns01
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNameservers(t *testing.T) {
+	servers := []string{
+		"1.1.1.1",
+		"1.1.1.1:5353",
+		"tls://dns.example.com",
+		"tls://1.1.1.1:8853",
+		"https://dns.example.com/dns-query",
+	}
+
+	expected := []string{
+		"1.1.1.1:53",
+		"1.1.1.1:5353",
+		"tls://dns.example.com:853",
+		"tls://1.1.1.1:8853",
+		"https://dns.example.com/dns-query",
+	}
+
+	assert.Equal(t, expected, ParseNameservers(servers))
+}
+
+func TestSendDNSQuery_DoH(t *testing.T) {
+	server := setupDoHServer(t)
+
+	m := createDNSMsg("example.com.", dns.TypeSOA, true)
+
+	r, err := sendDNSQuery(m, server.URL+"/dns-query")
+	require.NoError(t, err)
+
+	assert.Equal(t, m.Id, r.Id)
+	require.Len(t, r.Answer, 1)
+	assert.IsType(t, &dns.SOA{}, r.Answer[0])
+}
+
+func TestSendDNSQuery_DoH_reuseConnection(t *testing.T) {
+	var connections atomic.Int32
+
+	server := httptest.NewUnstartedServer(newDoHHandler())
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	trustCertificate(t, server.Certificate())
+
+	for range 3 {
+		_, err := sendDNSQuery(createDNSMsg("example.com.", dns.TypeSOA, true), server.URL+"/dns-query")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), connections.Load())
+}
+
+func TestSendDNSQuery_DoH_error(t *testing.T) {
+	server := setupDoHServer(t)
+
+	m := createDNSMsg("example.com.", dns.TypeSOA, true)
+
+	_, err := sendDNSQuery(m, server.URL+"/unknown")
+	require.Error(t, err)
+
+	assert.ErrorContains(t, err, "unexpected status code: 404")
+}
+
+func TestSendDNSQuery_DoT(t *testing.T) {
+	addr := setupDoTServer(t)
+
+	m := createDNSMsg("example.com.", dns.TypeSOA, true)
+
+	r, err := sendDNSQuery(m, "tls://"+addr)
+	require.NoError(t, err)
+
+	require.Len(t, r.Answer, 1)
+	assert.IsType(t, &dns.SOA{}, r.Answer[0])
+}
+
+func TestFindZoneByFqdnCustom_encrypted(t *testing.T) {
+	dohServer := setupDoHServer(t)
+	dotAddr := setupDoTServer(t)
+
+	testCases := []struct {
+		desc        string
+		nameservers []string
+	}{
+		{
+			desc:        "DoH",
+			nameservers: []string{dohServer.URL + "/dns-query"},
+		},
+		{
+			desc:        "DoT",
+			nameservers: []string{"tls://" + dotAddr},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			ClearFqdnCache()
+
+			zone, err := FindZoneByFqdnCustom("_acme-challenge.www.example.com.", ParseNameservers(test.nameservers))
+			require.NoError(t, err)
+
+			assert.Equal(t, "example.com.", zone)
+		})
+	}
+}
+
+func TestAddRecursiveNameservers_encrypted(t *testing.T) {
+	server := setupDoHServer(t)
+
+	backup := recursiveNameservers
+	t.Cleanup(func() {
+		recursiveNameservers = backup
+		ClearFqdnCache()
+	})
+
+	ClearFqdnCache()
+
+	err := AddRecursiveNameservers([]string{server.URL + "/dns-query"})(nil)
+	require.NoError(t, err)
+
+	nss, err := lookupNameservers("_acme-challenge.www.example.com.")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ns1.example.com.", "ns2.example.com."}, nss)
+}
+
+// fakeZoneHandler answers the SOA and NS queries for "example.com.".
+func fakeZoneHandler(w dns.ResponseWriter, req *dns.Msg) {
+	_ = w.WriteMsg(fakeZoneAnswer(req))
+}
+
+func fakeZoneAnswer(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	q := req.Question[0]
+
+	if q.Name != "example.com." {
+		m.Rcode = dns.RcodeNameError
+		return m
+	}
+
+	switch q.Qtype {
+	case dns.TypeSOA:
+		m.Answer = append(m.Answer, &dns.SOA{
+			Hdr:     dns.RR_Header{Name: q.Name, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+			Ns:      "ns1.example.com.",
+			Mbox:    "hostmaster.example.com.",
+			Serial:  1,
+			Refresh: 3600,
+		})
+	case dns.TypeNS:
+		for _, ns := range []string{"ns1.example.com.", "ns2.example.com."} {
+			m.Answer = append(m.Answer, &dns.NS{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: 300},
+				Ns:  ns,
+			})
+		}
+	}
+
+	return m
+}
+
+// setupDoHServer starts a local DNS-over-HTTPS server trusted by the DNS clients.
+func setupDoHServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(newDoHHandler())
+	t.Cleanup(server.Close)
+
+	trustCertificate(t, server.Certificate())
+
+	return server
+}
+
+func newDoHHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /dns-query", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Content-Type") != dnsMessageContentType {
+			http.Error(rw, "invalid content type", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		m := new(dns.Msg)
+
+		err = m.Unpack(raw)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := fakeZoneAnswer(m).Pack()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rw.Header().Set("Content-Type", dnsMessageContentType)
+		_, _ = rw.Write(resp)
+	})
+
+	return mux
+}
+
+// setupDoTServer starts a local DNS-over-TLS server trusted by the DNS clients.
+func setupDoTServer(t *testing.T) string {
+	t.Helper()
+
+	// Reuses the certificate of httptest (valid for 127.0.0.1).
+	certServer := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(certServer.Close)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: certServer.TLS.Certificates,
+		MinVersion:   tls.VersionTLS12,
+	})
+	require.NoError(t, err)
+
+	server := &dns.Server{Listener: listener, Net: "tcp-tls", Handler: dns.HandlerFunc(fakeZoneHandler)}
+
+	started := make(chan struct{})
+	server.NotifyStartedFunc = func() { close(started) }
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	<-started
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	trustCertificate(t, certServer.Certificate())
+
+	return listener.Addr().(*net.TCPAddr).String()
+}
+
+func trustCertificate(t *testing.T, cert *x509.Certificate) {
+	t.Helper()
+
+	backup := encryptedDNSTLSConfig
+	backupClient := dohClient
+
+	t.Cleanup(func() {
+		dohClient.CloseIdleConnections()
+
+		encryptedDNSTLSConfig = backup
+		dohClient = backupClient
+	})
+
+	pool := x509.NewCertPool()
+	if backup.RootCAs != nil {
+		pool = backup.RootCAs.Clone()
+	}
+
+	pool.AddCert(cert)
+
+	encryptedDNSTLSConfig = backup.Clone()
+	encryptedDNSTLSConfig.RootCAs = pool
+
+	dohClient = newDoHClient()
+}
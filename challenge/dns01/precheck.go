@@ -65,6 +65,12 @@ type preCheck struct {
 
 	// require the TXT record to be propagated to all recursive name servers
 	requireRecursiveNssPropagation bool
+
+	// validates the DNSSEC signatures of the TXT record (optional)
+	dnssec *dnssecValidator
+
+	// require the TXT record to be found by a quorum of perspectives (optional)
+	perspectives *perspectives
 }
 
 func newPreCheck() preCheck {
@@ -94,12 +100,19 @@ func (p preCheck) checkDNSPropagation(fqdn, value string) (bool, error) {
 	}
 
 	if p.requireRecursiveNssPropagation {
-		_, err = checkNameserversPropagation(fqdn, value, recursiveNameservers, false)
+		_, err = checkNameserversPropagation(fqdn, value, recursiveNameservers, false, p.dnssec)
 		if err != nil {
 			return false, fmt.Errorf("recursive nameservers: %w", err)
 		}
 	}
 
+	if p.perspectives != nil {
+		err = p.perspectives.check(fqdn, value, p.dnssec)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	if !p.requireAuthoritativeNssPropagation {
 		return true, nil
 	}
@@ -109,7 +122,7 @@ func (p preCheck) checkDNSPropagation(fqdn, value string) (bool, error) {
 		return false, err
 	}
 
-	found, err := checkNameserversPropagation(fqdn, value, authoritativeNss, true)
+	found, err := checkNameserversPropagation(fqdn, value, authoritativeNss, true, p.dnssec)
 	if err != nil {
 		return found, fmt.Errorf("authoritative nameservers: %w", err)
 	}
@@ -118,39 +131,59 @@ func (p preCheck) checkDNSPropagation(fqdn, value string) (bool, error) {
 }
 
 // checkNameserversPropagation queries each of the given nameservers for the expected TXT record.
-func checkNameserversPropagation(fqdn, value string, nameservers []string, addPort bool) (bool, error) {
+// If validator is not nil, the DNSSEC signatures of the TXT record are also validated.
+func checkNameserversPropagation(fqdn, value string, nameservers []string, addPort bool, validator *dnssecValidator) (bool, error) {
 	for _, ns := range nameservers {
 		if addPort {
 			ns = net.JoinHostPort(ns, "53")
 		}
 
-		r, err := dnsQuery(fqdn, dns.TypeTXT, []string{ns}, false)
+		err := checkNameserverPropagation(fqdn, value, ns, false, validator)
 		if err != nil {
 			return false, err
 		}
+	}
 
-		if r.Rcode != dns.RcodeSuccess {
-			return false, fmt.Errorf("NS %s returned %s for %s", ns, dns.RcodeToString[r.Rcode], fqdn)
-		}
+	return true, nil
+}
+
+// checkNameserverPropagation queries a nameserver for the expected TXT record.
+func checkNameserverPropagation(fqdn, value, ns string, recursive bool, validator *dnssecValidator) error {
+	m := createDNSMsg(fqdn, dns.TypeTXT, recursive)
+	if validator != nil {
+		m = createDNSSECMsg(fqdn, dns.TypeTXT, recursive)
+	}
+
+	r, err := dnsQueryMsg(m, []string{ns})
+	if err != nil {
+		return err
+	}
+
+	if r.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("NS %s returned %s for %s", ns, dns.RcodeToString[r.Rcode], fqdn)
+	}
 
-		var records []string
-
-		var found bool
-		for _, rr := range r.Answer {
-			if txt, ok := rr.(*dns.TXT); ok {
-				record := strings.Join(txt.Txt, "")
-				records = append(records, record)
-				if record == value {
-					found = true
-					break
-				}
+	var records []string
+
+	var found bool
+	for _, rr := range r.Answer {
+		if txt, ok := rr.(*dns.TXT); ok {
+			record := strings.Join(txt.Txt, "")
+			records = append(records, record)
+			if record == value {
+				found = true
+				break
 			}
 		}
+	}
 
-		if !found {
-			return false, fmt.Errorf("NS %s did not return the expected TXT record [fqdn: %s, value: %s]: %s", ns, fqdn, value, strings.Join(records, " ,"))
-		}
+	if !found {
+		return fmt.Errorf("NS %s did not return the expected TXT record [fqdn: %s, value: %s]: %s", ns, fqdn, value, strings.Join(records, " ,"))
 	}
 
-	return true, nil
+	if validator != nil {
+		return validator.validate(fqdn, r, ns)
+	}
+
+	return nil
 }
@@ -0,0 +1,132 @@
+package dns01
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Perspective is a named group of resolvers (e.g. the resolvers of a region or of a network).
+type Perspective struct {
+	Name        string
+	Nameservers []string
+}
+
+// PerspectiveResult is the result of the propagation check of a perspective.
+type PerspectiveResult struct {
+	Name string
+	// Err is nil if the TXT record was found by all the resolvers of the perspective.
+	Err error
+}
+
+// PerspectivesError is returned when the TXT record was not found by enough perspectives.
+type PerspectivesError struct {
+	Quorum  int
+	Results []PerspectiveResult
+}
+
+func (e *PerspectivesError) Error() string {
+	var succeeded int
+
+	var details []string
+
+	for _, result := range e.Results {
+		if result.Err == nil {
+			succeeded++
+			details = append(details, fmt.Sprintf("[%s: ok]", result.Name))
+
+			continue
+		}
+
+		details = append(details, fmt.Sprintf("[%s: %v]", result.Name, result.Err))
+	}
+
+	return fmt.Sprintf("perspectives: quorum not reached (%d/%d, required: %d): %s",
+		succeeded, len(e.Results), e.Quorum, strings.Join(details, ", "))
+}
+
+// perspectives is the configuration of the multi-perspective propagation check.
+type perspectives struct {
+	quorum int
+	groups []Perspective
+}
+
+// PerspectivesPropagationRequirement requires the TXT record to be found by a quorum of perspectives,
+// like the multi-perspective validation of the CAs.
+// The perspectives are queried concurrently, a perspective succeeds when all its resolvers return the TXT record.
+// If quorum is 0, all the perspectives must succeed.
+func PerspectivesPropagationRequirement(quorum int, groups ...Perspective) ChallengeOption {
+	return func(chlg *Challenge) error {
+		if len(groups) == 0 {
+			return errors.New("perspectives: no perspectives")
+		}
+
+		if quorum < 0 || quorum > len(groups) {
+			return fmt.Errorf("perspectives: invalid quorum %d: must be between 0 and %d", quorum, len(groups))
+		}
+
+		if quorum == 0 {
+			quorum = len(groups)
+		}
+
+		var normalized []Perspective
+
+		for _, group := range groups {
+			if len(group.Nameservers) == 0 {
+				return fmt.Errorf("perspectives: %s: no nameservers", group.Name)
+			}
+
+			normalized = append(normalized, Perspective{Name: group.Name, Nameservers: ParseNameservers(group.Nameservers)})
+		}
+
+		chlg.preCheck.perspectives = &perspectives{quorum: quorum, groups: normalized}
+
+		return nil
+	}
+}
+
+// check queries all the perspectives concurrently.
+func (p *perspectives) check(fqdn, value string, validator *dnssecValidator) error {
+	results := make([]PerspectiveResult, len(p.groups))
+
+	var wg sync.WaitGroup
+
+	for i, group := range p.groups {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i] = PerspectiveResult{Name: group.Name, Err: checkPerspective(fqdn, value, group.Nameservers, validator)}
+		}()
+	}
+
+	wg.Wait()
+
+	var succeeded int
+
+	for _, result := range results {
+		if result.Err == nil {
+			succeeded++
+		}
+	}
+
+	if succeeded < p.quorum {
+		return &PerspectivesError{Quorum: p.quorum, Results: results}
+	}
+
+	return nil
+}
+
+// checkPerspective checks that all the resolvers of a perspective return the TXT record.
+func checkPerspective(fqdn, value string, nameservers []string, validator *dnssecValidator) error {
+	for _, ns := range nameservers {
+		err := checkNameserverPropagation(fqdn, value, ns, true, validator)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package dns01
+
+import (
+	"net"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerspectivesPropagationRequirement(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		quorum        int
+		groups        []Perspective
+		expected      *perspectives
+		expectedError string
+	}{
+		{
+			desc:   "all",
+			quorum: 0,
+			groups: []Perspective{
+				{Name: "a", Nameservers: []string{"192.0.2.1"}},
+				{Name: "b", Nameservers: []string{"tls://192.0.2.2"}},
+			},
+			expected: &perspectives{quorum: 2, groups: []Perspective{
+				{Name: "a", Nameservers: []string{"192.0.2.1:53"}},
+				{Name: "b", Nameservers: []string{"tls://192.0.2.2:853"}},
+			}},
+		},
+		{
+			desc:   "quorum",
+			quorum: 1,
+			groups: []Perspective{
+				{Name: "a", Nameservers: []string{"192.0.2.1:53"}},
+				{Name: "b", Nameservers: []string{"192.0.2.2:53"}},
+			},
+			expected: &perspectives{quorum: 1, groups: []Perspective{
+				{Name: "a", Nameservers: []string{"192.0.2.1:53"}},
+				{Name: "b", Nameservers: []string{"192.0.2.2:53"}},
+			}},
+		},
+		{
+			desc:          "no perspectives",
+			expectedError: "perspectives: no perspectives",
+		},
+		{
+			desc:          "invalid quorum",
+			quorum:        2,
+			groups:        []Perspective{{Name: "a", Nameservers: []string{"192.0.2.1:53"}}},
+			expectedError: "perspectives: invalid quorum 2: must be between 0 and 1",
+		},
+		{
+			desc:          "no nameservers",
+			groups:        []Perspective{{Name: "a"}},
+			expectedError: "perspectives: a: no nameservers",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			chlg := &Challenge{}
+
+			err := PerspectivesPropagationRequirement(test.quorum, test.groups...)(chlg)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, chlg.preCheck.perspectives)
+		})
+	}
+}
+
+func Test_perspectives_check(t *testing.T) {
+	fqdn := "_acme-challenge.example.com."
+
+	found1 := startTXTServer(t, fqdn, "value")
+	found2 := startTXTServer(t, fqdn, "value")
+	stale := startTXTServer(t, fqdn, "old")
+
+	groups := []Perspective{
+		{Name: "us", Nameservers: []string{found1}},
+		{Name: "eu", Nameservers: []string{found2, stale}},
+		{Name: "ap", Nameservers: []string{found2}},
+	}
+
+	p := &perspectives{quorum: 2, groups: groups}
+
+	require.NoError(t, p.check(fqdn, "value", nil))
+
+	p = &perspectives{quorum: 3, groups: groups}
+
+	err := p.check(fqdn, "value", nil)
+	require.Error(t, err)
+
+	var perspectivesErr *PerspectivesError
+	require.ErrorAs(t, err, &perspectivesErr)
+
+	assert.Equal(t, 3, perspectivesErr.Quorum)
+	require.Len(t, perspectivesErr.Results, 3)
+
+	assert.Equal(t, "us", perspectivesErr.Results[0].Name)
+	assert.NoError(t, perspectivesErr.Results[0].Err)
+	assert.Equal(t, "eu", perspectivesErr.Results[1].Name)
+	assert.Error(t, perspectivesErr.Results[1].Err)
+	assert.Equal(t, "ap", perspectivesErr.Results[2].Name)
+	assert.NoError(t, perspectivesErr.Results[2].Err)
+
+	expected := "perspectives: quorum not reached (2/3, required: 3): [us: ok], " +
+		"[eu: NS " + stale + " did not return the expected TXT record [fqdn: _acme-challenge.example.com., value: value]: old], [ap: ok]"
+	assert.EqualError(t, err, expected)
+}
+
+// startTXTServer starts a local DNS server answering a TXT record, with recursion available.
+func startTXTServer(t *testing.T, fqdn, value string) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	handler := func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+		m.RecursionAvailable = true
+
+		q := req.Question[0]
+		if q.Name == fqdn && q.Qtype == dns.TypeTXT && req.RecursionDesired {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 120},
+				Txt: []string{value},
+			})
+		}
+
+		_ = w.WriteMsg(m)
+	}
+
+	server := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(handler)}
+
+	started := make(chan struct{})
+	server.NotifyStartedFunc = func() { close(started) }
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	<-started
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	return pc.LocalAddr().String()
+}
@@ -0,0 +1,33 @@
+package dns01
+
+import (
+	"strings"
+	"time"
+)
+
+// ChallengeRecord is a TXT record of a DNS-01 challenge, as stored by a DNS provider.
+type ChallengeRecord struct {
+	// ID is the identifier of the record for the DNS provider, if any.
+	ID string
+	// FQDN is the full-qualified name of the record (i.e. `_acme-challenge.[domain].`).
+	FQDN string
+	// Value is the content of the TXT record.
+	Value string
+	// CreatedAt is the creation time of the record, zero if the DNS provider doesn't report it.
+	CreatedAt time.Time
+}
+
+// RecordLister is implemented by the DNS providers able to list and delete the challenge records of a zone.
+// It allows removing the records left behind when the cleanup of a challenge didn't happen.
+type RecordLister interface {
+	// ListChallengeRecords returns the `_acme-challenge` TXT records of a zone.
+	ListChallengeRecords(zone string) ([]ChallengeRecord, error)
+
+	// DeleteChallengeRecord deletes a record returned by ListChallengeRecords.
+	DeleteChallengeRecord(zone string, record ChallengeRecord) error
+}
+
+// IsChallengeFQDN reports whether fqdn is the name of a challenge record.
+func IsChallengeFQDN(fqdn string) bool {
+	return strings.HasPrefix(strings.ToLower(fqdn), "_acme-challenge.")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/netip"
+	"net/textproto"
 	"strings"
 )
 
@@ -47,6 +48,21 @@ type domainMatcher interface {
 	name() string
 }
 
+// newDomainMatcher returns the domainMatcher associated with a header name.
+//   - "" (the empty string) and "Host": hostMatcher
+//   - "Forwarded": forwardedMatcher
+//   - any other value: arbitraryMatcher
+func newDomainMatcher(headerName string) domainMatcher {
+	switch h := textproto.CanonicalMIMEHeaderKey(headerName); h {
+	case "", "Host":
+		return &hostMatcher{}
+	case "Forwarded":
+		return &forwardedMatcher{}
+	default:
+		return arbitraryMatcher(h)
+	}
+}
+
 // hostMatcher checks whether (*net/http).Request.Host starts with a domain name.
 type hostMatcher struct{}
 
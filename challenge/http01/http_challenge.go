@@ -13,7 +13,7 @@ type ValidateFunc func(core *api.Core, domain string, chlng acme.Challenge) erro
 
 // ChallengePath returns the URL path for the `http-01` challenge.
 func ChallengePath(token string) string {
-	return "/.well-known/acme-challenge/" + token
+	return challengePathPrefix + token
 }
 
 type Challenge struct {
@@ -36,7 +36,7 @@ func (c *Challenge) SetProvider(provider challenge.Provider) {
 
 func (c *Challenge) Solve(authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
-	log.Infof("[%s] acme: Trying to solve HTTP-01", domain)
+	log.Info("acme: Trying to solve HTTP-01", log.Domain(domain), log.ChallengeType(string(challenge.HTTP01)))
 
 	chlng, err := challenge.FindChallenge(challenge.HTTP01, authz)
 	if err != nil {
@@ -56,7 +56,7 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 	defer func() {
 		err := c.provider.CleanUp(authz.Identifier.Value, chlng.Token, keyAuth)
 		if err != nil {
-			log.Warnf("[%s] acme: cleaning up failed: %v", domain, err)
+			log.Warn(fmt.Sprintf("acme: cleaning up failed: %v", err), log.Domain(domain), log.ChallengeType(string(challenge.HTTP01)))
 		}
 	}()
 
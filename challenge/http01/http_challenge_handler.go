@@ -0,0 +1,114 @@
+package http01
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/log"
+)
+
+const challengePathPrefix = "/.well-known/acme-challenge/"
+
+// Handler implements ChallengeProvider for `http-01` challenge,
+// and serves the challenges through an existing HTTP server.
+//
+// It can be mounted in an http.ServeMux:
+//
+//	mux.Handle("/.well-known/acme-challenge/", handler)
+//
+// or used as a middleware in front of the application:
+//
+//	http.ListenAndServe(":80", http01.NewHandler(app))
+//
+// The requests that are not related to a pending challenge are passed to the next handler.
+type Handler struct {
+	next    http.Handler
+	matcher domainMatcher
+
+	mu     sync.RWMutex
+	tokens map[string]handlerChallenge
+}
+
+type handlerChallenge struct {
+	domain  string
+	keyAuth string
+}
+
+// NewHandler creates a new Handler.
+// The requests that are not related to a pending challenge are passed to next.
+// If next is nil, a 404 response is returned for those requests.
+func NewHandler(next http.Handler) *Handler {
+	if next == nil {
+		next = http.NotFoundHandler()
+	}
+
+	return &Handler{
+		next:    next,
+		matcher: &hostMatcher{},
+		tokens:  make(map[string]handlerChallenge),
+	}
+}
+
+// SetProxyHeader changes the validation of incoming requests.
+// See ProviderServer.SetProxyHeader for details.
+func (h *Handler) SetProxyHeader(headerName string) {
+	h.matcher = newDomainMatcher(headerName)
+}
+
+// Present makes the token available at `ChallengePath(token)` for web requests.
+func (h *Handler) Present(domain, token, keyAuth string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tokens[token] = handlerChallenge{domain: domain, keyAuth: keyAuth}
+
+	return nil
+}
+
+// CleanUp removes the token from `ChallengePath(token)`.
+func (h *Handler) CleanUp(_, token, _ string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.tokens, token)
+
+	return nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutPrefix(r.URL.Path, challengePathPrefix)
+	if !ok || r.Method != http.MethodGet {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	h.mu.RLock()
+	chlg, ok := h.tokens[token]
+	h.mu.RUnlock()
+
+	if !ok {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	// The incoming request is validated to prevent DNS rebind attacks.
+	if !h.matcher.matches(r, chlg.domain) {
+		log.Warnf("Received request for domain %s with method %s but the domain did not match any challenge. Please ensure you are passing the %s header properly.", r.Host, r.Method, h.matcher.name())
+
+		http.NotFound(w, r)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+
+	_, err := w.Write([]byte(chlg.keyAuth))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.Info("Served key authentication", log.Domain(chlg.domain), log.ChallengeType(string(challenge.HTTP01)))
+}
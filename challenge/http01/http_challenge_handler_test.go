@@ -0,0 +1,144 @@
+package http01
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	app := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("app"))
+	})
+
+	handler := NewHandler(app)
+
+	require.NoError(t, handler.Present("example.com", "token1", "keyAuth1"))
+	require.NoError(t, handler.Present("example.org", "token2", "keyAuth2"))
+
+	testCases := []struct {
+		desc         string
+		method       string
+		host         string
+		path         string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			desc:         "first token",
+			host:         "example.com",
+			path:         ChallengePath("token1"),
+			expectedCode: http.StatusOK,
+			expectedBody: "keyAuth1",
+		},
+		{
+			desc:         "second token",
+			host:         "example.org:80",
+			path:         ChallengePath("token2"),
+			expectedCode: http.StatusOK,
+			expectedBody: "keyAuth2",
+		},
+		{
+			desc:         "domain mismatch",
+			host:         "example.org",
+			path:         ChallengePath("token1"),
+			expectedCode: http.StatusNotFound,
+			expectedBody: "404 page not found\n",
+		},
+		{
+			desc:         "unknown token",
+			host:         "example.com",
+			path:         ChallengePath("unknown"),
+			expectedCode: http.StatusOK,
+			expectedBody: "app",
+		},
+		{
+			desc:         "not a challenge",
+			host:         "example.com",
+			path:         "/index.html",
+			expectedCode: http.StatusOK,
+			expectedBody: "app",
+		},
+		{
+			desc:         "POST",
+			method:       http.MethodPost,
+			host:         "example.com",
+			path:         ChallengePath("token1"),
+			expectedCode: http.StatusOK,
+			expectedBody: "app",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			method := test.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			req := httptest.NewRequest(method, test.path, http.NoBody)
+			req.Host = test.host
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.expectedCode, rec.Code)
+			assert.Equal(t, test.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+func TestHandler_CleanUp(t *testing.T) {
+	handler := NewHandler(nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("/.well-known/acme-challenge/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	require.NoError(t, handler.Present("127.0.0.1", "token", "keyAuth"))
+
+	resp, err := http.Get(server.URL + ChallengePath("token"))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "keyAuth", string(body))
+
+	require.NoError(t, handler.CleanUp("127.0.0.1", "token", "keyAuth"))
+
+	resp, err = http.Get(server.URL + ChallengePath("token"))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHandler_SetProxyHeader(t *testing.T) {
+	handler := NewHandler(nil)
+	handler.SetProxyHeader("Forwarded")
+
+	require.NoError(t, handler.Present("example.com", "token", "keyAuth"))
+
+	req := httptest.NewRequest(http.MethodGet, ChallengePath("token"), http.NoBody)
+	req.Host = "127.0.0.1"
+	req.Header.Set("Forwarded", "host=example.com;proto=http")
+
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "keyAuth", rec.Body.String())
+}
@@ -5,10 +5,10 @@ import (
 	"io/fs"
 	"net"
 	"net/http"
-	"net/textproto"
 	"os"
 	"strings"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/log"
 )
 
@@ -62,6 +62,16 @@ func (s *ProviderServer) Present(domain, token, keyAuth string) error {
 	return nil
 }
 
+// Check verifies that the web server can listen on its address.
+func (s *ProviderServer) Check(_ string) error {
+	listener, err := net.Listen(s.network, s.GetAddress())
+	if err != nil {
+		return fmt.Errorf("could not start HTTP server for challenge: %w", err)
+	}
+
+	return listener.Close()
+}
+
 func (s *ProviderServer) GetAddress() string {
 	return s.address
 }
@@ -92,14 +102,7 @@ func (s *ProviderServer) CleanUp(domain, token, keyAuth string) error {
 // - "Forwarded" will look for a Forwarded header, and inspect it according to https://www.rfc-editor.org/rfc/rfc7239.html
 // - any other value will check the header value with the same name.
 func (s *ProviderServer) SetProxyHeader(headerName string) {
-	switch h := textproto.CanonicalMIMEHeaderKey(headerName); h {
-	case "", "Host":
-		s.matcher = &hostMatcher{}
-	case "Forwarded":
-		s.matcher = &forwardedMatcher{}
-	default:
-		s.matcher = arbitraryMatcher(h)
-	}
+	s.matcher = newDomainMatcher(headerName)
 }
 
 func (s *ProviderServer) serve(domain, token, keyAuth string) {
@@ -119,7 +122,7 @@ func (s *ProviderServer) serve(domain, token, keyAuth string) {
 				return
 			}
 
-			log.Infof("[%s] Served key authentication", domain)
+			log.Info("Served key authentication", log.Domain(domain), log.ChallengeType(string(challenge.HTTP01)))
 			return
 		}
 
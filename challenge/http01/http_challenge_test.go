@@ -66,6 +66,22 @@ func TestProviderServer_GetAddress(t *testing.T) {
 	}
 }
 
+func TestProviderServer_Check(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	err = NewProviderServer("127.0.0.1", port).Check("example.com")
+	require.Error(t, err)
+
+	err = NewProviderServer("127.0.0.1", "0").Check("example.com")
+	require.NoError(t, err)
+}
+
 func TestChallenge(t *testing.T) {
 	_, apiURL := tester.SetupFakeAPI(t)
 
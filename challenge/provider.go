@@ -26,3 +26,34 @@ type ProviderTimeout interface {
 	Provider
 	Timeout() (timeout, interval time.Duration)
 }
+
+// BatchRecord is a challenge solution of a batch,
+// with the same parameters as the Present and CleanUp methods of a Provider.
+type BatchRecord struct {
+	Domain  string
+	Token   string
+	KeyAuth string
+}
+
+// BatchProvider allows for implementing a Provider
+// that presents and cleans up the challenges of an order in one call,
+// such as a DNS provider with an API supporting change batches.
+// If a Provider provides the PresentBatch and CleanUpBatch methods,
+// they are used instead of Present and CleanUp when several challenges are solved together.
+//
+// The challenges of a BatchProvider are always solved together,
+// even if the Provider is sequential.
+type BatchProvider interface {
+	Provider
+	PresentBatch(records []BatchRecord) error
+	CleanUpBatch(records []BatchRecord) error
+}
+
+// Checker allows for implementing a Provider
+// that verifies its configuration before any challenge is solved,
+// such as the validity of the credentials or the access to the zone of a domain.
+// The Check method must not leave any change behind.
+type Checker interface {
+	Provider
+	Check(domain string) error
+}
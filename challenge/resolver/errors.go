@@ -2,8 +2,11 @@ package resolver
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"sort"
+
+	"github.com/go-acme/lego/v4/challenge"
 )
 
 // obtainError is returned when there are specific errors available per domain.
@@ -23,3 +26,30 @@ func (e obtainError) Error() string {
 	}
 	return buffer.String()
 }
+
+// attemptError is the failure of an attempt to solve an authorization with a challenge type.
+type attemptError struct {
+	challengeType challenge.Type
+	err           error
+}
+
+func (e *attemptError) Error() string {
+	return fmt.Sprintf("%s: %v", e.challengeType, e.err)
+}
+
+func (e *attemptError) Unwrap() error {
+	return e.err
+}
+
+// mergeAttempts replaces the failures of the domains with several attempts by all their attempts.
+func mergeAttempts(failures obtainError, attempts map[string][]error) obtainError {
+	for domain, errs := range attempts {
+		if _, ok := failures[domain]; !ok || len(errs) < 2 {
+			continue
+		}
+
+		failures[domain] = errors.Join(errs...)
+	}
+
+	return failures
+}
@@ -0,0 +1,131 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
+)
+
+// PolicyRule defines the challenges used to validate the domains matching a pattern.
+type PolicyRule struct {
+	// Pattern is the domain pattern:
+	//   - "*": all the domains.
+	//   - "*.example.com": the wildcard domain "*.example.com" and all the subdomains of "example.com".
+	//   - "example.com": only the domain "example.com".
+	Pattern string
+
+	// Challenges are the challenge types to use, by order of preference.
+	// Only those challenge types are used for the matching domains.
+	// If empty, all the challenge types with a solver are used (default order).
+	Challenges []challenge.Type
+
+	// Providers are the providers to use for the matching domains, instead of the default providers.
+	Providers map[challenge.Type]challenge.Provider
+
+	// DNS01Options are the options of the DNS-01 provider defined in Providers.
+	// If nil, the options of SetDNS01Provider are used.
+	DNS01Options []dns01.ChallengeOption
+}
+
+// policyRule is a PolicyRule with its solvers.
+type policyRule struct {
+	pattern    string
+	challenges []challenge.Type
+	solvers    map[challenge.Type]solver
+}
+
+// matches checks whether the rule matches a domain (as returned by challenge.GetTargetedDomain).
+func (r policyRule) matches(domain string) bool {
+	domain = strings.ToLower(domain)
+
+	switch {
+	case r.pattern == "*":
+		return true
+	case strings.HasPrefix(r.pattern, "*."):
+		return domain == r.pattern || strings.HasSuffix(domain, r.pattern[1:])
+	default:
+		return domain == r.pattern
+	}
+}
+
+// SetPolicy defines the challenge selection policy.
+// The rules are evaluated in order, the first rule matching a domain is used.
+// The domains without a matching rule use the default behavior.
+//
+// The options of SetDNS01Provider are used by the DNS-01 providers of the rules without DNS01Options,
+// so SetPolicy must be called after SetDNS01Provider.
+func (c *SolverManager) SetPolicy(rules ...PolicyRule) error {
+	var policy []policyRule
+
+	for _, rule := range rules {
+		pattern := strings.ToLower(strings.TrimSuffix(rule.Pattern, "."))
+		if pattern == "" {
+			return errors.New("policy: empty domain pattern")
+		}
+
+		if pattern != "*" && strings.Contains(strings.TrimPrefix(pattern, "*."), "*") {
+			return fmt.Errorf("policy: invalid domain pattern %q", rule.Pattern)
+		}
+
+		for _, chlgType := range rule.Challenges {
+			if !isKnownChallenge(chlgType) {
+				return fmt.Errorf("policy: %s: unknown challenge type %q", rule.Pattern, chlgType)
+			}
+		}
+
+		pr := policyRule{
+			pattern:    pattern,
+			challenges: rule.Challenges,
+			solvers:    make(map[challenge.Type]solver),
+		}
+
+		for chlgType, provider := range rule.Providers {
+			switch chlgType {
+			case challenge.HTTP01:
+				pr.solvers[chlgType] = http01.NewChallenge(c.core, validate, provider)
+			case challenge.TLSALPN01:
+				pr.solvers[chlgType] = tlsalpn01.NewChallenge(c.core, validate, provider)
+			case challenge.DNS01:
+				opts := rule.DNS01Options
+				if opts == nil {
+					opts = c.dnsOptions
+				}
+
+				pr.solvers[chlgType] = dns01.NewChallenge(c.core, validate, provider, opts...)
+			default:
+				return fmt.Errorf("policy: %s: unknown challenge type %q", rule.Pattern, chlgType)
+			}
+		}
+
+		policy = append(policy, pr)
+	}
+
+	c.policy = policy
+
+	return nil
+}
+
+// findRule returns the first rule matching the domain.
+func (c *SolverManager) findRule(domain string) (policyRule, bool) {
+	for _, rule := range c.policy {
+		if rule.matches(domain) {
+			return rule, true
+		}
+	}
+
+	return policyRule{}, false
+}
+
+func isKnownChallenge(chlgType challenge.Type) bool {
+	switch chlgType {
+	case challenge.HTTP01, challenge.DNS01, challenge.TLSALPN01:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,179 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type noopProvider struct{}
+
+func (noopProvider) Present(_, _, _ string) error { return nil }
+func (noopProvider) CleanUp(_, _, _ string) error { return nil }
+
+func TestSolverManager_SetPolicy(t *testing.T) {
+	manager := NewSolversManager(nil)
+
+	require.NoError(t, manager.SetHTTP01Provider(noopProvider{}))
+	require.NoError(t, manager.SetTLSALPN01Provider(noopProvider{}))
+	require.NoError(t, manager.SetDNS01Provider(noopProvider{}))
+
+	err := manager.SetPolicy(
+		PolicyRule{Pattern: "internal.example.com", Challenges: []challenge.Type{challenge.DNS01}},
+		PolicyRule{
+			Pattern:    "*.example.org",
+			Challenges: []challenge.Type{challenge.DNS01},
+			Providers:  map[challenge.Type]challenge.Provider{challenge.DNS01: noopProvider{}},
+		},
+		PolicyRule{Pattern: "legacy.example.com", Challenges: []challenge.Type{challenge.TLSALPN01}},
+		PolicyRule{Pattern: "*", Challenges: []challenge.Type{challenge.HTTP01, challenge.DNS01}},
+	)
+	require.NoError(t, err)
+
+	allChallenges := []acme.Challenge{
+		{Type: challenge.HTTP01.String()},
+		{Type: challenge.DNS01.String()},
+		{Type: challenge.TLSALPN01.String()},
+	}
+
+	testCases := []struct {
+		desc     string
+		authz    acme.Authorization
+		expected func(t *testing.T, solvr solver)
+	}{
+		{
+			desc:  "exact match",
+			authz: acme.Authorization{Identifier: acme.Identifier{Value: "internal.example.com"}, Challenges: allChallenges},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.Same(t, manager.solvers[challenge.DNS01], solvr)
+			},
+		},
+		{
+			desc:  "specific provider for subdomains",
+			authz: acme.Authorization{Identifier: acme.Identifier{Value: "a.b.example.org"}, Challenges: allChallenges},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.IsType(t, &dns01.Challenge{}, solvr)
+				assert.NotSame(t, manager.solvers[challenge.DNS01], solvr)
+			},
+		},
+		{
+			desc: "specific provider for the wildcard",
+			authz: acme.Authorization{
+				Identifier: acme.Identifier{Value: "example.org"},
+				Wildcard:   true,
+				Challenges: []acme.Challenge{{Type: challenge.DNS01.String()}},
+			},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.IsType(t, &dns01.Challenge{}, solvr)
+				assert.NotSame(t, manager.solvers[challenge.DNS01], solvr)
+			},
+		},
+		{
+			desc:  "fallback rule",
+			authz: acme.Authorization{Identifier: acme.Identifier{Value: "www.example.com"}, Challenges: allChallenges},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.IsType(t, &http01.Challenge{}, solvr)
+			},
+		},
+		{
+			desc: "preference not offered",
+			authz: acme.Authorization{
+				Identifier: acme.Identifier{Value: "example.com"},
+				Wildcard:   true,
+				Challenges: []acme.Challenge{{Type: challenge.DNS01.String()}},
+			},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.Same(t, manager.solvers[challenge.DNS01], solvr)
+			},
+		},
+		{
+			desc: "no allowed challenge",
+			authz: acme.Authorization{
+				Identifier: acme.Identifier{Value: "legacy.example.com"},
+				Challenges: []acme.Challenge{{Type: challenge.HTTP01.String()}},
+			},
+			expected: func(t *testing.T, solvr solver) {
+				t.Helper()
+				assert.Nil(t, solvr)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			test.authz.Challenges = append([]acme.Challenge(nil), test.authz.Challenges...)
+
+			solvr, _ := manager.chooseSolver(test.authz)
+
+			test.expected(t, solvr)
+		})
+	}
+}
+
+func TestSolverManager_SetPolicy_withoutRules(t *testing.T) {
+	manager := NewSolversManager(nil)
+
+	require.NoError(t, manager.SetHTTP01Provider(noopProvider{}))
+	require.NoError(t, manager.SetTLSALPN01Provider(noopProvider{}))
+
+	require.NoError(t, manager.SetPolicy(PolicyRule{Pattern: "example.com"}))
+
+	authz := acme.Authorization{
+		Identifier: acme.Identifier{Value: "example.com"},
+		Challenges: []acme.Challenge{{Type: challenge.HTTP01.String()}, {Type: challenge.TLSALPN01.String()}},
+	}
+
+	solvr, chlgType := manager.chooseSolver(authz)
+
+	assert.IsType(t, &tlsalpn01.Challenge{}, solvr)
+	assert.Equal(t, challenge.TLSALPN01, chlgType)
+}
+
+func TestSolverManager_SetPolicy_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		rule     PolicyRule
+		expected string
+	}{
+		{
+			desc:     "empty pattern",
+			rule:     PolicyRule{},
+			expected: "policy: empty domain pattern",
+		},
+		{
+			desc:     "invalid pattern",
+			rule:     PolicyRule{Pattern: "a.*.example.com"},
+			expected: `policy: invalid domain pattern "a.*.example.com"`,
+		},
+		{
+			desc:     "unknown challenge",
+			rule:     PolicyRule{Pattern: "example.com", Challenges: []challenge.Type{"foo-01"}},
+			expected: `policy: example.com: unknown challenge type "foo-01"`,
+		},
+		{
+			desc:     "unknown provider challenge",
+			rule:     PolicyRule{Pattern: "example.com", Providers: map[challenge.Type]challenge.Provider{"foo-01": noopProvider{}}},
+			expected: `policy: example.com: unknown challenge type "foo-01"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewSolversManager(nil).SetPolicy(test.rule)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package resolver
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
@@ -29,12 +30,27 @@ type sequential interface {
 	Sequential() (bool, time.Duration)
 }
 
+// Interface for challenges like dns, where the records of several challenges can be set in one call,
+// and their propagation checked together.
+type batchPreSolver interface {
+	Batch() bool
+	PreSolveBatch(authorizations []acme.Authorization) error
+	SolveBatch(authorizations []acme.Authorization) map[string]error
+	CleanUpBatch(authorizations []acme.Authorization) error
+}
+
 // an authz with the solver we have chosen and the index of the challenge associated with it.
 type selectedAuthSolver struct {
 	authz  acme.Authorization
 	solver solver
 }
 
+// the authzs solved together by a batch solver.
+type batchAuthSolver struct {
+	authzs []acme.Authorization
+	solver batchPreSolver
+}
+
 type Prober struct {
 	solverManager *SolverManager
 }
@@ -48,7 +64,84 @@ func NewProber(solverManager *SolverManager) *Prober {
 // Solve Looks through the challenge combinations to find a solvable match.
 // Then solves the challenges in series and returns.
 func (p *Prober) Solve(authorizations []acme.Authorization) error {
+	failures, _ := p.solve(authorizations, nil)
+
+	// Be careful not to return an empty failures map,
+	// for even an empty obtainError is a non-nil error value
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
+
+// SolveWithFallback is like Solve,
+// but the failed authorizations are retried with the next challenge type they offer.
+//
+// A failed challenge invalidates its authorization (and the order),
+// so renew is called to get fresh authorizations (i.e. from a new order) before each retry.
+// The retry only happens when all the failed authorizations have another solvable challenge type.
+// All the attempts are recorded in the returned error.
+func (p *Prober) SolveWithFallback(authorizations []acme.Authorization, renew func() ([]acme.Authorization, error)) error {
+	tried := make(map[string][]challenge.Type)
+	attempts := make(map[string][]error)
+
+	for {
+		failures, used := p.solve(authorizations, tried)
+		if len(failures) == 0 {
+			return nil
+		}
+
+		retry := true
+
+		for _, authz := range authorizations {
+			domain := challenge.GetTargetedDomain(authz)
+
+			err, ok := failures[domain]
+			if !ok {
+				continue
+			}
+
+			chlgType, ok := used[domain]
+			if !ok {
+				// No solver.
+				retry = false
+				continue
+			}
+
+			attempts[domain] = append(attempts[domain], &attemptError{challengeType: chlgType, err: err})
+			tried[domain] = append(tried[domain], chlgType)
+
+			if !p.solverManager.hasSolver(withoutChallenges(authz, tried[domain])) {
+				retry = false
+			}
+		}
+
+		if !retry {
+			return mergeAttempts(failures, attempts)
+		}
+
+		for domain := range failures {
+			log.Warn(fmt.Sprintf("acme: %s failed, retrying with another challenge type", used[domain]), log.Domain(domain), log.ChallengeType(string(used[domain])))
+		}
+
+		var err error
+
+		authorizations, err = renew()
+		if err != nil {
+			for domain := range failures {
+				attempts[domain] = append(attempts[domain], fmt.Errorf("[%s] acme: unable to retry with another challenge type: %w", domain, err))
+			}
+
+			return mergeAttempts(failures, attempts)
+		}
+	}
+}
+
+// solve solves the authorizations, the challenge types already tried for a domain are not used.
+// It returns the failures and the challenge type used for each domain.
+func (p *Prober) solve(authorizations []acme.Authorization, tried map[string][]challenge.Type) (obtainError, map[string]challenge.Type) {
 	failures := make(obtainError)
+	used := make(map[string]challenge.Type)
 
 	var authSolvers []*selectedAuthSolver
 	var authSolversSequential []*selectedAuthSolver
@@ -59,12 +152,21 @@ func (p *Prober) Solve(authorizations []acme.Authorization) error {
 		domain := challenge.GetTargetedDomain(authz)
 		if authz.Status == acme.StatusValid {
 			// Boulder might recycle recent validated authz (see issue #267)
-			log.Infof("[%s] acme: authorization already valid; skipping challenge", domain)
+			log.Info("acme: authorization already valid; skipping challenge", log.Domain(domain))
 			continue
 		}
 
-		if solvr := p.solverManager.chooseSolver(authz); solvr != nil {
+		authz = withoutChallenges(authz, tried[domain])
+
+		if solvr, chlgType := p.solverManager.chooseSolver(authz); solvr != nil {
 			authSolver := &selectedAuthSolver{authz: authz, solver: solvr}
+			used[domain] = chlgType
+
+			// The challenges of a batch solver are presented in one call, so they are always solved together.
+			if s, ok := solvr.(batchPreSolver); ok && s.Batch() {
+				authSolvers = append(authSolvers, authSolver)
+				continue
+			}
 
 			switch s := solvr.(type) {
 			case sequential:
@@ -85,12 +187,7 @@ func (p *Prober) Solve(authorizations []acme.Authorization) error {
 
 	sequentialSolve(authSolversSequential, failures)
 
-	// Be careful not to return an empty failures map,
-	// for even an empty obtainError is a non-nil error value
-	if len(failures) > 0 {
-		return failures
-	}
-	return nil
+	return failures, used
 }
 
 func sequentialSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
@@ -128,8 +225,19 @@ func sequentialSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
 }
 
 func parallelSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
+	batches, others := groupBatches(authSolvers)
+
 	// For all valid preSolvers, first submit the challenges, so they have max time to propagate
-	for _, authSolver := range authSolvers {
+	for _, batch := range batches {
+		err := batch.solver.PreSolveBatch(batch.authzs)
+		if err != nil {
+			for _, authz := range batch.authzs {
+				failures[challenge.GetTargetedDomain(authz)] = err
+			}
+		}
+	}
+
+	for _, authSolver := range others {
 		authz := authSolver.authz
 		if solvr, ok := authSolver.solver.(preSolver); ok {
 			err := solvr.PreSolve(authz)
@@ -141,13 +249,35 @@ func parallelSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
 
 	defer func() {
 		// Clean all created TXT records
-		for _, authSolver := range authSolvers {
+		for _, batch := range batches {
+			err := batch.solver.CleanUpBatch(batch.authzs)
+			if err != nil {
+				log.Warnf("acme: cleaning up failed: %v", err)
+			}
+		}
+
+		for _, authSolver := range others {
 			cleanUp(authSolver.solver, authSolver.authz)
 		}
 	}()
 
 	// Finally solve all challenges for real
-	for _, authSolver := range authSolvers {
+	for _, batch := range batches {
+		// The challenges of a batch wait for the propagation together.
+		authzs := slices.DeleteFunc(slices.Clone(batch.authzs), func(authz acme.Authorization) bool {
+			return failures[challenge.GetTargetedDomain(authz)] != nil
+		})
+
+		if len(authzs) == 0 {
+			continue
+		}
+
+		for domain, err := range batch.solver.SolveBatch(authzs) {
+			failures[domain] = err
+		}
+	}
+
+	for _, authSolver := range others {
 		authz := authSolver.authz
 		domain := challenge.GetTargetedDomain(authz)
 		if failures[domain] != nil {
@@ -162,12 +292,69 @@ func parallelSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
 	}
 }
 
+// groupBatches groups the authzs of the batch solvers.
+// The authzs of a batch solver used only once are returned with the other authzs.
+func groupBatches(authSolvers []*selectedAuthSolver) ([]*batchAuthSolver, []*selectedAuthSolver) {
+	var candidates []*batchAuthSolver
+
+	index := make(map[batchPreSolver]*batchAuthSolver)
+
+	for _, authSolver := range authSolvers {
+		solvr, ok := authSolver.solver.(batchPreSolver)
+		if !ok || !solvr.Batch() {
+			continue
+		}
+
+		batch, ok := index[solvr]
+		if !ok {
+			batch = &batchAuthSolver{solver: solvr}
+			index[solvr] = batch
+			candidates = append(candidates, batch)
+		}
+
+		batch.authzs = append(batch.authzs, authSolver.authz)
+	}
+
+	var batches []*batchAuthSolver
+
+	for _, batch := range candidates {
+		if len(batch.authzs) > 1 {
+			batches = append(batches, batch)
+		}
+	}
+
+	var others []*selectedAuthSolver
+
+	for _, authSolver := range authSolvers {
+		if solvr, ok := authSolver.solver.(batchPreSolver); ok && solvr.Batch() && len(index[solvr].authzs) > 1 {
+			continue
+		}
+
+		others = append(others, authSolver)
+	}
+
+	return batches, others
+}
+
 func cleanUp(solvr solver, authz acme.Authorization) {
 	if solvr, ok := solvr.(cleanup); ok {
 		domain := challenge.GetTargetedDomain(authz)
 		err := solvr.CleanUp(authz)
 		if err != nil {
-			log.Warnf("[%s] acme: cleaning up failed: %v ", domain, err)
+			log.Warn(fmt.Sprintf("acme: cleaning up failed: %v ", err), log.Domain(domain))
 		}
 	}
 }
+
+// withoutChallenges returns a copy of the authorization without the challenges of the specified types.
+func withoutChallenges(authz acme.Authorization, types []challenge.Type) acme.Authorization {
+	if len(types) == 0 {
+		return authz
+	}
+
+	authz.Challenges = slices.DeleteFunc(slices.Clone(authz.Challenges), func(chlg acme.Challenge) bool {
+		return slices.Contains(types, challenge.Type(chlg.Type))
+	})
+
+	return authz
+}
@@ -25,6 +25,58 @@ func (s *preSolverMock) CleanUp(authorization acme.Authorization) error {
 	return s.cleanUp[authorization.Identifier.Value]
 }
 
+type batchSolverMock struct {
+	preSolverMock
+
+	sequential bool
+
+	presolveBatchErr error
+	batches          [][]string
+	solveBatches     [][]string
+	cleanUpBatches   [][]string
+}
+
+func (s *batchSolverMock) Batch() bool {
+	return true
+}
+
+func (s *batchSolverMock) Sequential() (bool, time.Duration) {
+	return s.sequential, time.Hour
+}
+
+func (s *batchSolverMock) PreSolveBatch(authorizations []acme.Authorization) error {
+	s.batches = append(s.batches, domainsOf(authorizations))
+	return s.presolveBatchErr
+}
+
+func (s *batchSolverMock) SolveBatch(authorizations []acme.Authorization) map[string]error {
+	s.solveBatches = append(s.solveBatches, domainsOf(authorizations))
+
+	failures := make(map[string]error)
+
+	for _, authz := range authorizations {
+		if err := s.solve[authz.Identifier.Value]; err != nil {
+			failures[authz.Identifier.Value] = err
+		}
+	}
+
+	return failures
+}
+
+func (s *batchSolverMock) CleanUpBatch(authorizations []acme.Authorization) error {
+	s.cleanUpBatches = append(s.cleanUpBatches, domainsOf(authorizations))
+	return nil
+}
+
+func domainsOf(authorizations []acme.Authorization) []string {
+	var domains []string
+	for _, authz := range authorizations {
+		domains = append(domains, authz.Identifier.Value)
+	}
+
+	return domains
+}
+
 func createStubAuthorizationHTTP01(domain, status string) acme.Authorization {
 	return acme.Authorization{
 		Status:  status,
@@ -42,3 +94,20 @@ func createStubAuthorizationHTTP01(domain, status string) acme.Authorization {
 		},
 	}
 }
+
+func createStubAuthorization(domain string, types ...challenge.Type) acme.Authorization {
+	authz := acme.Authorization{
+		Status:  acme.StatusPending,
+		Expires: time.Now(),
+		Identifier: acme.Identifier{
+			Type:  "dns",
+			Value: domain,
+		},
+	}
+
+	for _, typ := range types {
+		authz.Challenges = append(authz.Challenges, acme.Challenge{Type: typ.String()})
+	}
+
+	return authz
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/challenge"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -115,3 +116,203 @@ func TestProber_Solve(t *testing.T) {
 		})
 	}
 }
+
+func TestProber_Solve_batch(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		solver        *batchSolverMock
+		authz         []acme.Authorization
+		expectedBatch [][]string
+		expectedSolve [][]string
+		expectedError string
+	}{
+		{
+			desc:   "batch",
+			solver: &batchSolverMock{},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.DNS01),
+				createStubAuthorization("lego.wtf", challenge.DNS01),
+				createStubAuthorization("mydomain.wtf", challenge.DNS01),
+			},
+			expectedBatch: [][]string{{"acme.wtf", "lego.wtf", "mydomain.wtf"}},
+			expectedSolve: [][]string{{"acme.wtf", "lego.wtf", "mydomain.wtf"}},
+		},
+		{
+			desc:   "sequential batch",
+			solver: &batchSolverMock{sequential: true},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.DNS01),
+				createStubAuthorization("lego.wtf", challenge.DNS01),
+			},
+			expectedBatch: [][]string{{"acme.wtf", "lego.wtf"}},
+			expectedSolve: [][]string{{"acme.wtf", "lego.wtf"}},
+		},
+		{
+			desc:   "single authorization",
+			solver: &batchSolverMock{},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.DNS01),
+			},
+		},
+		{
+			desc:   "batch error",
+			solver: &batchSolverMock{presolveBatchErr: errors.New("batch error")},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.DNS01),
+				createStubAuthorization("lego.wtf", challenge.DNS01),
+			},
+			expectedBatch: [][]string{{"acme.wtf", "lego.wtf"}},
+			expectedError: `error: one or more domains had a problem:
+[acme.wtf] batch error
+[lego.wtf] batch error
+`,
+		},
+		{
+			desc: "solve error",
+			solver: &batchSolverMock{preSolverMock: preSolverMock{
+				solve: map[string]error{"lego.wtf": errors.New("propagation error")},
+			}},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.DNS01),
+				createStubAuthorization("lego.wtf", challenge.DNS01),
+			},
+			expectedBatch: [][]string{{"acme.wtf", "lego.wtf"}},
+			expectedSolve: [][]string{{"acme.wtf", "lego.wtf"}},
+			expectedError: `error: one or more domains had a problem:
+[lego.wtf] propagation error
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			prober := &Prober{
+				solverManager: &SolverManager{solvers: map[challenge.Type]solver{challenge.DNS01: test.solver}},
+			}
+
+			err := prober.Solve(test.authz)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedBatch, test.solver.batches)
+			assert.Equal(t, test.expectedSolve, test.solver.solveBatches)
+			assert.Equal(t, test.expectedBatch, test.solver.cleanUpBatches)
+		})
+	}
+}
+
+func TestProber_SolveWithFallback(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		solvers       map[challenge.Type]solver
+		authz         []acme.Authorization
+		expectedRenew int
+		expectedError string
+	}{
+		{
+			desc: "fallback succeeds",
+			solvers: map[challenge.Type]solver{
+				challenge.TLSALPN01: &preSolverMock{
+					solve: map[string]error{"acme.wtf": errors.New("solve error acme.wtf")},
+				},
+				challenge.HTTP01: &preSolverMock{},
+			},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.TLSALPN01, challenge.HTTP01),
+				createStubAuthorization("lego.wtf", challenge.TLSALPN01, challenge.HTTP01),
+			},
+			expectedRenew: 1,
+		},
+		{
+			desc: "all the attempts fail",
+			solvers: map[challenge.Type]solver{
+				challenge.TLSALPN01: &preSolverMock{
+					solve: map[string]error{"acme.wtf": errors.New("solve error tls-alpn-01")},
+				},
+				challenge.HTTP01: &preSolverMock{
+					solve: map[string]error{"acme.wtf": errors.New("solve error http-01")},
+				},
+			},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.TLSALPN01, challenge.HTTP01),
+			},
+			expectedRenew: 1,
+			expectedError: `error: one or more domains had a problem:
+[acme.wtf] tls-alpn-01: solve error tls-alpn-01
+http-01: solve error http-01
+`,
+		},
+		{
+			desc: "no alternative",
+			solvers: map[challenge.Type]solver{
+				challenge.TLSALPN01: &preSolverMock{
+					solve: map[string]error{
+						"acme.wtf": errors.New("solve error acme.wtf"),
+						"lego.wtf": errors.New("solve error lego.wtf"),
+					},
+				},
+				challenge.HTTP01: &preSolverMock{},
+			},
+			authz: []acme.Authorization{
+				createStubAuthorization("acme.wtf", challenge.TLSALPN01, challenge.HTTP01),
+				createStubAuthorization("lego.wtf", challenge.TLSALPN01),
+			},
+			expectedError: `error: one or more domains had a problem:
+[acme.wtf] solve error acme.wtf
+[lego.wtf] solve error lego.wtf
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			prober := &Prober{
+				solverManager: &SolverManager{solvers: test.solvers},
+			}
+
+			var renew int
+
+			err := prober.SolveWithFallback(test.authz, func() ([]acme.Authorization, error) {
+				renew++
+				return test.authz, nil
+			})
+
+			assert.Equal(t, test.expectedRenew, renew)
+
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProber_SolveWithFallback_renewError(t *testing.T) {
+	prober := &Prober{
+		solverManager: &SolverManager{solvers: map[challenge.Type]solver{
+			challenge.TLSALPN01: &preSolverMock{
+				solve: map[string]error{"acme.wtf": errors.New("solve error acme.wtf")},
+			},
+			challenge.HTTP01: &preSolverMock{},
+		}},
+	}
+
+	authz := []acme.Authorization{createStubAuthorization("acme.wtf", challenge.TLSALPN01, challenge.HTTP01)}
+
+	err := prober.SolveWithFallback(authz, func() ([]acme.Authorization, error) {
+		return nil, errors.New("new order error")
+	})
+
+	require.EqualError(t, err, `error: one or more domains had a problem:
+[acme.wtf] tls-alpn-01: solve error acme.wtf
+[acme.wtf] acme: unable to retry with another challenge type: new order error
+`)
+}
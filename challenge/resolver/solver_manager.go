@@ -26,6 +26,9 @@ func (a byType) Less(i, j int) bool { return a[i].Type > a[j].Type }
 type SolverManager struct {
 	core    *api.Core
 	solvers map[challenge.Type]solver
+
+	dnsOptions []dns01.ChallengeOption
+	policy     []policyRule
 }
 
 func NewSolversManager(core *api.Core) *SolverManager {
@@ -50,6 +53,7 @@ func (c *SolverManager) SetTLSALPN01Provider(p challenge.Provider) error {
 // SetDNS01Provider specifies a custom provider p that can solve the given DNS-01 challenge.
 func (c *SolverManager) SetDNS01Provider(p challenge.Provider, opts ...dns01.ChallengeOption) error {
 	c.solvers[challenge.DNS01] = dns01.NewChallenge(c.core, validate, p, opts...)
+	c.dnsOptions = opts
 	return nil
 }
 
@@ -58,21 +62,86 @@ func (c *SolverManager) Remove(chlgType challenge.Type) {
 	delete(c.solvers, chlgType)
 }
 
-// Checks all challenges from the server in order and returns the first matching solver.
-func (c *SolverManager) chooseSolver(authz acme.Authorization) solver {
+// Checks all challenges from the server in order and returns the first matching solver, and its challenge type.
+func (c *SolverManager) chooseSolver(authz acme.Authorization) (solver, challenge.Type) {
+	domain := challenge.GetTargetedDomain(authz)
+
+	types, rule := c.preferences(authz)
+
+	for _, chlgType := range types {
+		if _, err := challenge.FindChallenge(chlgType, authz); err != nil {
+			log.Info("acme: Challenge not offered by the server: "+chlgType.String(), log.Domain(domain), log.ChallengeType(chlgType.String()))
+			continue
+		}
+
+		solvr, ok := c.lookup(rule, chlgType)
+		if !ok {
+			log.Info("acme: Could not find solver for: "+chlgType.String(), log.Domain(domain), log.ChallengeType(chlgType.String()))
+			continue
+		}
+
+		if rule != nil {
+			log.Info(fmt.Sprintf("acme: use %s solver (policy %q)", chlgType, rule.pattern), log.Domain(domain), log.ChallengeType(chlgType.String()))
+		} else {
+			log.Info(fmt.Sprintf("acme: use %s solver", chlgType), log.Domain(domain), log.ChallengeType(chlgType.String()))
+		}
+
+		return solvr, chlgType
+	}
+
+	return nil, ""
+}
+
+// hasSolver checks if a solver can be chosen for the authorization.
+func (c *SolverManager) hasSolver(authz acme.Authorization) bool {
+	types, rule := c.preferences(authz)
+
+	for _, chlgType := range types {
+		if _, err := challenge.FindChallenge(chlgType, authz); err != nil {
+			continue
+		}
+
+		if _, ok := c.lookup(rule, chlgType); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// preferences returns the challenge types to try in order, and the matching policy rule (if any).
+func (c *SolverManager) preferences(authz acme.Authorization) ([]challenge.Type, *policyRule) {
 	// Allow to have a deterministic challenge order
 	sort.Sort(byType(authz.Challenges))
 
-	domain := challenge.GetTargetedDomain(authz)
+	rule, ok := c.findRule(challenge.GetTargetedDomain(authz))
+	if ok && len(rule.challenges) > 0 {
+		return rule.challenges, &rule
+	}
+
+	var types []challenge.Type
 	for _, chlg := range authz.Challenges {
-		if solvr, ok := c.solvers[challenge.Type(chlg.Type)]; ok {
-			log.Infof("[%s] acme: use %s solver", domain, chlg.Type)
-			return solvr
+		types = append(types, challenge.Type(chlg.Type))
+	}
+
+	if ok {
+		return types, &rule
+	}
+
+	return types, nil
+}
+
+// lookup returns the solver for a challenge type: the solver of the policy rule, or the default solver.
+func (c *SolverManager) lookup(rule *policyRule, chlgType challenge.Type) (solver, bool) {
+	if rule != nil {
+		if solvr, ok := rule.solvers[chlgType]; ok {
+			return solvr, true
 		}
-		log.Infof("[%s] acme: Could not find solver for: %s", domain, chlg.Type)
 	}
 
-	return nil
+	solvr, ok := c.solvers[chlgType]
+
+	return solvr, ok
 }
 
 func validate(core *api.Core, domain string, chlg acme.Challenge) error {
@@ -87,7 +156,7 @@ func validate(core *api.Core, domain string, chlg acme.Challenge) error {
 	}
 
 	if valid {
-		log.Infof("[%s] The server validated our request", domain)
+		log.Info("The server validated our request", log.Domain(domain), log.ChallengeType(chlng.Type), log.AuthzURL(chlng.AuthorizationURL))
 		return nil
 	}
 
@@ -120,7 +189,7 @@ func validate(core *api.Core, domain string, chlg acme.Challenge) error {
 		}
 
 		if valid {
-			log.Infof("[%s] The server validated our request", domain)
+			log.Info("The server validated our request", log.Domain(domain), log.ChallengeType(chlng.Type), log.AuthzURL(chlng.AuthorizationURL))
 			return nil
 		}
 
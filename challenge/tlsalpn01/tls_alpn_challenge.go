@@ -7,12 +7,15 @@ import (
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/log"
+	"github.com/miekg/dns"
 )
 
 // idPeAcmeIdentifierV1 is the SMI Security for PKIX Certification Extension OID referencing the ACME extension.
@@ -42,7 +45,7 @@ func (c *Challenge) SetProvider(provider challenge.Provider) {
 // Solve manages the provider to validate and solve the challenge.
 func (c *Challenge) Solve(authz acme.Authorization) error {
 	domain := authz.Identifier.Value
-	log.Infof("[%s] acme: Trying to solve TLS-ALPN-01", challenge.GetTargetedDomain(authz))
+	log.Info("acme: Trying to solve TLS-ALPN-01", log.Domain(challenge.GetTargetedDomain(authz)), log.ChallengeType(string(challenge.TLSALPN01)))
 
 	chlng, err := challenge.FindChallenge(challenge.TLSALPN01, authz)
 	if err != nil {
@@ -62,7 +65,7 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 	defer func() {
 		err := c.provider.CleanUp(domain, chlng.Token, keyAuth)
 		if err != nil {
-			log.Warnf("[%s] acme: cleaning up failed: %v", challenge.GetTargetedDomain(authz), err)
+			log.Warn(fmt.Sprintf("acme: cleaning up failed: %v", err), log.Domain(challenge.GetTargetedDomain(authz)), log.ChallengeType(string(challenge.TLSALPN01)))
 		}
 	}()
 
@@ -70,8 +73,25 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 	return c.validate(c.core, domain, chlng)
 }
 
+// ServerName returns the TLS server name (SNI) used by the ACME server to validate the `tls-alpn-01` challenge of an identifier.
+// For an IP address, it's the reverse-DNS form (`in-addr.arpa` or `ip6.arpa`) without the trailing dot.
+// Reference: https://www.rfc-editor.org/rfc/rfc8738.html#section-6
+func ServerName(domain string) string {
+	if net.ParseIP(domain) == nil {
+		return strings.ToLower(strings.TrimSuffix(domain, "."))
+	}
+
+	reverse, err := dns.ReverseAddr(domain)
+	if err != nil {
+		return domain
+	}
+
+	return strings.TrimSuffix(reverse, ".")
+}
+
 // ChallengeBlocks returns PEM blocks (certPEMBlock, keyPEMBlock) with the acmeValidation-v1 extension
 // and domain name for the `tls-alpn-01` challenge.
+// For an IP address, the certificate contains an IP address SAN instead of a DNS name.
 func ChallengeBlocks(domain, keyAuth string) ([]byte, []byte, error) {
 	// Compute the SHA-256 digest of the key authorization.
 	zBytes := sha256.Sum256([]byte(keyAuth))
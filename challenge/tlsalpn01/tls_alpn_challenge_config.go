@@ -0,0 +1,123 @@
+package tlsalpn01
+
+import (
+	"crypto/tls"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ConfigProvider implements ChallengeProvider for `TLS-ALPN-01` challenge.
+// The challenge certificates are kept in memory,
+// and served through the tls.Config of an existing TLS server.
+//
+// The simplest integration is to wrap the configuration of the application:
+//
+//	server.TLSConfig = provider.TLSConfig(server.TLSConfig)
+//
+// or to use the GetConfigForClient (or GetCertificate) wrappers directly.
+type ConfigProvider struct {
+	mu    sync.RWMutex
+	certs map[string]*tls.Certificate
+}
+
+// NewConfigProvider creates a new ConfigProvider.
+func NewConfigProvider() *ConfigProvider {
+	return &ConfigProvider{certs: make(map[string]*tls.Certificate)}
+}
+
+// Present generates the challenge certificate and keeps it in memory.
+func (p *ConfigProvider) Present(domain, _, keyAuth string) error {
+	cert, err := ChallengeCert(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.certs[ServerName(domain)] = cert
+
+	return nil
+}
+
+// CleanUp removes the challenge certificate.
+func (p *ConfigProvider) CleanUp(domain, _, _ string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.certs, ServerName(domain))
+
+	return nil
+}
+
+// TLSConfig returns a copy of base that serves the challenge certificates.
+// base can be nil.
+func (p *ConfigProvider) TLSConfig(base *tls.Config) *tls.Config {
+	var config *tls.Config
+	if base == nil {
+		config = &tls.Config{MinVersion: tls.VersionTLS12}
+	} else {
+		config = base.Clone()
+	}
+
+	config.GetConfigForClient = p.GetConfigForClient(config.GetConfigForClient)
+
+	return config
+}
+
+// GetConfigForClient wraps a tls.Config.GetConfigForClient function.
+// When the client requests the `acme-tls/1` protocol and a challenge is pending for the server name,
+// a configuration serving only the challenge certificate is returned.
+// Otherwise, the call is delegated to next.
+// If next is nil, the original configuration is used.
+func (p *ConfigProvider) GetConfigForClient(next func(*tls.ClientHelloInfo) (*tls.Config, error)) func(*tls.ClientHelloInfo) (*tls.Config, error) {
+	return func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		if cert := p.challengeCert(hello); cert != nil {
+			return &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{ACMETLS1Protocol},
+				MinVersion:   tls.VersionTLS12,
+			}, nil
+		}
+
+		if next == nil {
+			return nil, nil
+		}
+
+		return next(hello)
+	}
+}
+
+// GetCertificate wraps a tls.Config.GetCertificate function.
+// When the client requests the `acme-tls/1` protocol and a challenge is pending for the server name,
+// the challenge certificate is returned.
+// Otherwise, the call is delegated to next.
+// If next is nil, the certificates of the original configuration are used.
+//
+// The `acme-tls/1` protocol must be in the NextProtos of the configuration.
+func (p *ConfigProvider) GetCertificate(next func(*tls.ClientHelloInfo) (*tls.Certificate, error)) func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if cert := p.challengeCert(hello); cert != nil {
+			return cert, nil
+		}
+
+		if next == nil {
+			return nil, nil
+		}
+
+		return next(hello)
+	}
+}
+
+// challengeCert returns the challenge certificate matching the ClientHello, or nil.
+func (p *ConfigProvider) challengeCert(hello *tls.ClientHelloInfo) *tls.Certificate {
+	if !slices.Contains(hello.SupportedProtos, ACMETLS1Protocol) {
+		return nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.certs[strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))]
+}
@@ -0,0 +1,155 @@
+package tlsalpn01
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigProvider(t *testing.T) {
+	appCert, err := ChallengeCert("app.example.com", "app")
+	require.NoError(t, err)
+
+	provider := NewConfigProvider()
+
+	config := provider.TLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{*appCert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	})
+
+	addr := serveTLS(t, config)
+
+	require.NoError(t, provider.Present("example.com", "token1", "keyAuth1"))
+	require.NoError(t, provider.Present("example.org", "token2", "keyAuth2"))
+	require.NoError(t, provider.Present("192.0.2.1", "token3", "keyAuth3"))
+
+	testCases := []struct {
+		desc             string
+		serverName       string
+		protos           []string
+		expectedName     string
+		expectedProtocol string
+	}{
+		{
+			desc:             "challenge",
+			serverName:       "example.com",
+			protos:           []string{ACMETLS1Protocol},
+			expectedName:     "example.com",
+			expectedProtocol: ACMETLS1Protocol,
+		},
+		{
+			desc:             "second challenge",
+			serverName:       "EXAMPLE.org",
+			protos:           []string{ACMETLS1Protocol},
+			expectedName:     "example.org",
+			expectedProtocol: ACMETLS1Protocol,
+		},
+		{
+			desc:             "IP address",
+			serverName:       "1.2.0.192.in-addr.arpa",
+			protos:           []string{ACMETLS1Protocol},
+			expectedName:     "192.0.2.1",
+			expectedProtocol: ACMETLS1Protocol,
+		},
+		{
+			desc:             "application",
+			serverName:       "example.com",
+			protos:           []string{"h2"},
+			expectedName:     "app.example.com",
+			expectedProtocol: "h2",
+		},
+		{
+			desc:             "no pending challenge",
+			serverName:       "example.net",
+			protos:           []string{ACMETLS1Protocol, "h2"},
+			expectedName:     "app.example.com",
+			expectedProtocol: "h2",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cert, protocol := handshake(t, addr, test.serverName, test.protos)
+
+			names := cert.DNSNames
+			for _, ip := range cert.IPAddresses {
+				names = append(names, ip.String())
+			}
+
+			assert.Equal(t, []string{test.expectedName}, names)
+			assert.Equal(t, test.expectedProtocol, protocol)
+		})
+	}
+}
+
+func TestConfigProvider_CleanUp(t *testing.T) {
+	appCert, err := ChallengeCert("app.example.com", "app")
+	require.NoError(t, err)
+
+	provider := NewConfigProvider()
+
+	// The GetCertificate wrapper requires the acme-tls/1 protocol in NextProtos.
+	addr := serveTLS(t, &tls.Config{
+		GetCertificate: provider.GetCertificate(func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return appCert, nil
+		}),
+		NextProtos: []string{"http/1.1", ACMETLS1Protocol},
+	})
+
+	require.NoError(t, provider.Present("example.com", "token", "keyAuth"))
+
+	cert, _ := handshake(t, addr, "example.com", []string{ACMETLS1Protocol})
+	assert.Equal(t, []string{"example.com"}, cert.DNSNames)
+
+	require.NoError(t, provider.CleanUp("example.com", "token", "keyAuth"))
+
+	cert, _ = handshake(t, addr, "example.com", []string{ACMETLS1Protocol})
+	assert.Equal(t, []string{"app.example.com"}, cert.DNSNames)
+}
+
+func serveTLS(t *testing.T, config *tls.Config) string {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_ = conn.(*tls.Conn).Handshake()
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func handshake(t *testing.T, addr, serverName string, protos []string) (*x509.Certificate, string) {
+	t.Helper()
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{
+		ServerName:         serverName,
+		NextProtos:         protos,
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	state := conn.ConnectionState()
+
+	return state.PeerCertificates[0], state.NegotiatedProtocol
+}
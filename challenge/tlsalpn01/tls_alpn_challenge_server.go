@@ -54,10 +54,19 @@ func (s *ProviderServer) Present(domain, token, keyAuth string) error {
 		return err
 	}
 
-	// Place the generated certificate with the extension into the TLS config
-	// so that it can serve the correct details.
+	// Serve the generated certificate with the extension,
+	// only when the SNI matches the identifier (reverse-DNS form for an IP address).
+	// Reference: https://www.rfc-editor.org/rfc/rfc8737.html#section-3
+	serverName := ServerName(domain)
+
 	tlsConf := new(tls.Config)
-	tlsConf.Certificates = []tls.Certificate{*cert}
+	tlsConf.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if !strings.EqualFold(strings.TrimSuffix(hello.ServerName, "."), serverName) {
+			return nil, fmt.Errorf("no tls-alpn-01 challenge for the server name %q", hello.ServerName)
+		}
+
+		return cert, nil
+	}
 
 	// We must set that the `acme-tls/1` application level protocol is supported
 	// so that the protocol negotiation can succeed. Reference:
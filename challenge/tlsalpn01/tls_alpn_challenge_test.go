@@ -191,3 +191,64 @@ func TestChallengeIPaddress(t *testing.T) {
 
 	require.NoError(t, solver.Solve(authz))
 }
+
+func TestServerName(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		domain   string
+		expected string
+	}{
+		{
+			desc:     "domain",
+			domain:   "Example.com.",
+			expected: "example.com",
+		},
+		{
+			desc:     "IPv4",
+			domain:   "192.0.2.1",
+			expected: "1.2.0.192.in-addr.arpa",
+		},
+		{
+			desc:     "IPv6",
+			domain:   "2001:db8::1",
+			expected: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, ServerName(test.domain))
+		})
+	}
+}
+
+func TestProviderServer_serverNameMismatch(t *testing.T) {
+	server := NewProviderServer("127.0.0.1", "24458")
+
+	require.NoError(t, server.Present("127.0.0.1", "token", "keyAuth"))
+
+	t.Cleanup(func() { _ = server.CleanUp("127.0.0.1", "token", "keyAuth") })
+
+	_, err := tls.Dial("tcp", server.GetAddress(), &tls.Config{
+		ServerName:         "example.com",
+		NextProtos:         []string{ACMETLS1Protocol},
+		InsecureSkipVerify: true,
+	})
+	require.Error(t, err)
+
+	conn, err := tls.Dial("tcp", server.GetAddress(), &tls.Config{
+		ServerName:         ServerName("127.0.0.1"),
+		NextProtos:         []string{ACMETLS1Protocol},
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	state := conn.ConnectionState()
+
+	require.Len(t, state.PeerCertificates, 1)
+	assert.True(t, net.ParseIP("127.0.0.1").Equal(state.PeerCertificates[0].IPAddresses[0]))
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/lego"
@@ -22,6 +23,7 @@ const (
 	baseAccountsRootFolderName = "accounts"
 	baseKeysFolderName         = "keys"
 	accountFileName            = "account.json"
+	accountLockFileName        = "account.lock"
 )
 
 // AccountsStorage A storage for account data.
@@ -119,7 +121,18 @@ func (s *AccountsStorage) Save(account *Account) error {
 		return err
 	}
 
-	return os.WriteFile(s.accountFilePath, jsonBytes, filePerm)
+	return writeFileAtomic(s.accountFilePath, jsonBytes, filePerm)
+}
+
+// Lock acquires the advisory lock of the account.
+// It waits for the lock to be released by another process, at most for the given timeout (0 means no limit).
+func (s *AccountsStorage) Lock(timeout time.Duration) *fileLock {
+	lock, err := lockFile(filepath.Join(s.rootUserPath, accountLockFileName), timeout)
+	if err != nil {
+		log.Fatalf("Could not lock account %s: %v", s.userID, err)
+	}
+
+	return lock
 }
 
 func (s *AccountsStorage) LoadAccount(privateKey crypto.PrivateKey) *Account {
@@ -188,14 +201,9 @@ func generatePrivateKey(file string, keyType certcrypto.KeyType) (crypto.Private
 		return nil, err
 	}
 
-	certOut, err := os.Create(file)
-	if err != nil {
-		return nil, err
-	}
-	defer certOut.Close()
-
 	pemKey := certcrypto.PEMBlock(privateKey)
-	err = pem.Encode(certOut, pemKey)
+
+	err = writeFileAtomic(file, pem.EncodeToMemory(pemKey), filePerm)
 	if err != nil {
 		return nil, err
 	}
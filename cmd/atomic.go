@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// fileBatch stages a group of files in temporary files
+// and renames them to their destination on Commit.
+//
+// The temporary files are created next to their destination (same directory, so same filesystem)
+// and are hidden (dot-prefixed) to avoid being picked up by the other commands (list, archive, etc.).
+// A crash before Commit leaves the previous files untouched.
+//
+// Each file is replaced atomically, but the files are renamed one at a time.
+// With a journal, an interrupted Commit (crash, rename error) is completed by recoverFileBatch,
+// so the files end up matching each other (i.e. a certificate and its private key).
+type fileBatch struct {
+	perm    os.FileMode
+	journal string
+	pending []pendingFile
+}
+
+type pendingFile struct {
+	Tmp  string `json:"tmp"`
+	Dest string `json:"dest"`
+}
+
+func newFileBatch(perm os.FileMode) *fileBatch {
+	return &fileBatch{perm: perm}
+}
+
+// newJournaledFileBatch creates a fileBatch that records the pending renames in the journal file during Commit.
+func newJournaledFileBatch(perm os.FileMode, journal string) *fileBatch {
+	return &fileBatch{perm: perm, journal: journal}
+}
+
+// Add writes the data to a temporary file that will be renamed to filePath on Commit.
+func (b *fileBatch) Add(filePath string, data []byte) error {
+	tmp, err := writeTempFile(filePath, data, b.perm)
+	if err != nil {
+		return err
+	}
+
+	b.pending = append(b.pending, pendingFile{Tmp: tmp, Dest: filePath})
+
+	return nil
+}
+
+// Commit renames all the staged files to their final destination.
+//
+// With a journal, the renames are recorded before the first one:
+// if a rename fails, the temporary files and the journal are kept to complete the commit later with recoverFileBatch.
+func (b *fileBatch) Commit() error {
+	dirs := b.dirs()
+
+	if b.journal != "" {
+		err := b.writeJournal()
+		if err != nil {
+			return errors.Join(err, b.Rollback())
+		}
+	}
+
+	for i, f := range b.pending {
+		err := os.Rename(f.Tmp, f.Dest)
+		if err != nil {
+			if b.journal != "" {
+				b.pending = nil
+				return fmt.Errorf("rename %s: %w (the remaining files will be moved by the next run)", f.Dest, err)
+			}
+
+			// Remove the temporary files that have not been moved yet.
+			b.pending = b.pending[i:]
+			return errors.Join(fmt.Errorf("rename %s: %w", f.Dest, err), b.Rollback())
+		}
+	}
+
+	b.pending = nil
+
+	// Persist the renames (best effort: not supported on all platforms).
+	for _, dir := range dirs {
+		syncDir(dir)
+	}
+
+	if b.journal != "" {
+		return removeJournal(b.journal)
+	}
+
+	return nil
+}
+
+func (b *fileBatch) writeJournal() error {
+	data, err := json.Marshal(b.pending)
+	if err != nil {
+		return fmt.Errorf("marshal journal: %w", err)
+	}
+
+	err = writeFileAtomic(b.journal, data, b.perm)
+	if err != nil {
+		return fmt.Errorf("write journal: %w", err)
+	}
+
+	syncDir(filepath.Dir(b.journal))
+
+	return nil
+}
+
+// recoverFileBatch completes the commit recorded in a journal file, if any.
+// The renames that were already done are skipped (their temporary file doesn't exist anymore).
+// It must be called while holding the lock protecting the files of the batch.
+func recoverFileBatch(journal string) error {
+	data, err := os.ReadFile(journal)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read journal: %w", err)
+	}
+
+	var pending []pendingFile
+
+	err = json.Unmarshal(data, &pending)
+	if err != nil {
+		return fmt.Errorf("unmarshal journal %s: %w", journal, err)
+	}
+
+	batch := &fileBatch{pending: pending}
+	dirs := batch.dirs()
+
+	for _, f := range pending {
+		err = os.Rename(f.Tmp, f.Dest)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("rename %s: %w", f.Dest, err)
+		}
+	}
+
+	for _, dir := range dirs {
+		syncDir(dir)
+	}
+
+	return removeJournal(journal)
+}
+
+func removeJournal(journal string) error {
+	err := os.Remove(journal)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove journal: %w", err)
+	}
+
+	syncDir(filepath.Dir(journal))
+
+	return nil
+}
+
+// Rollback removes all the staged files.
+func (b *fileBatch) Rollback() error {
+	var errs []error
+
+	for _, f := range b.pending {
+		err := os.Remove(f.Tmp)
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	b.pending = nil
+
+	return errors.Join(errs...)
+}
+
+func (b *fileBatch) dirs() []string {
+	seen := map[string]struct{}{}
+
+	var dirs []string
+	for _, f := range b.pending {
+		dir := filepath.Dir(f.Dest)
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+
+		seen[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+
+	return dirs
+}
+
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+
+	defer func() { _ = d.Close() }()
+
+	_ = d.Sync()
+}
+
+// writeFileAtomic writes data to a temporary file and renames it to filePath.
+// Readers see either the old content or the new content, never a partial write.
+func writeFileAtomic(filePath string, data []byte, perm os.FileMode) error {
+	batch := newFileBatch(perm)
+
+	err := batch.Add(filePath, data)
+	if err != nil {
+		return err
+	}
+
+	return batch.Commit()
+}
+
+func writeTempFile(filePath string, data []byte, perm os.FileMode) (string, error) {
+	dir, base := filepath.Split(filePath)
+
+	file, err := os.CreateTemp(dir, "."+base+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("create temporary file for %s: %w", filePath, err)
+	}
+
+	cleanup := func(err error) (string, error) {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+
+		return "", fmt.Errorf("write temporary file for %s: %w", filePath, err)
+	}
+
+	if err = file.Chmod(perm); err != nil {
+		return cleanup(err)
+	}
+
+	if _, err = file.Write(data); err != nil {
+		return cleanup(err)
+	}
+
+	// Flush the content to the disk before the rename,
+	// otherwise a crash can leave an empty file after the rename.
+	if err = file.Sync(); err != nil {
+		return cleanup(err)
+	}
+
+	if err = file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		return "", fmt.Errorf("close temporary file for %s: %w", filePath, err)
+	}
+
+	return file.Name(), nil
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fileBatch_Commit(t *testing.T) {
+	dir := t.TempDir()
+
+	existing := filepath.Join(dir, "a.crt")
+	err := os.WriteFile(existing, []byte("old"), filePerm)
+	require.NoError(t, err)
+
+	batch := newFileBatch(filePerm)
+
+	require.NoError(t, batch.Add(existing, []byte("new")))
+	require.NoError(t, batch.Add(filepath.Join(dir, "a.key"), []byte("key")))
+
+	// Nothing is visible before the commit.
+	assertFileContent(t, existing, "old")
+	assert.NoFileExists(t, filepath.Join(dir, "a.key"))
+
+	require.NoError(t, batch.Commit())
+
+	assertFileContent(t, existing, "new")
+	assertFileContent(t, filepath.Join(dir, "a.key"), "key")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	info, err := os.Stat(filepath.Join(dir, "a.key"))
+	require.NoError(t, err)
+
+	if os.PathSeparator == '/' {
+		assert.Equal(t, filePerm, info.Mode().Perm())
+	}
+}
+
+func Test_fileBatch_Rollback(t *testing.T) {
+	dir := t.TempDir()
+
+	existing := filepath.Join(dir, "a.crt")
+	err := os.WriteFile(existing, []byte("old"), filePerm)
+	require.NoError(t, err)
+
+	batch := newFileBatch(filePerm)
+
+	require.NoError(t, batch.Add(existing, []byte("new")))
+	require.NoError(t, batch.Add(filepath.Join(dir, "a.key"), []byte("key")))
+
+	require.NoError(t, batch.Rollback())
+
+	assertFileContent(t, existing, "old")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func Test_fileBatch_Commit_journal(t *testing.T) {
+	dir := t.TempDir()
+
+	journal := filepath.Join(dir, ".a.journal")
+
+	batch := newJournaledFileBatch(filePerm, journal)
+
+	require.NoError(t, batch.Add(filepath.Join(dir, "a.crt"), []byte("crt")))
+	require.NoError(t, batch.Add(filepath.Join(dir, "a.key"), []byte("key")))
+
+	require.NoError(t, batch.Commit())
+
+	assertFileContent(t, filepath.Join(dir, "a.crt"), "crt")
+	assertFileContent(t, filepath.Join(dir, "a.key"), "key")
+	assert.NoFileExists(t, journal)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func Test_recoverFileBatch(t *testing.T) {
+	dir := t.TempDir()
+
+	crt := filepath.Join(dir, "a.crt")
+	key := filepath.Join(dir, "a.key")
+
+	require.NoError(t, os.WriteFile(crt, []byte("old crt"), filePerm))
+	require.NoError(t, os.WriteFile(key, []byte("old key"), filePerm))
+
+	journal := filepath.Join(dir, ".a.journal")
+
+	batch := newJournaledFileBatch(filePerm, journal)
+
+	require.NoError(t, batch.Add(crt, []byte("new crt")))
+	require.NoError(t, batch.Add(key, []byte("new key")))
+
+	// Simulate a crash after the first rename of the commit.
+	require.NoError(t, batch.writeJournal())
+	require.NoError(t, os.Rename(batch.pending[0].Tmp, batch.pending[0].Dest))
+
+	assertFileContent(t, crt, "new crt")
+	assertFileContent(t, key, "old key")
+
+	require.NoError(t, recoverFileBatch(journal))
+
+	assertFileContent(t, crt, "new crt")
+	assertFileContent(t, key, "new key")
+	assert.NoFileExists(t, journal)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// Without journal.
+	require.NoError(t, recoverFileBatch(journal))
+}
@@ -25,6 +25,7 @@ import (
 const (
 	baseCertificatesFolderName = "certificates"
 	baseArchivesFolderName     = "archives"
+	baseLocksFolderName        = "locks"
 )
 
 const (
@@ -34,6 +35,8 @@ const (
 	pemExt      = ".pem"
 	pfxExt      = ".pfx"
 	resourceExt = ".json"
+	lockExt     = ".lock"
+	journalExt  = ".journal"
 )
 
 // CertificatesStorage a certificates' storage.
@@ -49,9 +52,16 @@ const (
 //	./.lego/archives/
 //	     │      └── archived certificates directory
 //	     └── "path" option
+//
+// lockPath:
+//
+//	./.lego/locks/
+//	     │    └── advisory lock files directory
+//	     └── "path" option
 type CertificatesStorage struct {
 	rootPath    string
 	archivePath string
+	lockPath    string
 	pem         bool
 	pfx         bool
 	pfxPassword string
@@ -72,6 +82,7 @@ func NewCertificatesStorage(ctx *cli.Context) *CertificatesStorage {
 	return &CertificatesStorage{
 		rootPath:    filepath.Join(ctx.String(flgPath), baseCertificatesFolderName),
 		archivePath: filepath.Join(ctx.String(flgPath), baseArchivesFolderName),
+		lockPath:    filepath.Join(ctx.String(flgPath), baseLocksFolderName),
 		pem:         ctx.Bool(flgPEM),
 		pfx:         ctx.Bool(flgPFX),
 		pfxPassword: ctx.String(flgPFXPass),
@@ -98,42 +109,63 @@ func (s *CertificatesStorage) GetRootPath() string {
 	return s.rootPath
 }
 
+// SaveResource writes all the files related to a certificate resource (.crt, .issuer.crt, .key, .pem, .pfx, .json).
+// The files are first written to temporary files, then renamed into place.
+// The renames are recorded in a journal: an interrupted save is completed when the domain is locked again,
+// so the certificate ends up matching its private key.
+// The lock of the domain must be held.
 func (s *CertificatesStorage) SaveResource(certRes *certificate.Resource) {
 	domain := certRes.Domain
 
+	batch := newJournaledFileBatch(filePerm, s.getJournalFileName(domain))
+
+	fatalf := func(format string, args ...any) {
+		if err := batch.Rollback(); err != nil {
+			log.Warnf("[%s] Unable to remove temporary files: %v", domain, err)
+		}
+
+		log.Fatalf(format, args...)
+	}
+
 	// We store the certificate, private key and metadata in different files
 	// as web servers would not be able to work with a combined file.
-	err := s.WriteFile(domain, certExt, certRes.Certificate)
+	err := batch.Add(s.getWriteFileName(domain, certExt), certRes.Certificate)
 	if err != nil {
-		log.Fatalf("Unable to save Certificate for domain %s\n\t%v", domain, err)
+		fatalf("Unable to save Certificate for domain %s\n\t%v", domain, err)
 	}
 
 	if certRes.IssuerCertificate != nil {
-		err = s.WriteFile(domain, issuerExt, certRes.IssuerCertificate)
+		err = batch.Add(s.getWriteFileName(domain, issuerExt), certRes.IssuerCertificate)
 		if err != nil {
-			log.Fatalf("Unable to save IssuerCertificate for domain %s\n\t%v", domain, err)
+			fatalf("Unable to save IssuerCertificate for domain %s\n\t%v", domain, err)
 		}
 	}
 
 	// if we were given a CSR, we don't know the private key
 	if certRes.PrivateKey != nil {
-		err = s.WriteCertificateFiles(domain, certRes)
+		err = s.addCertificateFiles(batch, domain, certRes)
 		if err != nil {
-			log.Fatalf("Unable to save PrivateKey for domain %s\n\t%v", domain, err)
+			fatalf("Unable to save PrivateKey for domain %s\n\t%v", domain, err)
 		}
 	} else if s.pem || s.pfx {
 		// we don't have the private key; can't write the .pem or .pfx file
-		log.Fatalf("Unable to save PEM or PFX without private key for domain %s. Are you using a CSR?", domain)
+		fatalf("Unable to save PEM or PFX without private key for domain %s. Are you using a CSR?", domain)
 	}
 
 	jsonBytes, err := json.MarshalIndent(certRes, "", "\t")
 	if err != nil {
-		log.Fatalf("Unable to marshal CertResource for domain %s\n\t%v", domain, err)
+		fatalf("Unable to marshal CertResource for domain %s\n\t%v", domain, err)
 	}
 
-	err = s.WriteFile(domain, resourceExt, jsonBytes)
+	// The resource file is written last: it is the file used to detect an existing certificate.
+	err = batch.Add(s.getWriteFileName(domain, resourceExt), jsonBytes)
 	if err != nil {
-		log.Fatalf("Unable to save CertResource for domain %s\n\t%v", domain, err)
+		fatalf("Unable to save CertResource for domain %s\n\t%v", domain, err)
+	}
+
+	err = batch.Commit()
+	if err != nil {
+		log.Fatalf("Unable to save files for domain %s\n\t%v", domain, err)
 	}
 }
 
@@ -181,34 +213,51 @@ func (s *CertificatesStorage) ReadCertificate(domain, extension string) ([]*x509
 	return certcrypto.ParsePEMBundle(content)
 }
 
+// WriteFile writes a single file atomically.
 func (s *CertificatesStorage) WriteFile(domain, extension string, data []byte) error {
-	var baseFileName string
-	if s.filename != "" {
-		baseFileName = s.filename
-	} else {
-		baseFileName = sanitizedDomain(domain)
+	return writeFileAtomic(s.getWriteFileName(domain, extension), data, filePerm)
+}
+
+func (s *CertificatesStorage) WriteCertificateFiles(domain string, certRes *certificate.Resource) error {
+	batch := newJournaledFileBatch(filePerm, s.getJournalFileName(domain))
+
+	err := s.addCertificateFiles(batch, domain, certRes)
+	if err != nil {
+		return errors.Join(err, batch.Rollback())
 	}
 
-	filePath := filepath.Join(s.rootPath, baseFileName+extension)
+	return batch.Commit()
+}
+
+func (s *CertificatesStorage) WritePFXFile(domain string, certRes *certificate.Resource) error {
+	pfxBytes, err := s.encodePFX(domain, certRes)
+	if err != nil {
+		return err
+	}
 
-	return os.WriteFile(filePath, data, filePerm)
+	return s.WriteFile(domain, pfxExt, pfxBytes)
 }
 
-func (s *CertificatesStorage) WriteCertificateFiles(domain string, certRes *certificate.Resource) error {
-	err := s.WriteFile(domain, keyExt, certRes.PrivateKey)
+func (s *CertificatesStorage) addCertificateFiles(batch *fileBatch, domain string, certRes *certificate.Resource) error {
+	err := batch.Add(s.getWriteFileName(domain, keyExt), certRes.PrivateKey)
 	if err != nil {
 		return fmt.Errorf("unable to save key file: %w", err)
 	}
 
 	if s.pem {
-		err = s.WriteFile(domain, pemExt, bytes.Join([][]byte{certRes.Certificate, certRes.PrivateKey}, nil))
+		err = batch.Add(s.getWriteFileName(domain, pemExt), bytes.Join([][]byte{certRes.Certificate, certRes.PrivateKey}, nil))
 		if err != nil {
 			return fmt.Errorf("unable to save PEM file: %w", err)
 		}
 	}
 
 	if s.pfx {
-		err = s.WritePFXFile(domain, certRes)
+		pfxBytes, err := s.encodePFX(domain, certRes)
+		if err != nil {
+			return fmt.Errorf("unable to save PFX file: %w", err)
+		}
+
+		err = batch.Add(s.getWriteFileName(domain, pfxExt), pfxBytes)
 		if err != nil {
 			return fmt.Errorf("unable to save PFX file: %w", err)
 		}
@@ -217,25 +266,25 @@ func (s *CertificatesStorage) WriteCertificateFiles(domain string, certRes *cert
 	return nil
 }
 
-func (s *CertificatesStorage) WritePFXFile(domain string, certRes *certificate.Resource) error {
+func (s *CertificatesStorage) encodePFX(domain string, certRes *certificate.Resource) ([]byte, error) {
 	certPemBlock, _ := pem.Decode(certRes.Certificate)
 	if certPemBlock == nil {
-		return fmt.Errorf("unable to parse Certificate for domain %s", domain)
+		return nil, fmt.Errorf("unable to parse Certificate for domain %s", domain)
 	}
 
 	cert, err := x509.ParseCertificate(certPemBlock.Bytes)
 	if err != nil {
-		return fmt.Errorf("unable to load Certificate for domain %s: %w", domain, err)
+		return nil, fmt.Errorf("unable to load Certificate for domain %s: %w", domain, err)
 	}
 
 	certChain, err := getCertificateChain(certRes)
 	if err != nil {
-		return fmt.Errorf("unable to get certificate chain for domain %s: %w", domain, err)
+		return nil, fmt.Errorf("unable to get certificate chain for domain %s: %w", domain, err)
 	}
 
 	keyPemBlock, _ := pem.Decode(certRes.PrivateKey)
 	if keyPemBlock == nil {
-		return fmt.Errorf("unable to parse PrivateKey for domain %s", domain)
+		return nil, fmt.Errorf("unable to parse PrivateKey for domain %s", domain)
 	}
 
 	var privateKey crypto.Signer
@@ -245,28 +294,84 @@ func (s *CertificatesStorage) WritePFXFile(domain string, certRes *certificate.R
 	case "RSA PRIVATE KEY":
 		privateKey, keyErr = x509.ParsePKCS1PrivateKey(keyPemBlock.Bytes)
 		if keyErr != nil {
-			return fmt.Errorf("unable to load RSA PrivateKey for domain %s: %w", domain, keyErr)
+			return nil, fmt.Errorf("unable to load RSA PrivateKey for domain %s: %w", domain, keyErr)
 		}
 	case "EC PRIVATE KEY":
 		privateKey, keyErr = x509.ParseECPrivateKey(keyPemBlock.Bytes)
 		if keyErr != nil {
-			return fmt.Errorf("unable to load EC PrivateKey for domain %s: %w", domain, keyErr)
+			return nil, fmt.Errorf("unable to load EC PrivateKey for domain %s: %w", domain, keyErr)
 		}
 	default:
-		return fmt.Errorf("unsupported PrivateKey type '%s' for domain %s", keyPemBlock.Type, domain)
+		return nil, fmt.Errorf("unsupported PrivateKey type '%s' for domain %s", keyPemBlock.Type, domain)
 	}
 
 	encoder, err := getPFXEncoder(s.pfxFormat)
 	if err != nil {
-		return fmt.Errorf("PFX encoder: %w", err)
+		return nil, fmt.Errorf("PFX encoder: %w", err)
 	}
 
 	pfxBytes, err := encoder.Encode(privateKey, cert, certChain, s.pfxPassword)
 	if err != nil {
-		return fmt.Errorf("unable to encode PFX data for domain %s: %w", domain, err)
+		return nil, fmt.Errorf("unable to encode PFX data for domain %s: %w", domain, err)
 	}
 
-	return s.WriteFile(domain, pfxExt, pfxBytes)
+	return pfxBytes, nil
+}
+
+// getWriteFileName returns the path of the file to write, taking the deprecated "filename" option into account.
+func (s *CertificatesStorage) getWriteFileName(domain, extension string) string {
+	var baseFileName string
+	if s.filename != "" {
+		baseFileName = s.filename
+	} else {
+		baseFileName = sanitizedDomain(domain)
+	}
+
+	return filepath.Join(s.rootPath, baseFileName+extension)
+}
+
+// Lock acquires the advisory lock of a domain.
+// It waits for the lock to be released by another process, at most for the given timeout (0 means no limit).
+// An interrupted save of the files of the domain is completed once the lock is acquired.
+func (s *CertificatesStorage) Lock(domain string, timeout time.Duration) (*fileLock, error) {
+	lock, err := lockFile(s.getLockFileName(domain), timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recover(domain)
+
+	return lock, nil
+}
+
+// TryLock tries to acquire the advisory lock of a domain without waiting.
+// It returns errLocked if the lock is held by another process.
+// An interrupted save of the files of the domain is completed once the lock is acquired.
+func (s *CertificatesStorage) TryLock(domain string) (*fileLock, error) {
+	lock, err := tryLockFile(s.getLockFileName(domain))
+	if err != nil {
+		return nil, err
+	}
+
+	s.recover(domain)
+
+	return lock, nil
+}
+
+func (s *CertificatesStorage) recover(domain string) {
+	err := recoverFileBatch(s.getJournalFileName(domain))
+	if err != nil {
+		log.Warnf("[%s] Unable to complete the previous save of the certificate files: %v", domain, err)
+	}
+}
+
+func (s *CertificatesStorage) getLockFileName(domain string) string {
+	return filepath.Join(s.lockPath, sanitizedDomain(domain)+lockExt)
+}
+
+// getJournalFileName returns the path of the journal of the files of a domain (hidden, like the temporary files).
+func (s *CertificatesStorage) getJournalFileName(domain string) string {
+	return filepath.Join(s.rootPath, "."+sanitizedDomain(domain)+journalExt)
 }
 
 func (s *CertificatesStorage) MoveToArchive(domain string) error {
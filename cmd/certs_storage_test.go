@@ -5,7 +5,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/go-acme/lego/v4/certificate"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -113,3 +115,77 @@ func generateTestFiles(t *testing.T, dir, domain string) []string {
 
 	return filenames
 }
+
+func TestCertificatesStorage_SaveResource(t *testing.T) {
+	domain := "example.com"
+
+	storage := CertificatesStorage{
+		rootPath: t.TempDir(),
+		pem:      true,
+	}
+
+	generateTestFiles(t, storage.rootPath, domain)
+
+	storage.SaveResource(&certificate.Resource{
+		Domain:            domain,
+		Certificate:       []byte("cert"),
+		IssuerCertificate: []byte("issuer"),
+		PrivateKey:        []byte("key"),
+	})
+
+	root, err := os.ReadDir(storage.rootPath)
+	require.NoError(t, err)
+
+	// No temporary files are left.
+	require.Len(t, root, 6)
+
+	assertFileContent(t, storage.GetFileName(domain, certExt), "cert")
+	assertFileContent(t, storage.GetFileName(domain, issuerExt), "issuer")
+	assertFileContent(t, storage.GetFileName(domain, keyExt), "key")
+	assertFileContent(t, storage.GetFileName(domain, pemExt), "certkey")
+
+	// Not rewritten because the PFX option is disabled.
+	assertFileContent(t, storage.GetFileName(domain, pfxExt), "test")
+
+	resource := storage.ReadResource(domain)
+	assert.Equal(t, domain, resource.Domain)
+}
+
+func TestCertificatesStorage_Lock(t *testing.T) {
+	storage := CertificatesStorage{
+		lockPath: filepath.Join(t.TempDir(), baseLocksFolderName),
+	}
+
+	lock, err := storage.Lock("*.example.com", 0)
+	require.NoError(t, err)
+
+	_, err = storage.TryLock("*.example.com")
+	require.ErrorIs(t, err, errLocked)
+
+	_, err = storage.Lock("*.example.com", 10*time.Millisecond)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errLocked)
+
+	other, err := storage.TryLock("example.org")
+	require.NoError(t, err)
+
+	other.Unlock()
+
+	lock.Unlock()
+
+	lock, err = storage.TryLock("*.example.com")
+	require.NoError(t, err)
+
+	lock.Unlock()
+
+	assert.FileExists(t, filepath.Join(storage.lockPath, "_.example.com.lock"))
+}
+
+func assertFileContent(t *testing.T, filename, expected string) {
+	t.Helper()
+
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, string(content))
+}
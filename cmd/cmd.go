@@ -9,6 +9,8 @@ func CreateCommands() []*cli.Command {
 		createRevoke(),
 		createRenew(),
 		createDNSHelp(),
+		createDNS(),
+		createProvider(),
 		createList(),
 	}
 }
@@ -1,16 +1,31 @@
 package cmd
 
 import (
+	"fmt"
+	"log/slog"
+	"os"
+
 	"github.com/go-acme/lego/v4/log"
 	"github.com/urfave/cli/v2"
 )
 
+// Log formats.
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
 func Before(ctx *cli.Context) error {
+	err := setupLogger(ctx)
+	if err != nil {
+		log.Fatalf("Could not setup the logger: %v", err)
+	}
+
 	if ctx.String(flgPath) == "" {
 		log.Fatalf("Could not determine current working directory. Please pass --%s.", flgPath)
 	}
 
-	err := createNonExistingFolder(ctx.String(flgPath))
+	err = createNonExistingFolder(ctx.String(flgPath))
 	if err != nil {
 		log.Fatalf("Could not check/create path: %v", err)
 	}
@@ -21,3 +36,27 @@ func Before(ctx *cli.Context) error {
 
 	return nil
 }
+
+func setupLogger(ctx *cli.Context) error {
+	level, err := log.ParseLevel(ctx.String(flgLogLevel))
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool(flgDebugHTTP) && !ctx.IsSet(flgLogLevel) {
+		level = slog.LevelDebug
+	}
+
+	switch ctx.String(flgLogFormat) {
+	case logFormatText, "":
+		log.SetLevel(level)
+
+	case logFormatJSON:
+		log.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+
+	default:
+		return fmt.Errorf("unsupported log format: %s", ctx.String(flgLogFormat))
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/providers/dns"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flgZone              = "zone"
+	flgOlderThan         = "older-than"
+	flgIncludeUnknownAge = "include-unknown-age"
+	flgDryRun            = "dry-run"
+)
+
+func createDNS() *cli.Command {
+	return &cli.Command{
+		Name:  "dns",
+		Usage: "Manage the DNS records of the DNS-01 challenge",
+		Subcommands: []*cli.Command{
+			createDNSCleanup(),
+		},
+	}
+}
+
+func createDNSCleanup() *cli.Command {
+	return &cli.Command{
+		Name:  "cleanup",
+		Usage: "Remove the stale _acme-challenge TXT records of a zone, left behind by interrupted challenges",
+		Description: "Only the DNS providers able to list the records of a zone are supported (cloudflare, digitalocean, hetzner, route53)." +
+			" The records are removed when they are older than the threshold (--older-than).\n\n" +
+			"Some DNS providers (digitalocean, route53) don't report the creation time of the records:" +
+			" by default, these records are never removed." +
+			" With --include-unknown-age, they are all removed, whatever their age," +
+			" including the records of a challenge in progress (i.e. a concurrent run of lego)." +
+			" Use --dry-run first, and --include-unknown-age only when no challenge is in progress for the zone.",
+		Action: dnsCleanup,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     flgDNS,
+				Usage:    "DNS provider code.",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     flgZone,
+				Usage:    "The zone to clean up (e.g. example.com).",
+				Required: true,
+			},
+			&cli.DurationFlag{
+				Name:  flgOlderThan,
+				Usage: "Only remove the records older than this duration.",
+				Value: 24 * time.Hour,
+			},
+			&cli.BoolFlag{
+				Name:  flgIncludeUnknownAge,
+				Usage: "Also remove the records without creation time (not all DNS providers report it), whatever their age: the records of a challenge in progress are removed too.",
+			},
+			&cli.BoolFlag{
+				Name:  flgDryRun,
+				Usage: "Display the records to remove without removing them.",
+			},
+		},
+	}
+}
+
+func dnsCleanup(ctx *cli.Context) error {
+	provider, err := dns.NewDNSChallengeProviderByName(ctx.String(flgDNS))
+	if err != nil {
+		return err
+	}
+
+	lister, ok := provider.(dns01.RecordLister)
+	if !ok {
+		return fmt.Errorf("the DNS provider %q cannot list the records of a zone", ctx.String(flgDNS))
+	}
+
+	opts := cleanupOptions{
+		olderThan:         ctx.Duration(flgOlderThan),
+		includeUnknownAge: ctx.Bool(flgIncludeUnknownAge),
+		dryRun:            ctx.Bool(flgDryRun),
+	}
+
+	return cleanupRecords(lister, ctx.String(flgZone), time.Now(), opts)
+}
+
+type cleanupOptions struct {
+	olderThan         time.Duration
+	includeUnknownAge bool
+	dryRun            bool
+}
+
+func cleanupRecords(lister dns01.RecordLister, zone string, now time.Time, opts cleanupOptions) error {
+	records, err := lister.ListChallengeRecords(zone)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	if opts.includeUnknownAge && !opts.dryRun {
+		log.Warnf("[%s] the records without creation time are removed, including the records of a challenge in progress", zone)
+	}
+
+	var count, unknownAge int
+
+	for _, record := range records {
+		if record.CreatedAt.IsZero() && !opts.includeUnknownAge {
+			unknownAge++
+		}
+
+		if !isStaleRecord(record, now, opts) {
+			log.Infof("[%s] %s: skipped (%s)", zone, record.FQDN, recordAge(record, now))
+			continue
+		}
+
+		count++
+
+		if opts.dryRun {
+			log.Infof("[%s] %s: would be removed (%s)", zone, record.FQDN, recordAge(record, now))
+			continue
+		}
+
+		err = lister.DeleteChallengeRecord(zone, record)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", record.FQDN, err))
+			continue
+		}
+
+		log.Infof("[%s] %s: removed (%s)", zone, record.FQDN, recordAge(record, now))
+	}
+
+	if opts.dryRun {
+		log.Infof("[%s] %d/%d record(s) would be removed", zone, count, len(records))
+	} else {
+		log.Infof("[%s] %d/%d record(s) removed", zone, count-len(errs), len(records))
+	}
+
+	if unknownAge > 0 {
+		log.Warnf("[%s] %d record(s) without creation time skipped: the DNS provider doesn't report it (see --%s)", zone, unknownAge, flgIncludeUnknownAge)
+	}
+
+	return errors.Join(errs...)
+}
+
+func isStaleRecord(record dns01.ChallengeRecord, now time.Time, opts cleanupOptions) bool {
+	if record.CreatedAt.IsZero() {
+		return opts.includeUnknownAge
+	}
+
+	return now.Sub(record.CreatedAt) >= opts.olderThan
+}
+
+func recordAge(record dns01.ChallengeRecord, now time.Time) string {
+	if record.CreatedAt.IsZero() {
+		return "unknown age"
+	}
+
+	return fmt.Sprintf("age: %s", now.Sub(record.CreatedAt).Truncate(time.Second))
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordListerMock struct {
+	records []dns01.ChallengeRecord
+	deleted []string
+	err     error
+}
+
+func (m *recordListerMock) ListChallengeRecords(_ string) ([]dns01.ChallengeRecord, error) {
+	return m.records, nil
+}
+
+func (m *recordListerMock) DeleteChallengeRecord(_ string, record dns01.ChallengeRecord) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	m.deleted = append(m.deleted, record.ID)
+
+	return nil
+}
+
+func Test_cleanupRecords(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	records := []dns01.ChallengeRecord{
+		{ID: "old", FQDN: "_acme-challenge.example.com.", CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: "recent", FQDN: "_acme-challenge.example.com.", CreatedAt: now.Add(-time.Hour)},
+		{ID: "unknown", FQDN: "_acme-challenge.www.example.com."},
+	}
+
+	testCases := []struct {
+		desc          string
+		opts          cleanupOptions
+		deleteErr     error
+		expected      []string
+		expectedError string
+	}{
+		{
+			desc:     "older than",
+			opts:     cleanupOptions{olderThan: 24 * time.Hour},
+			expected: []string{"old"},
+		},
+		{
+			desc:     "include unknown age",
+			opts:     cleanupOptions{olderThan: 24 * time.Hour, includeUnknownAge: true},
+			expected: []string{"old", "unknown"},
+		},
+		{
+			desc:     "no threshold",
+			opts:     cleanupOptions{},
+			expected: []string{"old", "recent"},
+		},
+		{
+			desc: "dry-run",
+			opts: cleanupOptions{olderThan: 24 * time.Hour, includeUnknownAge: true, dryRun: true},
+		},
+		{
+			desc:          "delete error",
+			opts:          cleanupOptions{olderThan: 24 * time.Hour},
+			deleteErr:     errors.New("oops"),
+			expectedError: "_acme-challenge.example.com.: oops",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			lister := &recordListerMock{records: records, err: test.deleteErr}
+
+			err := cleanupRecords(lister, "example.com", now, test.opts)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, lister.deleted)
+		})
+	}
+}
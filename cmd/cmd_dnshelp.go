@@ -1,15 +1,25 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/urfave/cli/v2"
 )
 
-const flgCode = "code"
+const (
+	flgCode   = "code"
+	flgFormat = "format"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
 
 func createDNSHelp() *cli.Command {
 	return &cli.Command{
@@ -22,12 +32,26 @@ func createDNSHelp() *cli.Command {
 				Aliases: []string{"c"},
 				Usage:   fmt.Sprintf("DNS code: %s", allDNSCodes()),
 			},
+			&cli.StringFlag{
+				Name:  flgFormat,
+				Usage: fmt.Sprintf("Output format: %s, %s (the JSON output contains the catalog of the DNS providers).", formatText, formatJSON),
+				Value: formatText,
+			},
 		},
 	}
 }
 
 func dnsHelp(ctx *cli.Context) error {
 	code := ctx.String(flgCode)
+
+	switch ctx.String(flgFormat) {
+	case formatText:
+	case formatJSON:
+		return displayDNSCatalog(ctx.App.Writer, strings.ToLower(code))
+	default:
+		return fmt.Errorf("unsupported format %q: %s or %s", ctx.String(flgFormat), formatText, formatJSON)
+	}
+
 	if code == "" {
 		w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
 		ew := &errWriter{w: w}
@@ -53,6 +77,70 @@ func dnsHelp(ctx *cli.Context) error {
 	return displayDNSHelp(ctx.App.Writer, strings.ToLower(code))
 }
 
+// dnsProviderInfo describes a DNS provider in the JSON catalog.
+type dnsProviderInfo struct {
+	Code         string          `json:"code"`
+	Aliases      []string        `json:"aliases,omitempty"`
+	Name         string          `json:"name"`
+	URL          string          `json:"url"`
+	Since        string          `json:"since"`
+	Description  string          `json:"description"`
+	Required     []dnsEnvVarInfo `json:"required"`
+	Optional     []dnsEnvVarInfo `json:"optional"`
+	Capabilities dnsCapabilities `json:"capabilities"`
+}
+
+// dnsEnvVarInfo describes an environment variable of a DNS provider.
+type dnsEnvVarInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// dnsCapabilities describes the features supported by a DNS provider.
+// An unknown capability (not defined by the descriptor of the provider) is nil.
+// The wildcard certificates are supported by all the providers (it's a property of the DNS-01 challenge).
+type dnsCapabilities struct {
+	Wildcard          bool  `json:"wildcard"`
+	MultipleTXT       *bool `json:"multipleTxtValues"`
+	ZoneAutoDiscovery *bool `json:"zoneAutoDiscovery"`
+}
+
+func capability(supported bool) *bool {
+	return &supported
+}
+
+// displayDNSCatalog writes the catalog of the DNS providers as JSON.
+// The catalog is restricted to one provider when a code is given.
+func displayDNSCatalog(w io.Writer, code string) error {
+	providers := dnsCatalog()
+
+	if code != "" {
+		index := slices.IndexFunc(providers, func(p dnsProviderInfo) bool {
+			return p.Code == code || slices.Contains(p.Aliases, code)
+		})
+		if index < 0 {
+			return fmt.Errorf("%q is not yet supported", code)
+		}
+
+		providers = providers[index : index+1]
+	}
+
+	for i := range providers {
+		if providers[i].Required == nil {
+			providers[i].Required = []dnsEnvVarInfo{}
+		}
+
+		if providers[i].Optional == nil {
+			providers[i].Optional = []dnsEnvVarInfo{}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(map[string]any{"providers": providers})
+}
+
 type errWriter struct {
 	w   io.Writer
 	err error
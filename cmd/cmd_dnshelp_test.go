@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type catalog struct {
+	Providers []dnsProviderInfo `json:"providers"`
+}
+
+func Test_displayDNSCatalog(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := displayDNSCatalog(buf, "")
+	require.NoError(t, err)
+
+	var result catalog
+
+	err = json.Unmarshal(buf.Bytes(), &result)
+	require.NoError(t, err)
+
+	codes := strings.Split(allDNSCodes(), ", ")
+
+	// All the codes, except "manual".
+	assert.Len(t, result.Providers, len(codes)-1)
+
+	for _, provider := range result.Providers {
+		assert.Contains(t, codes, provider.Code)
+		assert.NotEmpty(t, provider.Name)
+		assert.NotEmpty(t, provider.Since)
+		assert.NotNil(t, provider.Required)
+		assert.NotNil(t, provider.Optional)
+	}
+}
+
+func Test_displayDNSCatalog_code(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		code     string
+		expected dnsProviderInfo
+	}{
+		{
+			desc: "code",
+			code: "duckdns",
+			expected: dnsProviderInfo{
+				Code:  "duckdns",
+				Name:  "Duck DNS",
+				URL:   "https://www.duckdns.org/",
+				Since: "v0.5.0",
+				Required: []dnsEnvVarInfo{
+					{Name: "DUCKDNS_TOKEN", Description: "Account token"},
+				},
+				Optional: []dnsEnvVarInfo{
+					{Name: "DUCKDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+					{Name: "DUCKDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+					{Name: "DUCKDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+					{Name: "DUCKDNS_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+					{Name: "DUCKDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				},
+				Capabilities: dnsCapabilities{Wildcard: true, MultipleTXT: capability(false), ZoneAutoDiscovery: capability(false)},
+			},
+		},
+		{
+			desc: "alias",
+			code: "acmedns",
+			expected: dnsProviderInfo{
+				Code:    "acme-dns",
+				Aliases: []string{"acmedns"},
+				Name:    "Joohoi's ACME-DNS",
+				URL:     "https://github.com/joohoi/acme-dns",
+				Since:   "v1.1.0",
+				Required: []dnsEnvVarInfo{
+					{Name: "ACME_DNS_API_BASE", Description: "The ACME-DNS API address"},
+					{Name: "ACME_DNS_STORAGE_BASE_URL", Description: "The ACME-DNS JSON account data server."},
+					{Name: "ACME_DNS_STORAGE_PATH", Description: "The ACME-DNS JSON account data file. A per-domain account will be registered/persisted to this file and used for TXT updates."},
+				},
+				Optional:     []dnsEnvVarInfo{},
+				Capabilities: dnsCapabilities{Wildcard: true, ZoneAutoDiscovery: capability(false)},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+
+			err := displayDNSCatalog(buf, test.code)
+			require.NoError(t, err)
+
+			var result catalog
+
+			err = json.Unmarshal(buf.Bytes(), &result)
+			require.NoError(t, err)
+
+			assert.Equal(t, []dnsProviderInfo{test.expected}, result.Providers)
+		})
+	}
+}
+
+func Test_displayDNSCatalog_capabilities(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := displayDNSCatalog(buf, "pdns")
+	require.NoError(t, err)
+
+	var result struct {
+		Providers []struct {
+			Capabilities map[string]any `json:"capabilities"`
+		} `json:"providers"`
+	}
+
+	err = json.Unmarshal(buf.Bytes(), &result)
+	require.NoError(t, err)
+
+	require.Len(t, result.Providers, 1)
+
+	// The unknown capabilities are explicit.
+	expected := map[string]any{"wildcard": true, "multipleTxtValues": true, "zoneAutoDiscovery": nil}
+
+	assert.Equal(t, expected, result.Providers[0].Capabilities)
+}
+
+func Test_displayDNSCatalog_unknown(t *testing.T) {
+	err := displayDNSCatalog(&bytes.Buffer{}, "foobar")
+	require.EqualError(t, err, `"foobar" is not yet supported`)
+}
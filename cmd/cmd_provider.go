@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/urfave/cli/v2"
+)
+
+const flgProbe = "probe"
+
+func createProvider() *cli.Command {
+	return &cli.Command{
+		Name:  "provider",
+		Usage: "Manage the challenge providers",
+		Subcommands: []*cli.Command{
+			createProviderCheck(),
+		},
+	}
+}
+
+func createProviderCheck() *cli.Command {
+	return &cli.Command{
+		Name:  "check",
+		Usage: "Check the configuration of the challenge providers, without contacting the ACME server",
+		Description: "The providers are defined by the global options, as for the 'run' command" +
+			" (e.g. 'lego --dns cloudflare --domains example.com provider check')." +
+			" The credentials and the access to the zone of the domains are verified when the provider supports it.",
+		Action: providerCheck,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  flgProbe,
+				Usage: "Also present and clean up a probe challenge for each domain (e.g. create and delete a TXT record).",
+			},
+		},
+	}
+}
+
+func providerCheck(ctx *cli.Context) error {
+	domains := ctx.StringSlice(flgDomains)
+	if len(domains) == 0 {
+		return fmt.Errorf("no domains to check: please specify --%s or -d", flgDomains)
+	}
+
+	providers, err := checkedProviders(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, p := range providers {
+		for _, domain := range domains {
+			err = checkProvider(ctx.App.Writer, p.name, p.provider, domain, ctx.Bool(flgProbe))
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// namedProvider is a provider with the name of its challenge type.
+type namedProvider struct {
+	name     string
+	provider challenge.Provider
+}
+
+// checkedProviders creates the providers of the challenges selected by the global options.
+func checkedProviders(ctx *cli.Context) ([]namedProvider, error) {
+	var providers []namedProvider
+
+	if ctx.Bool(flgHTTP) {
+		providers = append(providers, namedProvider{name: string(challenge.HTTP01), provider: setupHTTPProvider(ctx)})
+	}
+
+	if ctx.Bool(flgTLS) {
+		providers = append(providers, namedProvider{name: string(challenge.TLSALPN01), provider: setupTLSProvider(ctx)})
+	}
+
+	if ctx.IsSet(flgDNS) || ctx.IsSet(flgDNSZone) {
+		provider, err := setupDNSProvider(ctx)
+		if err != nil {
+			displayDNSCredentials(ctx.App.Writer, dnsProviderNames(ctx))
+
+			return nil, err
+		}
+
+		providers = append(providers, namedProvider{name: string(challenge.DNS01), provider: provider})
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no challenge selected: you must specify at least one challenge: `--%s`, `--%s`, `--%s`", flgHTTP, flgTLS, flgDNS)
+	}
+
+	return providers, nil
+}
+
+func checkProvider(w io.Writer, name string, provider challenge.Provider, domain string, probe bool) error {
+	if checker, ok := provider.(challenge.Checker); ok {
+		err := checker.Check(domain)
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "%s: %s: check: %v\n", name, domain, err)
+			return fmt.Errorf("%s: %s: %w", name, domain, err)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s: %s: check: ok\n", name, domain)
+	} else {
+		_, _ = fmt.Fprintf(w, "%s: %s: check: not supported by the provider\n", name, domain)
+	}
+
+	if !probe {
+		return nil
+	}
+
+	token, keyAuth, err := probeChallenge()
+	if err != nil {
+		return err
+	}
+
+	err = provider.Present(domain, token, keyAuth)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "%s: %s: probe: %v\n", name, domain, err)
+
+		// The provider may have created a part of the challenge before failing.
+		errC := provider.CleanUp(domain, token, keyAuth)
+		if errC != nil {
+			_, _ = fmt.Fprintf(w, "%s: %s: probe cleanup: %v\n", name, domain, errC)
+		}
+
+		return fmt.Errorf("%s: %s: probe: %w", name, domain, err)
+	}
+
+	err = provider.CleanUp(domain, token, keyAuth)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "%s: %s: probe cleanup: %v\n", name, domain, err)
+		return fmt.Errorf("%s: %s: probe cleanup: %w", name, domain, err)
+	}
+
+	_, _ = fmt.Fprintf(w, "%s: %s: probe: ok\n", name, domain)
+
+	return nil
+}
+
+func probeChallenge() (token, keyAuth string, err error) {
+	raw := make([]byte, 16)
+
+	_, err = rand.Read(raw)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to generate the probe token: %w", err)
+	}
+
+	token = "lego-probe-" + hex.EncodeToString(raw)
+
+	return token, token + ".probe", nil
+}
+
+// dnsProviderNames returns the names of the DNS providers defined by --dns and --dns.zone.
+func dnsProviderNames(ctx *cli.Context) []string {
+	var values []string
+
+	if ctx.IsSet(flgDNS) {
+		values = append(values, ctx.String(flgDNS))
+	}
+
+	for _, value := range ctx.StringSlice(flgDNSZone) {
+		_, name, ok := strings.Cut(value, "=")
+		if ok {
+			values = append(values, name)
+		}
+	}
+
+	var names []string
+
+	for _, value := range values {
+		for _, name := range strings.Split(value, "+") {
+			name = strings.TrimSpace(name)
+			if name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+// displayDNSCredentials displays whether the environment variables of the credentials of the DNS providers are set.
+// Some of the variables are alternatives: they are not all required.
+func displayDNSCredentials(w io.Writer, names []string) {
+	for _, name := range names {
+		credentials := dnsCredentials(name)
+		if len(credentials) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(w, "Credential environment variables of the DNS provider %q (see 'lego dnshelp -c %s' for the required ones):\n", name, name)
+
+		for _, envVar := range credentials {
+			state := "not set"
+			if os.Getenv(envVar) != "" || os.Getenv(envVar+"_FILE") != "" {
+				state = "set"
+			}
+
+			_, _ = fmt.Fprintf(w, "\t%s: %s\n", envVar, state)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type providerMock struct {
+	presentErr error
+	cleanUpErr error
+	presented  []string
+	cleanedUp  []string
+}
+
+func (p *providerMock) Present(domain, _, _ string) error {
+	p.presented = append(p.presented, domain)
+	return p.presentErr
+}
+
+func (p *providerMock) CleanUp(domain, _, _ string) error {
+	p.cleanedUp = append(p.cleanedUp, domain)
+	return p.cleanUpErr
+}
+
+type checkerMock struct {
+	*providerMock
+
+	checkErr error
+}
+
+func (p *checkerMock) Check(_ string) error {
+	return p.checkErr
+}
+
+func Test_checkProvider(t *testing.T) {
+	testCases := []struct {
+		desc              string
+		provider          *checkerMock
+		probe             bool
+		expected          string
+		expectedError     string
+		expectedPresented []string
+		expectedCleanedUp []string
+	}{
+		{
+			desc:     "check",
+			provider: &checkerMock{providerMock: &providerMock{}},
+			expected: "dns-01: example.com: check: ok\n",
+		},
+		{
+			desc:          "check error",
+			provider:      &checkerMock{providerMock: &providerMock{}, checkErr: errors.New("invalid credentials")},
+			probe:         true,
+			expected:      "dns-01: example.com: check: invalid credentials\n",
+			expectedError: "dns-01: example.com: invalid credentials",
+		},
+		{
+			desc:              "probe",
+			provider:          &checkerMock{providerMock: &providerMock{}},
+			probe:             true,
+			expected:          "dns-01: example.com: check: ok\ndns-01: example.com: probe: ok\n",
+			expectedPresented: []string{"example.com"},
+			expectedCleanedUp: []string{"example.com"},
+		},
+		{
+			desc:              "probe error",
+			provider:          &checkerMock{providerMock: &providerMock{presentErr: errors.New("permission denied")}},
+			probe:             true,
+			expected:          "dns-01: example.com: check: ok\ndns-01: example.com: probe: permission denied\n",
+			expectedError:     "dns-01: example.com: probe: permission denied",
+			expectedPresented: []string{"example.com"},
+			expectedCleanedUp: []string{"example.com"},
+		},
+		{
+			desc: "probe error and cleanup error",
+			provider: &checkerMock{providerMock: &providerMock{
+				presentErr: errors.New("permission denied"),
+				cleanUpErr: errors.New("record not found"),
+			}},
+			probe:             true,
+			expected:          "dns-01: example.com: check: ok\ndns-01: example.com: probe: permission denied\ndns-01: example.com: probe cleanup: record not found\n",
+			expectedError:     "dns-01: example.com: probe: permission denied",
+			expectedPresented: []string{"example.com"},
+			expectedCleanedUp: []string{"example.com"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			w := &bytes.Buffer{}
+
+			err := checkProvider(w, "dns-01", test.provider, "example.com", test.probe)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, w.String())
+			assert.Equal(t, test.expectedPresented, test.provider.presented)
+			assert.Equal(t, test.expectedCleanedUp, test.provider.cleanedUp)
+		})
+	}
+}
+
+func Test_checkProvider_notSupported(t *testing.T) {
+	w := &bytes.Buffer{}
+
+	provider := &providerMock{}
+
+	err := checkProvider(w, "http-01", provider, "example.com", true)
+	require.NoError(t, err)
+
+	assert.Equal(t, "http-01: example.com: check: not supported by the provider\nhttp-01: example.com: probe: ok\n", w.String())
+	assert.Equal(t, []string{"example.com"}, provider.cleanedUp)
+}
+
+func Test_displayDNSCredentials(t *testing.T) {
+	t.Setenv("HETZNER_API_KEY", "")
+	t.Setenv("DO_AUTH_TOKEN", "")
+	t.Setenv("DO_AUTH_TOKEN_FILE", "/run/secrets/do")
+	t.Setenv("GCE_PROJECT", "lego")
+	t.Setenv("GCE_SERVICE_ACCOUNT", "")
+	t.Setenv("GCE_SERVICE_ACCOUNT_FILE", "")
+
+	w := &bytes.Buffer{}
+
+	displayDNSCredentials(w, []string{"hetzner", "digitalocean", "manual", "gcloud"})
+
+	expected := `Credential environment variables of the DNS provider "hetzner" (see 'lego dnshelp -c hetzner' for the required ones):
+	HETZNER_API_KEY: not set
+Credential environment variables of the DNS provider "digitalocean" (see 'lego dnshelp -c digitalocean' for the required ones):
+	DO_AUTH_TOKEN: set
+Credential environment variables of the DNS provider "gcloud" (see 'lego dnshelp -c gcloud' for the required ones):
+	GCE_PROJECT: set
+	GCE_SERVICE_ACCOUNT: not set
+	GCE_SERVICE_ACCOUNT_FILE: not set
+`
+
+	assert.Equal(t, expected, w.String())
+}
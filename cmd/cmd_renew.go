@@ -28,6 +28,7 @@ const (
 	flgRenewHookTimeout       = "renew-hook-timeout"
 	flgNoRandomSleep          = "no-random-sleep"
 	flgForceCertDomains       = "force-cert-domains"
+	flgLockSkip               = "lock-skip"
 )
 
 func createRenew() *cli.Command {
@@ -40,13 +41,13 @@ func createRenew() *cli.Command {
 			hasDomains := len(ctx.StringSlice(flgDomains)) > 0
 			hasCsr := ctx.String(flgCSR) != ""
 			if hasDomains && hasCsr {
-				log.Fatal("Please specify either --%s/-d or --%s/-c, but not both", flgDomains, flgCSR)
+				log.Fatalf("Please specify either --%s/-d or --%s/-c, but not both", flgDomains, flgCSR)
 			}
 			if !hasDomains && !hasCsr {
-				log.Fatal("Please specify --%s/-d (or --%s/-c if you already have a CSR)", flgDomains, flgCSR)
+				log.Fatalf("Please specify --%s/-d (or --%s/-c if you already have a CSR)", flgDomains, flgCSR)
 			}
 			if ctx.Bool(flgForceCertDomains) && hasCsr {
-				log.Fatal("--%s only works with --%s/-d, --%s/-c doesn't support this option.", flgForceCertDomains, flgDomains, flgCSR)
+				log.Fatalf("--%s only works with --%s/-d, --%s/-c doesn't support this option.", flgForceCertDomains, flgDomains, flgCSR)
 			}
 			return nil
 		},
@@ -101,12 +102,21 @@ func createRenew() *cli.Command {
 				Usage: "Force the authorizations to be relinquished even if the certificate request was successful.",
 			},
 			&cli.StringFlag{
-				Name:  flgRenewHook,
-				Usage: "Define a hook. The hook is executed only when the certificates are effectively renewed.",
+				Name:    flgRenewHook,
+				Aliases: []string{"deploy-hook"},
+				Usage:   "Define a hook. The hook is executed only when the certificates are effectively renewed.",
+			},
+			&cli.StringFlag{
+				Name:  flgPreHook,
+				Usage: "Define a hook executed before requesting the renewal (only when a renewal is needed). A failure of the hook aborts the command.",
+			},
+			&cli.StringFlag{
+				Name:  flgPostHook,
+				Usage: "Define a hook executed after requesting the renewal, on success and on failure.",
 			},
 			&cli.DurationFlag{
 				Name:  flgRenewHookTimeout,
-				Usage: "Define the timeout for the hook execution.",
+				Usage: "Define the timeout for the hooks execution.",
 				Value: 2 * time.Minute,
 			},
 			&cli.BoolFlag{
@@ -118,12 +128,20 @@ func createRenew() *cli.Command {
 				Name:  flgForceCertDomains,
 				Usage: "Check and ensure that the cert's domain list matches those passed in the domains argument.",
 			},
+			&cli.BoolFlag{
+				Name:  flgLockSkip,
+				Usage: "Skip the renewal, instead of waiting, when another lego process is already working on the same certificate.",
+			},
 		},
 	}
 }
 
 func renew(ctx *cli.Context) error {
-	account, keyType := setupAccount(ctx, NewAccountsStorage(ctx))
+	accountsStorage := NewAccountsStorage(ctx)
+
+	accountLock := accountsStorage.Lock(ctx.Duration(flgLockTimeout))
+	account, keyType := setupAccount(ctx, accountsStorage)
+	accountLock.Unlock()
 
 	if account.Registration == nil {
 		log.Fatalf("Account %s is not registered. Use 'run' to register a new account.\n", account.Email)
@@ -133,21 +151,32 @@ func renew(ctx *cli.Context) error {
 
 	bundle := !ctx.Bool(flgNoBundle)
 
-	meta := map[string]string{hookEnvAccountEmail: account.Email}
+	hooks := newHookRunner(ctx, flgRenewHook, flgRenewHookTimeout, account.Email)
 
 	// CSR
 	if ctx.IsSet(flgCSR) {
-		return renewForCSR(ctx, account, keyType, certsStorage, bundle, meta)
+		return renewForCSR(ctx, account, keyType, certsStorage, bundle, hooks)
 	}
 
 	// Domains
-	return renewForDomains(ctx, account, keyType, certsStorage, bundle, meta)
+	return renewForDomains(ctx, account, keyType, certsStorage, bundle, hooks)
 }
 
-func renewForDomains(ctx *cli.Context, account *Account, keyType certcrypto.KeyType, certsStorage *CertificatesStorage, bundle bool, meta map[string]string) error {
+func renewForDomains(ctx *cli.Context, account *Account, keyType certcrypto.KeyType, certsStorage *CertificatesStorage, bundle bool, hooks *hookRunner) error {
 	domains := ctx.StringSlice(flgDomains)
 	domain := domains[0]
 
+	lock, err := lockDomain(ctx, certsStorage, domain)
+	if errors.Is(err, errLocked) {
+		log.Infof("[%s] Another lego process is working on this certificate: renewal skipped.", domain)
+		return nil
+	}
+	if err != nil {
+		log.Fatalf("Error while locking the certificate for domain %s\n\t%v", domain, err)
+	}
+
+	defer lock.Unlock()
+
 	// load the cert resource from files.
 	// We store the certificate, private key and metadata in different files
 	// as web servers would not be able to work with a combined file.
@@ -166,7 +195,10 @@ func renewForDomains(ctx *cli.Context, account *Account, keyType certcrypto.KeyT
 	if !ctx.Bool(flgARIDisable) {
 		client = setupClient(ctx, account, keyType)
 
-		ariRenewalTime = getARIRenewalTime(ctx, cert, domain, client)
+		var renewalInfo *certificate.RenewalInfoResponse
+		ariRenewalTime, renewalInfo = getARIRenewalTime(ctx, cert, domain, client)
+		hooks.SetRenewalInfo(renewalInfo)
+
 		if ariRenewalTime != nil {
 			now := time.Now().UTC()
 
@@ -246,19 +278,30 @@ func renewForDomains(ctx *cli.Context, account *Account, keyType certcrypto.KeyT
 		request.ReplacesCertID = replacesCertID
 	}
 
+	hooks.SetDomains(renewalDomains)
+
+	err = hooks.Pre()
+	if err != nil {
+		log.Fatalf("[%s] Pre-hook failed, no renewal requested:\n\t%v", domain, err)
+	}
+
 	certRes, err := client.Certificate.Obtain(request)
 	if err != nil {
+		if errH := hooks.Post(nil, certsStorage, err); errH != nil {
+			log.Warnf("[%s] %v", domain, errH)
+		}
+
 		log.Fatal(err)
 	}
 
 	certsStorage.SaveResource(certRes)
 
-	addPathToMetadata(meta, domain, certRes, certsStorage)
+	errD := hooks.Deploy(certRes, certsStorage)
 
-	return launchHook(ctx.String(flgRenewHook), ctx.Duration(flgRenewHookTimeout), meta)
+	return errors.Join(errD, hooks.Post(certRes, certsStorage, nil))
 }
 
-func renewForCSR(ctx *cli.Context, account *Account, keyType certcrypto.KeyType, certsStorage *CertificatesStorage, bundle bool, meta map[string]string) error {
+func renewForCSR(ctx *cli.Context, account *Account, keyType certcrypto.KeyType, certsStorage *CertificatesStorage, bundle bool, hooks *hookRunner) error {
 	csr, err := readCSRFile(ctx.String(flgCSR))
 	if err != nil {
 		log.Fatal(err)
@@ -269,6 +312,17 @@ func renewForCSR(ctx *cli.Context, account *Account, keyType certcrypto.KeyType,
 		log.Fatalf("Error: %v", err)
 	}
 
+	lock, err := lockDomain(ctx, certsStorage, domain)
+	if errors.Is(err, errLocked) {
+		log.Infof("[%s] Another lego process is working on this certificate: renewal skipped.", domain)
+		return nil
+	}
+	if err != nil {
+		log.Fatalf("Error while locking the certificate for domain %s\n\t%v", domain, err)
+	}
+
+	defer lock.Unlock()
+
 	// load the cert resource from files.
 	// We store the certificate, private key and metadata in different files
 	// as web servers would not be able to work with a combined file.
@@ -287,7 +341,10 @@ func renewForCSR(ctx *cli.Context, account *Account, keyType certcrypto.KeyType,
 	if !ctx.Bool(flgARIDisable) {
 		client = setupClient(ctx, account, keyType)
 
-		ariRenewalTime = getARIRenewalTime(ctx, cert, domain, client)
+		var renewalInfo *certificate.RenewalInfoResponse
+		ariRenewalTime, renewalInfo = getARIRenewalTime(ctx, cert, domain, client)
+		hooks.SetRenewalInfo(renewalInfo)
+
 		if ariRenewalTime != nil {
 			now := time.Now().UTC()
 
@@ -330,16 +387,37 @@ func renewForCSR(ctx *cli.Context, account *Account, keyType certcrypto.KeyType,
 		request.ReplacesCertID = replacesCertID
 	}
 
+	hooks.SetDomains(certcrypto.ExtractDomainsCSR(csr))
+
+	err = hooks.Pre()
+	if err != nil {
+		log.Fatalf("[%s] Pre-hook failed, no renewal requested:\n\t%v", domain, err)
+	}
+
 	certRes, err := client.Certificate.ObtainForCSR(request)
 	if err != nil {
+		if errH := hooks.Post(nil, certsStorage, err); errH != nil {
+			log.Warnf("[%s] %v", domain, errH)
+		}
+
 		log.Fatal(err)
 	}
 
 	certsStorage.SaveResource(certRes)
 
-	addPathToMetadata(meta, domain, certRes, certsStorage)
+	errD := hooks.Deploy(certRes, certsStorage)
+
+	return errors.Join(errD, hooks.Post(certRes, certsStorage, nil))
+}
+
+// lockDomain acquires the lock of the domain.
+// With --lock-skip, it returns errLocked instead of waiting when the lock is held by another process.
+func lockDomain(ctx *cli.Context, certsStorage *CertificatesStorage, domain string) (*fileLock, error) {
+	if ctx.Bool(flgLockSkip) {
+		return certsStorage.TryLock(domain)
+	}
 
-	return launchHook(ctx.String(flgRenewHook), ctx.Duration(flgRenewHookTimeout), meta)
+	return certsStorage.Lock(domain, ctx.Duration(flgLockTimeout))
 }
 
 func needRenewal(x509Cert *x509.Certificate, domain string, days int) bool {
@@ -360,7 +438,8 @@ func needRenewal(x509Cert *x509.Certificate, domain string, days int) bool {
 }
 
 // getARIRenewalTime checks if the certificate needs to be renewed using the renewalInfo endpoint.
-func getARIRenewalTime(ctx *cli.Context, cert *x509.Certificate, domain string, client *lego.Client) *time.Time {
+// It also returns the renewal information, if any.
+func getARIRenewalTime(ctx *cli.Context, cert *x509.Certificate, domain string, client *lego.Client) (*time.Time, *certificate.RenewalInfoResponse) {
 	if cert.IsCA {
 		log.Fatalf("[%s] Certificate bundle starts with a CA certificate", domain)
 	}
@@ -370,17 +449,17 @@ func getARIRenewalTime(ctx *cli.Context, cert *x509.Certificate, domain string,
 		if errors.Is(err, api.ErrNoARI) {
 			// The server does not advertise a renewal info endpoint.
 			log.Warnf("[%s] acme: %v", domain, err)
-			return nil
+			return nil, nil
 		}
 		log.Warnf("[%s] acme: calling renewal info endpoint: %v", domain, err)
-		return nil
+		return nil, nil
 	}
 
 	now := time.Now().UTC()
 	renewalTime := renewalInfo.ShouldRenewAt(now, ctx.Duration(flgARIWaitToRenewDuration))
 	if renewalTime == nil {
 		log.Infof("[%s] acme: renewalInfo endpoint indicates that renewal is not needed", domain)
-		return nil
+		return nil, renewalInfo
 	}
 	log.Infof("[%s] acme: renewalInfo endpoint indicates that renewal is needed", domain)
 
@@ -388,7 +467,7 @@ func getARIRenewalTime(ctx *cli.Context, cert *x509.Certificate, domain string,
 		log.Infof("[%s] acme: renewalInfo endpoint provided an explanation: %s", domain, renewalInfo.ExplanationURL)
 	}
 
-	return renewalTime
+	return renewalTime, renewalInfo
 }
 
 func merge(prevDomains, nextDomains []string) []string {
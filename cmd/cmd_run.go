@@ -2,11 +2,13 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
@@ -25,6 +27,8 @@ const (
 	flgAlwaysDeactivateAuthorizations = "always-deactivate-authorizations"
 	flgRunHook                        = "run-hook"
 	flgRunHookTimeout                 = "run-hook-timeout"
+	flgPreHook                        = "pre-hook"
+	flgPostHook                       = "post-hook"
 )
 
 func createRun() *cli.Command {
@@ -78,12 +82,21 @@ func createRun() *cli.Command {
 				Usage: "Force the authorizations to be relinquished even if the certificate request was successful.",
 			},
 			&cli.StringFlag{
-				Name:  flgRunHook,
-				Usage: "Define a hook. The hook is executed when the certificates are effectively created.",
+				Name:    flgRunHook,
+				Aliases: []string{"deploy-hook"},
+				Usage:   "Define a hook. The hook is executed when the certificates are effectively created.",
+			},
+			&cli.StringFlag{
+				Name:  flgPreHook,
+				Usage: "Define a hook executed before requesting the certificate. A failure of the hook aborts the command.",
+			},
+			&cli.StringFlag{
+				Name:  flgPostHook,
+				Usage: "Define a hook executed after requesting the certificate, on success and on failure.",
 			},
 			&cli.DurationFlag{
 				Name:  flgRunHookTimeout,
-				Usage: "Define the timeout for the hook execution.",
+				Usage: "Define the timeout for the hooks execution.",
 				Value: 2 * time.Minute,
 			},
 		},
@@ -104,6 +117,8 @@ backups of this folder is ideal.
 func run(ctx *cli.Context) error {
 	accountsStorage := NewAccountsStorage(ctx)
 
+	accountLock := accountsStorage.Lock(ctx.Duration(flgLockTimeout))
+
 	account, keyType := setupAccount(ctx, accountsStorage)
 
 	client := setupClient(ctx, account, keyType)
@@ -122,25 +137,76 @@ func run(ctx *cli.Context) error {
 		fmt.Printf(rootPathWarningMessage, accountsStorage.GetRootPath())
 	}
 
+	accountLock.Unlock()
+
 	certsStorage := NewCertificatesStorage(ctx)
 	certsStorage.CreateRootFolder()
 
+	hooks := newHookRunner(ctx, flgRunHook, flgRunHookTimeout, account.Email)
+	domains := getRequestedDomains(ctx)
+	hooks.SetDomains(domains)
+
+	// The certificate is locked before being obtained:
+	// a concurrent run for the same domain waits instead of obtaining another certificate.
+	var lockedDomain string
+
+	if len(domains) > 0 {
+		lockedDomain = domains[0]
+
+		lock, err := certsStorage.Lock(lockedDomain, ctx.Duration(flgLockTimeout))
+		if err != nil {
+			log.Fatalf("Error while locking the certificate for domain %s\n\t%v", lockedDomain, err)
+		}
+
+		defer lock.Unlock()
+	}
+
+	err := hooks.Pre()
+	if err != nil {
+		log.Fatalf("Pre-hook failed, no certificate requested:\n\t%v", err)
+	}
+
 	cert, err := obtainCertificate(ctx, client)
 	if err != nil {
+		if errH := hooks.Post(nil, certsStorage, err); errH != nil {
+			log.Warnf("%v", errH)
+		}
+
 		// Make sure to return a non-zero exit code if ObtainSANCertificate returned at least one error.
 		// Due to us not returning partial certificate we can just exit here instead of at the end.
 		log.Fatalf("Could not obtain certificates:\n\t%v", err)
 	}
 
+	// The domain of the certificate can differ from the requested one (i.e. normalized by the certifier).
+	if sanitizedDomain(cert.Domain) != sanitizedDomain(lockedDomain) {
+		lock, err := certsStorage.Lock(cert.Domain, ctx.Duration(flgLockTimeout))
+		if err != nil {
+			log.Fatalf("Error while locking the certificate for domain %s\n\t%v", cert.Domain, err)
+		}
+
+		defer lock.Unlock()
+	}
+
 	certsStorage.SaveResource(cert)
 
-	meta := map[string]string{
-		hookEnvAccountEmail: account.Email,
+	errD := hooks.Deploy(cert, certsStorage)
+
+	return errors.Join(errD, hooks.Post(cert, certsStorage, nil))
+}
+
+// getRequestedDomains returns the domains from the --domains flag or from the CSR.
+func getRequestedDomains(ctx *cli.Context) []string {
+	domains := ctx.StringSlice(flgDomains)
+	if len(domains) > 0 {
+		return domains
 	}
 
-	addPathToMetadata(meta, cert.Domain, cert, certsStorage)
+	csr, err := readCSRFile(ctx.String(flgCSR))
+	if err != nil {
+		return nil
+	}
 
-	return launchHook(ctx.String(flgRunHook), ctx.Duration(flgRunHookTimeout), meta)
+	return certcrypto.ExtractDomainsCSR(csr)
 }
 
 func handleTOS(ctx *cli.Context, client *lego.Client) bool {
@@ -29,6 +29,8 @@ const (
 	flgHTTPWebroot              = "http.webroot"
 	flgHTTPMemcachedHost        = "http.memcached-host"
 	flgHTTPS3Bucket             = "http.s3-bucket"
+	flgHTTPExec                 = "http.exec"
+	flgHTTPExecTimeout          = "http.exec.timeout"
 	flgTLS                      = "tls"
 	flgTLSPort                  = "tls.port"
 	flgDNS                      = "dns"
@@ -37,6 +39,12 @@ const (
 	flgDNSPropagationDisableANS = "dns.propagation-disable-ans"
 	flgDNSPropagationRNS        = "dns.propagation-rns"
 	flgDNSResolvers             = "dns.resolvers"
+	flgDNSSEC                   = "dns.dnssec"
+	flgDNSPerspective           = "dns.perspective"
+	flgDNSPerspectiveQuorum     = "dns.perspective-quorum"
+	flgDNSSECTrustAnchor        = "dns.dnssec-trust-anchor"
+	flgDNSZone                  = "dns.zone"
+	flgChallengePolicy          = "challenge-policy"
 	flgHTTPTimeout              = "http-timeout"
 	flgTLSSkipVerify            = "tls-skip-verify"
 	flgDNSTimeout               = "dns-timeout"
@@ -47,6 +55,11 @@ const (
 	flgCertTimeout              = "cert.timeout"
 	flgOverallRequestLimit      = "overall-request-limit"
 	flgUserAgent                = "user-agent"
+	flgLockTimeout              = "lock-timeout"
+	flgLogLevel                 = "log-level"
+	flgLogFormat                = "log-format"
+	flgDebugHTTP                = "debug-http"
+	flgDebugHTTPTranscript      = "debug-http.transcript"
 )
 
 const (
@@ -59,6 +72,8 @@ const (
 	envPFXFormat   = "LEGO_PFX_FORMAT"
 	envPFXPassword = "LEGO_PFX_PASSWORD"
 	envServer      = "LEGO_SERVER"
+	envLogLevel    = "LEGO_LOG_LEVEL"
+	envLogFormat   = "LEGO_LOG_FORMAT"
 )
 
 func CreateFlags(defaultPath string) []cli.Flag {
@@ -149,6 +164,15 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Name:  flgHTTPS3Bucket,
 			Usage: "Set the S3 bucket name to use for HTTP-01 based challenges. Challenges will be written to the S3 bucket.",
 		},
+		&cli.StringFlag{
+			Name: flgHTTPExec,
+			Usage: "Set the program to use for HTTP-01 based challenges." +
+				" The program receives a JSON request on its standard input (same protocol as the 'exec' DNS provider in JSON mode).",
+		},
+		&cli.DurationFlag{
+			Name:  flgHTTPExecTimeout,
+			Usage: "Set the time allowed to each call of the program of the HTTP-01 based challenges (0: no limit).",
+		},
 		&cli.BoolFlag{
 			Name:  flgTLS,
 			Usage: "Use the TLS-ALPN-01 challenge to solve challenges. Can be mixed with other types of challenges.",
@@ -159,8 +183,15 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Value: ":443",
 		},
 		&cli.StringFlag{
-			Name:  flgDNS,
-			Usage: "Solve a DNS-01 challenge using the specified provider. Can be mixed with other types of challenges. Run 'lego dnshelp' for help on usage.",
+			Name: flgDNS,
+			Usage: "Solve a DNS-01 challenge using the specified provider. Can be mixed with other types of challenges. Run 'lego dnshelp' for help on usage." +
+				" Several providers can be combined with '+' (e.g. 'route53+cloudflare') to publish the records through all of them.",
+		},
+		&cli.StringSliceFlag{
+			Name: flgDNSZone,
+			Usage: "Use a specific DNS provider for the domains of a zone. Supported: zone=provider." +
+				" The provider is selected by the authoritative zone of the domain, or by the closest parent zone." +
+				" The provider defined by --dns is used for the other zones. Can be specified multiple times.",
 		},
 		&cli.BoolFlag{
 			Name:  flgDNSDisableCP,
@@ -174,6 +205,25 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Name:  flgDNSPropagationRNS,
 			Usage: "By setting this flag to true, use all the recursive nameservers to check the propagation of the TXT record.",
 		},
+		&cli.StringSliceFlag{
+			Name: flgDNSPerspective,
+			Usage: "Check the propagation of the TXT record from a group of resolvers (perspective). Supported: name=resolver[,resolver...]." +
+				" The perspectives are queried concurrently, a perspective succeeds when all its resolvers return the TXT record.",
+		},
+		&cli.IntFlag{
+			Name:  flgDNSPerspectiveQuorum,
+			Usage: "The minimum number of perspectives that must return the TXT record. The default (0) requires all the perspectives.",
+		},
+		&cli.BoolFlag{
+			Name: flgDNSSEC,
+			Usage: "By setting this flag to true, validates the DNSSEC signatures of the TXT record during the propagation checks." +
+				" The answers without a valid chain of trust to a trust anchor are rejected.",
+		},
+		&cli.StringSliceFlag{
+			Name: flgDNSSECTrustAnchor,
+			Usage: "Set the DNSSEC trust anchors, as DS records (e.g. '. IN DS 20326 8 2 E06D...'). Requires '" + flgDNSSEC + "'." +
+				" The default is to use the trust anchors of the root zone.",
+		},
 		&cli.DurationFlag{
 			Name:  flgDNSPropagationWait,
 			Usage: "By setting this flag, disables all the propagation checks of the TXT record and uses a wait duration instead.",
@@ -182,9 +232,16 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Name: flgDNSResolvers,
 			Usage: "Set the resolvers to use for performing (recursive) CNAME resolving and apex domain determination." +
 				" For DNS-01 challenge verification, the authoritative DNS server is queried directly." +
-				" Supported: host:port." +
+				" Supported: host:port, tls://host:port (DNS-over-TLS), https://host/path (DNS-over-HTTPS)." +
 				" The default is to use the system resolvers, or Google's DNS resolvers if the system's cannot be determined.",
 		},
+		&cli.StringSliceFlag{
+			Name: flgChallengePolicy,
+			Usage: "Define the challenges to use for the domains matching a pattern, by order of preference." +
+				" Supported: 'pattern=challenge[,challenge...]' where the pattern is '*', '*.example.com', or 'example.com'," +
+				" and a DNS provider can be set with 'dns-01:provider'." +
+				" The first matching rule is used. Can be specified multiple times.",
+		},
 		&cli.IntFlag{
 			Name:  flgHTTPTimeout,
 			Usage: "Set the HTTP timeout value to a specific value in seconds.",
@@ -233,6 +290,30 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Name:  flgUserAgent,
 			Usage: "Add to the user-agent sent to the CA to identify an application embedding lego-cli",
 		},
+		&cli.StringFlag{
+			Name:    flgLogLevel,
+			EnvVars: []string{envLogLevel},
+			Usage:   "Set the minimum level of the log messages. Supported: debug, info, warn, error.",
+			Value:   "info",
+		},
+		&cli.StringFlag{
+			Name:    flgLogFormat,
+			EnvVars: []string{envLogFormat},
+			Usage:   "Set the format of the log messages. Supported: text, json.",
+			Value:   logFormatText,
+		},
+		&cli.BoolFlag{
+			Name:  flgDebugHTTP,
+			Usage: "Log the HTTP exchanges with the ACME server (decoded JWS, secrets redacted). Implies '--log-level debug' when the log level is not set.",
+		},
+		&cli.StringFlag{
+			Name:  flgDebugHTTPTranscript,
+			Usage: "Write the HTTP exchanges with the ACME server (JSON lines, secrets redacted) to the specified file.",
+		},
+		&cli.DurationFlag{
+			Name:  flgLockTimeout,
+			Usage: "Set the maximum duration to wait for another lego process working on the same account or certificate. 0 means no limit.",
+		},
 	}
 }
 
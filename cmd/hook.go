@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -9,7 +11,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/urfave/cli/v2"
 )
 
 const (
@@ -20,21 +26,234 @@ const (
 	hookEnvIssuerCertKeyPath = "LEGO_ISSUER_CERT_PATH"
 	hookEnvCertPEMPath       = "LEGO_CERT_PEM_PATH"
 	hookEnvCertPFXPath       = "LEGO_CERT_PFX_PATH"
+	hookEnvEvent             = "LEGO_HOOK_EVENT"
 )
 
-func launchHook(hook string, timeout time.Duration, meta map[string]string) error {
+// Hook events.
+const (
+	hookEventPre    = "pre"
+	hookEventPost   = "post"
+	hookEventDeploy = "deploy"
+)
+
+// Hook status (post-hook only).
+const (
+	hookStatusSuccess = "success"
+	hookStatusFailure = "failure"
+)
+
+// hookPayload is the JSON document sent to the hooks on stdin.
+type hookPayload struct {
+	Event       string           `json:"event"`
+	Command     string           `json:"command"`
+	Status      string           `json:"status,omitempty"`
+	Account     string           `json:"account,omitempty"`
+	Domain      string           `json:"domain,omitempty"`
+	Domains     []string         `json:"domains,omitempty"`
+	Files       *hookFiles       `json:"files,omitempty"`
+	Certificate *hookCertificate `json:"certificate,omitempty"`
+	RenewalInfo *hookRenewalInfo `json:"renewalInfo,omitempty"`
+	Error       *hookError       `json:"error,omitempty"`
+}
+
+type hookFiles struct {
+	Certificate string `json:"certificate,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+	Issuer      string `json:"issuer,omitempty"`
+	PEM         string `json:"pem,omitempty"`
+	PFX         string `json:"pfx,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+}
+
+type hookCertificate struct {
+	Serial    string    `json:"serial"`
+	Issuer    string    `json:"issuer"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+	URL       string    `json:"url,omitempty"`
+}
+
+type hookRenewalInfo struct {
+	WindowStart    time.Time `json:"windowStart"`
+	WindowEnd      time.Time `json:"windowEnd"`
+	ExplanationURL string    `json:"explanationUrl,omitempty"`
+}
+
+type hookError struct {
+	Message string `json:"message"`
+	Type    string `json:"type,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+	Status  int    `json:"status,omitempty"`
+}
+
+// hookRunner launches the hooks of a command (run or renew).
+//
+//   - the pre-hook is executed before the certificate request, an error aborts the command.
+//   - the deploy hook is executed when the certificate is effectively created or renewed.
+//   - the post-hook is executed after the certificate request, on success and on failure.
+//
+// An error from the deploy hook or the post-hook doesn't stop the command, but makes it fail.
+type hookRunner struct {
+	pre     string
+	post    string
+	deploy  string
+	timeout time.Duration
+
+	meta    map[string]string
+	payload hookPayload
+}
+
+func newHookRunner(ctx *cli.Context, deployFlag, timeoutFlag, accountEmail string) *hookRunner {
+	return &hookRunner{
+		pre:     ctx.String(flgPreHook),
+		post:    ctx.String(flgPostHook),
+		deploy:  ctx.String(deployFlag),
+		timeout: ctx.Duration(timeoutFlag),
+		meta:    map[string]string{hookEnvAccountEmail: accountEmail},
+		payload: hookPayload{Command: ctx.Command.Name, Account: accountEmail},
+	}
+}
+
+// SetDomains defines the domains of the certificate request.
+func (h *hookRunner) SetDomains(domains []string) {
+	if len(domains) > 0 {
+		h.payload.Domain = domains[0]
+	}
+
+	h.payload.Domains = domains
+}
+
+// SetRenewalInfo defines the renewal window returned by the renewalInfo endpoint.
+func (h *hookRunner) SetRenewalInfo(renewalInfo *certificate.RenewalInfoResponse) {
+	if renewalInfo == nil {
+		return
+	}
+
+	h.payload.RenewalInfo = &hookRenewalInfo{
+		WindowStart:    renewalInfo.SuggestedWindow.Start,
+		WindowEnd:      renewalInfo.SuggestedWindow.End,
+		ExplanationURL: renewalInfo.ExplanationURL,
+	}
+}
+
+// Pre launches the pre-hook.
+func (h *hookRunner) Pre() error {
+	payload := h.payload
+	payload.Event = hookEventPre
+
+	return launchHook(h.pre, h.timeout, h.meta, &payload)
+}
+
+// Deploy launches the deploy hook.
+func (h *hookRunner) Deploy(certRes *certificate.Resource, certsStorage *CertificatesStorage) error {
+	h.setCertificate(certRes, certsStorage)
+
+	payload := h.payload
+	payload.Event = hookEventDeploy
+
+	return launchHook(h.deploy, h.timeout, h.meta, &payload)
+}
+
+// Post launches the post-hook.
+// certRes must be nil when the certificate request has failed.
+func (h *hookRunner) Post(certRes *certificate.Resource, certsStorage *CertificatesStorage, reqErr error) error {
+	if reqErr == nil {
+		h.setCertificate(certRes, certsStorage)
+	}
+
+	payload := h.payload
+	payload.Event = hookEventPost
+
+	if reqErr != nil {
+		payload.Status = hookStatusFailure
+		payload.Error = newHookError(reqErr)
+	} else {
+		payload.Status = hookStatusSuccess
+	}
+
+	return launchHook(h.post, h.timeout, h.meta, &payload)
+}
+
+func (h *hookRunner) setCertificate(certRes *certificate.Resource, certsStorage *CertificatesStorage) {
+	if h.payload.Files != nil {
+		// already defined.
+		return
+	}
+
+	addPathToMetadata(h.meta, certRes.Domain, certRes, certsStorage)
+
+	h.payload.Domain = certRes.Domain
+	h.payload.Files = &hookFiles{
+		Certificate: h.meta[hookEnvCertPath],
+		Issuer:      h.meta[hookEnvIssuerCertKeyPath],
+		PEM:         h.meta[hookEnvCertPEMPath],
+		PFX:         h.meta[hookEnvCertPFXPath],
+		Resource:    certsStorage.GetFileName(certRes.Domain, resourceExt),
+	}
+
+	if certRes.PrivateKey != nil {
+		h.payload.Files.PrivateKey = h.meta[hookEnvCertKeyPath]
+	}
+
+	cert, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	if err != nil {
+		log.Warnf("[%s] Unable to parse the certificate for the hooks: %v", certRes.Domain, err)
+		return
+	}
+
+	h.payload.Domains = certcrypto.ExtractDomains(cert)
+	h.payload.Certificate = &hookCertificate{
+		Serial:    fmt.Sprintf("%x", cert.SerialNumber),
+		Issuer:    cert.Issuer.String(),
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+		URL:       certRes.CertURL,
+	}
+}
+
+func newHookError(err error) *hookError {
+	hErr := &hookError{Message: err.Error()}
+
+	var problem *acme.ProblemDetails
+	if errors.As(err, &problem) {
+		hErr.Type = problem.Type
+		hErr.Detail = problem.Detail
+		hErr.Status = problem.HTTPStatus
+	}
+
+	return hErr
+}
+
+func launchHook(hook string, timeout time.Duration, meta map[string]string, payload *hookPayload) error {
 	if hook == "" {
 		return nil
 	}
 
+	parts, err := splitCommandLine(hook)
+	if err != nil {
+		return fmt.Errorf("invalid hook command: %w", err)
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+
 	ctxCmd, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	parts := strings.Fields(hook)
-
 	cmdCtx := exec.CommandContext(ctxCmd, parts[0], parts[1:]...)
 	cmdCtx.Env = append(os.Environ(), metaToEnv(meta)...)
 
+	if payload != nil {
+		data, errM := json.Marshal(payload)
+		if errM != nil {
+			return fmt.Errorf("hook payload: %w", errM)
+		}
+
+		cmdCtx.Env = append(cmdCtx.Env, hookEnvEvent+"="+payload.Event)
+		cmdCtx.Stdin = bytes.NewReader(data)
+	}
+
 	output, err := cmdCtx.CombinedOutput()
 
 	if len(output) > 0 {
@@ -45,6 +264,10 @@ func launchHook(hook string, timeout time.Duration, meta map[string]string) erro
 		return errors.New("hook timed out")
 	}
 
+	if err != nil && payload != nil {
+		return fmt.Errorf("%s hook: %w", payload.Event, err)
+	}
+
 	return err
 }
 
@@ -75,3 +298,77 @@ func addPathToMetadata(meta map[string]string, domain string, certRes *certifica
 		meta[hookEnvCertPFXPath] = certsStorage.GetFileName(domain, pfxExt)
 	}
 }
+
+// splitCommandLine splits a command line into arguments, following the POSIX shell quoting rules:
+// single quotes preserve the literal value of each character,
+// and double quotes preserve the literal value of each character except backslash escapes of `"`, `\`, and `$`.
+// Unlike a POSIX shell, a backslash outside quotes is a literal character, to support the Windows paths (i.e. `C:\tools\hook.exe`).
+// Other shell features (variables, pipes, redirections, etc.) are not supported:
+// use an explicit shell (`sh -c '...'`) for that.
+func splitCommandLine(s string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			if quote == '"' && !strings.ContainsRune("\"\\$`", r) {
+				current.WriteRune('\\')
+			}
+
+			current.WriteRune(r)
+			escaped = false
+
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+
+		case r == '\\' && quote == '"':
+			escaped = true
+
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if escaped {
+		return nil, errors.New("unterminated escape sequence")
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quoted string: missing %c", quote)
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitCommandLine(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    string
+		expected []string
+	}{
+		{
+			desc:     "empty",
+			input:    "",
+			expected: nil,
+		},
+		{
+			desc:     "simple",
+			input:    "./myscript.sh a b",
+			expected: []string{"./myscript.sh", "a", "b"},
+		},
+		{
+			desc:     "extra spaces",
+			input:    "  ./myscript.sh \t a   b  ",
+			expected: []string{"./myscript.sh", "a", "b"},
+		},
+		{
+			desc:     "single quotes",
+			input:    `sh -c 'echo "$LEGO_CERT_DOMAIN" > /tmp/a b'`,
+			expected: []string{"sh", "-c", `echo "$LEGO_CERT_DOMAIN" > /tmp/a b`},
+		},
+		{
+			desc:     "double quotes",
+			input:    `"/opt/my scripts/deploy.sh" "a \"b\" \c"`,
+			expected: []string{"/opt/my scripts/deploy.sh", `a "b" \c`},
+		},
+		{
+			desc:     "backslash outside quotes",
+			input:    `C:\tools\hook.exe C:\certs\`,
+			expected: []string{`C:\tools\hook.exe`, `C:\certs\`},
+		},
+		{
+			desc:     "Windows path in double quotes",
+			input:    `"C:\Program Files\hook.exe" "C:\\certs"`,
+			expected: []string{`C:\Program Files\hook.exe`, `C:\certs`},
+		},
+		{
+			desc:     "empty quoted argument",
+			input:    `deploy.sh '' ""`,
+			expected: []string{"deploy.sh", "", ""},
+		},
+		{
+			desc:     "concatenated quotes",
+			input:    `a'b'"c"d`,
+			expected: []string{"abcd"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := splitCommandLine(test.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, args)
+		})
+	}
+}
+
+func Test_splitCommandLine_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		input    string
+		expected string
+	}{
+		{
+			desc:     "unterminated single quote",
+			input:    `a 'b`,
+			expected: "unterminated quoted string: missing '",
+		},
+		{
+			desc:     "unterminated double quote",
+			input:    `a "b`,
+			expected: `unterminated quoted string: missing "`,
+		},
+		{
+			desc:     "unterminated escape",
+			input:    `a "b\`,
+			expected: "unterminated escape sequence",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := splitCommandLine(test.input)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_launchHook_payload(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "payload.json")
+
+	hook := `sh -c 'cat > "$0"; test "$LEGO_HOOK_EVENT" = post' ` + output
+
+	payload := &hookPayload{
+		Event:   hookEventPost,
+		Command: "renew",
+		Status:  hookStatusFailure,
+		Domain:  "example.com",
+		Domains: []string{"example.com", "*.example.com"},
+		Error:   &hookError{Message: "boom"},
+	}
+
+	err := launchHook(hook, 10*time.Second, map[string]string{hookEnvCertDomain: "example.com"}, payload)
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	var actual hookPayload
+	err = json.Unmarshal(raw, &actual)
+	require.NoError(t, err)
+
+	assert.Equal(t, payload, &actual)
+}
+
+func Test_launchHook_exitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	err := launchHook(`sh -c 'exit 3'`, 10*time.Second, nil, &hookPayload{Event: hookEventPre})
+	require.EqualError(t, err, "pre hook: exit status 3")
+}
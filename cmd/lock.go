@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/go-acme/lego/v4/log"
+	"github.com/gofrs/flock"
+)
+
+// lockRetryDelay is the delay between two attempts to acquire a lock held by another process.
+const lockRetryDelay = 500 * time.Millisecond
+
+// errLocked is returned when a lock is held by another process.
+var errLocked = errors.New("locked by another process")
+
+// fileLock is an advisory lock based on a file (flock on Unix, LockFileEx on Windows).
+// The lock is released by the OS if the process dies.
+type fileLock struct {
+	flock *flock.Flock
+}
+
+// lockFile acquires the lock on the file at path.
+// It waits for the lock to be released by another process, at most for the given timeout (0 means no limit).
+func lockFile(path string, timeout time.Duration) (*fileLock, error) {
+	fl, err := newFlock(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	locked, err := fl.TryLockContext(ctx, lockRetryDelay)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("lock %s: still held by another process after %s", path, timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock %s: %w", path, err)
+	}
+
+	if !locked {
+		return nil, fmt.Errorf("%s: %w", path, errLocked)
+	}
+
+	return &fileLock{flock: fl}, nil
+}
+
+// tryLockFile tries to acquire the lock on the file at path without waiting.
+func tryLockFile(path string) (*fileLock, error) {
+	fl, err := newFlock(path)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := fl.TryLock()
+	if err != nil {
+		return nil, fmt.Errorf("lock %s: %w", path, err)
+	}
+
+	if !locked {
+		return nil, fmt.Errorf("%s: %w", path, errLocked)
+	}
+
+	return &fileLock{flock: fl}, nil
+}
+
+// Unlock releases the lock.
+// The lock file is kept: removing it would allow another process to lock a different inode.
+func (l *fileLock) Unlock() {
+	if l == nil {
+		return
+	}
+
+	err := l.flock.Unlock()
+	if err != nil {
+		log.Warnf("Unable to release the lock %s: %v", l.flock.Path(), err)
+	}
+}
+
+func newFlock(path string) (*flock.Flock, error) {
+	err := createNonExistingFolder(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not check/create lock directory: %w", err)
+	}
+
+	return flock.New(path, flock.SetPermissions(filePerm)), nil
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -73,6 +74,10 @@ func newClient(ctx *cli.Context, acc registration.User, keyType certcrypto.KeyTy
 
 	config.HTTPClient = retryClient.StandardClient()
 
+	if ctx.Bool(flgDebugHTTP) || ctx.String(flgDebugHTTPTranscript) != "" {
+		config.Debug = lego.DebugConfig{HTTP: true, Transcript: openTranscript(ctx.String(flgDebugHTTPTranscript))}
+	}
+
 	client, err := lego.NewClient(config)
 	if err != nil {
 		log.Fatalf("Could not create client: %v", err)
@@ -158,3 +163,18 @@ func readCSRFile(filename string) (*x509.CertificateRequest, error) {
 	// (if this assumption is wrong, parsing these bytes will fail)
 	return x509.ParseCertificateRequest(raw)
 }
+
+// openTranscript opens (append mode) the file where the HTTP exchanges are written.
+// The file is closed when the process exits.
+func openTranscript(filename string) io.Writer {
+	if filename == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, filePerm)
+	if err != nil {
+		log.Fatalf("Could not open the HTTP transcript file: %v", err)
+	}
+
+	return file
+}
@@ -9,10 +9,12 @@ import (
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/challenge/resolver"
 	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/providers/dns"
+	"github.com/go-acme/lego/v4/providers/http/exec"
 	"github.com/go-acme/lego/v4/providers/http/memcached"
 	"github.com/go-acme/lego/v4/providers/http/s3"
 	"github.com/go-acme/lego/v4/providers/http/webroot"
@@ -20,7 +22,7 @@ import (
 )
 
 func setupChallenges(ctx *cli.Context, client *lego.Client) {
-	if !ctx.Bool(flgHTTP) && !ctx.Bool(flgTLS) && !ctx.IsSet(flgDNS) {
+	if !ctx.Bool(flgHTTP) && !ctx.Bool(flgTLS) && !ctx.IsSet(flgDNS) && !ctx.IsSet(flgDNSZone) && !ctx.IsSet(flgChallengePolicy) {
 		log.Fatalf("No challenge selected. You must specify at least one challenge: `--%s`, `--%s`, `--%s`.", flgHTTP, flgTLS, flgDNS)
 	}
 
@@ -38,12 +40,19 @@ func setupChallenges(ctx *cli.Context, client *lego.Client) {
 		}
 	}
 
-	if ctx.IsSet(flgDNS) {
+	if ctx.IsSet(flgDNS) || ctx.IsSet(flgDNSZone) {
 		err := setupDNS(ctx, client)
 		if err != nil {
 			log.Fatal(err)
 		}
 	}
+
+	if ctx.IsSet(flgChallengePolicy) {
+		err := setupChallengePolicy(ctx, client)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 }
 
 //nolint:gocyclo // the complexity is expected.
@@ -67,6 +76,12 @@ func setupHTTPProvider(ctx *cli.Context) challenge.Provider {
 			log.Fatal(err)
 		}
 		return ps
+	case ctx.IsSet(flgHTTPExec):
+		ps, err := exec.NewHTTPProvider(ctx.String(flgHTTPExec), ctx.Duration(flgHTTPExecTimeout))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return ps
 	case ctx.IsSet(flgHTTPPort):
 		iface := ctx.String(flgHTTPPort)
 		if !strings.Contains(iface, ":") {
@@ -118,24 +133,115 @@ func setupTLSProvider(ctx *cli.Context) challenge.Provider {
 }
 
 func setupDNS(ctx *cli.Context, client *lego.Client) error {
-	err := checkPropagationExclusiveOptions(ctx)
+	opts, err := dnsChallengeOptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	provider, err := setupDNSProvider(ctx)
 	if err != nil {
 		return err
 	}
 
+	return client.Challenge.SetDNS01Provider(provider, opts...)
+}
+
+// setupDNSProvider creates the DNS provider,
+// the providers are routed by zone when zones are defined.
+func setupDNSProvider(ctx *cli.Context) (challenge.Provider, error) {
+	if !ctx.IsSet(flgDNSZone) {
+		return dns.NewDNSChallengeProviderByName(ctx.String(flgDNS))
+	}
+
+	// The providers are shared between the zones.
+	providers := make(map[string]challenge.Provider)
+
+	getProvider := func(name string) (challenge.Provider, error) {
+		if p, ok := providers[name]; ok {
+			return p, nil
+		}
+
+		p, err := dns.NewDNSChallengeProviderByName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		providers[name] = p
+
+		return p, nil
+	}
+
+	routes := make(map[string]challenge.Provider)
+
+	for _, value := range ctx.StringSlice(flgDNSZone) {
+		zone, name, ok := strings.Cut(value, "=")
+		if !ok || strings.TrimSpace(zone) == "" || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid value for '%s': %q, expected 'zone=provider'", flgDNSZone, value)
+		}
+
+		p, err := getProvider(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		routes[strings.TrimSpace(zone)] = p
+	}
+
+	if ctx.IsSet(flgDNS) {
+		p, err := getProvider(ctx.String(flgDNS))
+		if err != nil {
+			return nil, err
+		}
+
+		routes["."] = p
+	}
+
+	return dns01.NewZoneRouter(routes)
+}
+
+func dnsChallengeOptions(ctx *cli.Context) ([]dns01.ChallengeOption, error) {
+	err := checkPropagationExclusiveOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	wait := ctx.Duration(flgDNSPropagationWait)
 	if wait < 0 {
-		return fmt.Errorf("'%s' cannot be negative", flgDNSPropagationWait)
+		return nil, fmt.Errorf("'%s' cannot be negative", flgDNSPropagationWait)
+	}
+
+	if ctx.IsSet(flgDNSSECTrustAnchor) && !ctx.Bool(flgDNSSEC) {
+		return nil, fmt.Errorf("'%s' requires '%s'", flgDNSSECTrustAnchor, flgDNSSEC)
 	}
 
-	provider, err := dns.NewDNSChallengeProviderByName(ctx.String(flgDNS))
+	anchors, err := dns01.ParseTrustAnchors(ctx.StringSlice(flgDNSSECTrustAnchor))
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("'%s': %w", flgDNSSECTrustAnchor, err)
+	}
+
+	var perspectives []dns01.Perspective
+
+	for _, value := range joinKeyValues(ctx.StringSlice(flgDNSPerspective)) {
+		perspective, errP := parsePerspective(value)
+		if errP != nil {
+			return nil, errP
+		}
+
+		perspectives = append(perspectives, perspective)
+	}
+
+	if ctx.IsSet(flgDNSPerspectiveQuorum) && len(perspectives) == 0 {
+		return nil, fmt.Errorf("'%s' requires '%s'", flgDNSPerspectiveQuorum, flgDNSPerspective)
+	}
+
+	quorum := ctx.Int(flgDNSPerspectiveQuorum)
+	if quorum < 0 || quorum > len(perspectives) {
+		return nil, fmt.Errorf("'%s' must be between 0 and the number of perspectives (%d)", flgDNSPerspectiveQuorum, len(perspectives))
 	}
 
 	servers := ctx.StringSlice(flgDNSResolvers)
 
-	err = client.Challenge.SetDNS01Provider(provider,
+	return []dns01.ChallengeOption{
 		dns01.CondOption(len(servers) > 0,
 			dns01.AddRecursiveNameservers(dns01.ParseNameservers(ctx.StringSlice(flgDNSResolvers)))),
 
@@ -150,11 +256,87 @@ func setupDNS(ctx *cli.Context, client *lego.Client) error {
 		dns01.CondOption(ctx.Bool(flgDNSPropagationRNS),
 			dns01.RecursiveNSsPropagationRequirement()),
 
+		dns01.CondOption(ctx.Bool(flgDNSSEC),
+			dns01.DNSSECValidation(anchors...)),
+
+		dns01.CondOption(len(perspectives) > 0,
+			dns01.PerspectivesPropagationRequirement(quorum, perspectives...)),
+
 		dns01.CondOption(ctx.IsSet(flgDNSTimeout),
 			dns01.AddDNSTimeout(time.Duration(ctx.Int(flgDNSTimeout))*time.Second)),
-	)
+	}, nil
+}
+
+// setupChallengePolicy defines the challenge selection policy from the flags.
+// The rules are 'pattern=challenge[,challenge...]', a DNS provider can be set with 'dns-01:provider'.
+func setupChallengePolicy(ctx *cli.Context, client *lego.Client) error {
+	var rules []resolver.PolicyRule
+
+	for _, value := range joinKeyValues(ctx.StringSlice(flgChallengePolicy)) {
+		rule, err := parseChallengePolicy(value)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := rule.Providers[challenge.DNS01]; ok {
+			rule.DNS01Options, err = dnsChallengeOptions(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return client.Challenge.SetPolicy(rules...)
+}
+
+// joinKeyValues rebuilds the "key=value[,value...]" entries split on commas by the slice flag.
+func joinKeyValues(values []string) []string {
+	var rules []string
+
+	for _, value := range values {
+		if len(rules) > 0 && !strings.Contains(value, "=") {
+			rules[len(rules)-1] += "," + value
+			continue
+		}
+
+		rules = append(rules, value)
+	}
 
-	return err
+	return rules
+}
+
+func parseChallengePolicy(value string) (resolver.PolicyRule, error) {
+	pattern, challenges, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(pattern) == "" || strings.TrimSpace(challenges) == "" {
+		return resolver.PolicyRule{}, fmt.Errorf("invalid challenge policy %q: expected 'pattern=challenge[,challenge...]'", value)
+	}
+
+	rule := resolver.PolicyRule{Pattern: strings.TrimSpace(pattern)}
+
+	for _, item := range strings.Split(challenges, ",") {
+		chlgType, providerName, _ := strings.Cut(strings.TrimSpace(item), ":")
+
+		rule.Challenges = append(rule.Challenges, challenge.Type(chlgType))
+
+		if providerName == "" {
+			continue
+		}
+
+		if challenge.Type(chlgType) != challenge.DNS01 {
+			return resolver.PolicyRule{}, fmt.Errorf("invalid challenge policy %q: a provider can only be defined for %s", value, challenge.DNS01)
+		}
+
+		provider, err := dns.NewDNSChallengeProviderByName(providerName)
+		if err != nil {
+			return resolver.PolicyRule{}, err
+		}
+
+		rule.Providers = map[challenge.Type]challenge.Provider{challenge.DNS01: provider}
+	}
+
+	return rule, nil
 }
 
 func checkPropagationExclusiveOptions(ctx *cli.Context) error {
@@ -176,3 +358,25 @@ func checkPropagationExclusiveOptions(ctx *cli.Context) error {
 func isSetBool(ctx *cli.Context, name string) bool {
 	return ctx.IsSet(name) && ctx.Bool(name)
 }
+
+// parsePerspective parses a perspective: 'name=resolver[,resolver...]'.
+func parsePerspective(value string) (dns01.Perspective, error) {
+	name, servers, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(servers) == "" {
+		return dns01.Perspective{}, fmt.Errorf("invalid perspective %q: expected 'name=resolver[,resolver...]'", value)
+	}
+
+	perspective := dns01.Perspective{Name: strings.TrimSpace(name)}
+
+	for _, server := range strings.Split(servers, ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			perspective.Nameservers = append(perspective.Nameservers, server)
+		}
+	}
+
+	if len(perspective.Nameservers) == 0 {
+		return dns01.Perspective{}, fmt.Errorf("invalid perspective %q: no resolvers", value)
+	}
+
+	return perspective, nil
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_joinKeyValues(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		values   []string
+		expected []string
+	}{
+		{
+			desc:     "empty",
+			expected: nil,
+		},
+		{
+			desc:     "single challenges",
+			values:   []string{"*.example.com=dns-01", "*=http-01"},
+			expected: []string{"*.example.com=dns-01", "*=http-01"},
+		},
+		{
+			desc:     "split on commas",
+			values:   []string{"*.example.com=dns-01", "*=http-01", "tls-alpn-01", "dns-01"},
+			expected: []string{"*.example.com=dns-01", "*=http-01,tls-alpn-01,dns-01"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, joinKeyValues(test.values))
+		})
+	}
+}
+
+func Test_parseChallengePolicy(t *testing.T) {
+	rule, err := parseChallengePolicy("*.example.com = http-01, dns-01")
+	require.NoError(t, err)
+
+	assert.Equal(t, "*.example.com", rule.Pattern)
+	assert.Equal(t, []challenge.Type{challenge.HTTP01, challenge.DNS01}, rule.Challenges)
+	assert.Empty(t, rule.Providers)
+
+	rule, err = parseChallengePolicy("internal.example.com=dns-01:manual")
+	require.NoError(t, err)
+
+	assert.Equal(t, "internal.example.com", rule.Pattern)
+	assert.Equal(t, []challenge.Type{challenge.DNS01}, rule.Challenges)
+	assert.IsType(t, &dns01.DNSProviderManual{}, rule.Providers[challenge.DNS01])
+}
+
+func Test_parseChallengePolicy_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected string
+	}{
+		{
+			desc:     "missing challenges",
+			value:    "example.com",
+			expected: `invalid challenge policy "example.com": expected 'pattern=challenge[,challenge...]'`,
+		},
+		{
+			desc:     "missing pattern",
+			value:    "=http-01",
+			expected: `invalid challenge policy "=http-01": expected 'pattern=challenge[,challenge...]'`,
+		},
+		{
+			desc:     "provider for http-01",
+			value:    "example.com=http-01:webroot",
+			expected: `invalid challenge policy "example.com=http-01:webroot": a provider can only be defined for dns-01`,
+		},
+		{
+			desc:     "unknown DNS provider",
+			value:    "example.com=dns-01:foo",
+			expected: "unrecognized DNS provider: foo",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseChallengePolicy(test.value)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_parsePerspective(t *testing.T) {
+	perspective, err := parsePerspective("eu= 192.0.2.1:53, tls://dns.example.com ")
+	require.NoError(t, err)
+
+	expected := dns01.Perspective{
+		Name:        "eu",
+		Nameservers: []string{"192.0.2.1:53", "tls://dns.example.com"},
+	}
+
+	assert.Equal(t, expected, perspective)
+}
+
+func Test_parsePerspective_errors(t *testing.T) {
+	testCases := []string{"eu", "=192.0.2.1", "eu=", "eu= , "}
+
+	for _, value := range testCases {
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parsePerspective(value)
+			require.Error(t, err)
+		})
+	}
+}
@@ -135,6 +135,7 @@ func allDNSCodes() string {
 		"simply",
 		"sonic",
 		"stackpath",
+		"standalone",
 		"technitium",
 		"tencentcloud",
 		"timewebcloud",
@@ -163,6 +164,3791 @@ func allDNSCodes() string {
 	return strings.Join(providers, ", ")
 }
 
+// dnsCredentials returns the environment variables of the credentials of a DNS provider.
+func dnsCredentials(name string) []string {
+	switch name {
+	case "acme-dns":
+		return []string{
+			"ACME_DNS_API_BASE",
+			"ACME_DNS_STORAGE_BASE_URL",
+			"ACME_DNS_STORAGE_PATH",
+		}
+	case "alidns":
+		return []string{
+			"ALICLOUD_ACCESS_KEY",
+			"ALICLOUD_RAM_ROLE",
+			"ALICLOUD_SECRET_KEY",
+			"ALICLOUD_SECURITY_TOKEN",
+		}
+	case "allinkl":
+		return []string{
+			"ALL_INKL_LOGIN",
+			"ALL_INKL_PASSWORD",
+		}
+	case "arvancloud":
+		return []string{
+			"ARVANCLOUD_API_KEY",
+		}
+	case "auroradns":
+		return []string{
+			"AURORA_API_KEY",
+			"AURORA_SECRET",
+		}
+	case "autodns":
+		return []string{
+			"AUTODNS_API_PASSWORD",
+			"AUTODNS_API_USER",
+		}
+	case "azure":
+		return []string{
+			"AZURE_CLIENT_ID",
+			"AZURE_CLIENT_SECRET",
+			"AZURE_ENVIRONMENT",
+			"AZURE_RESOURCE_GROUP",
+			"AZURE_SUBSCRIPTION_ID",
+			"AZURE_TENANT_ID",
+		}
+	case "azuredns":
+		return []string{
+			"AZURE_CLIENT_CERTIFICATE_PATH",
+			"AZURE_CLIENT_ID",
+			"AZURE_CLIENT_SECRET",
+			"AZURE_TENANT_ID",
+		}
+	case "bindman":
+		return []string{
+			"BINDMAN_MANAGER_ADDRESS",
+		}
+	case "bluecat":
+		return []string{
+			"BLUECAT_CONFIG_NAME",
+			"BLUECAT_DNS_VIEW",
+			"BLUECAT_PASSWORD",
+			"BLUECAT_SERVER_URL",
+			"BLUECAT_USER_NAME",
+		}
+	case "brandit":
+		return []string{
+			"BRANDIT_API_KEY",
+			"BRANDIT_API_USERNAME",
+		}
+	case "bunny":
+		return []string{
+			"BUNNY_API_KEY",
+		}
+	case "checkdomain":
+		return []string{
+			"CHECKDOMAIN_TOKEN",
+		}
+	case "civo":
+		return []string{
+			"CIVO_TOKEN",
+		}
+	case "clouddns":
+		return []string{
+			"CLOUDDNS_CLIENT_ID",
+			"CLOUDDNS_EMAIL",
+			"CLOUDDNS_PASSWORD",
+		}
+	case "cloudflare":
+		return []string{
+			"CF_API_EMAIL",
+			"CF_API_KEY",
+			"CF_DNS_API_TOKEN",
+			"CF_ZONE_API_TOKEN",
+			"CLOUDFLARE_API_KEY",
+			"CLOUDFLARE_DNS_API_TOKEN",
+			"CLOUDFLARE_EMAIL",
+			"CLOUDFLARE_ZONE_API_TOKEN",
+		}
+	case "cloudns":
+		return []string{
+			"CLOUDNS_AUTH_ID",
+			"CLOUDNS_AUTH_PASSWORD",
+		}
+	case "cloudru":
+		return []string{
+			"CLOUDRU_KEY_ID",
+			"CLOUDRU_SECRET",
+			"CLOUDRU_SERVICE_INSTANCE_ID",
+		}
+	case "cloudxns":
+		return []string{
+			"CLOUDXNS_API_KEY",
+			"CLOUDXNS_SECRET_KEY",
+		}
+	case "conoha":
+		return []string{
+			"CONOHA_API_PASSWORD",
+			"CONOHA_API_USERNAME",
+			"CONOHA_TENANT_ID",
+		}
+	case "constellix":
+		return []string{
+			"CONSTELLIX_API_KEY",
+			"CONSTELLIX_SECRET_KEY",
+		}
+	case "corenetworks":
+		return []string{
+			"CORENETWORKS_LOGIN",
+			"CORENETWORKS_PASSWORD",
+		}
+	case "cpanel":
+		return []string{
+			"CPANEL_BASE_URL",
+			"CPANEL_TOKEN",
+			"CPANEL_USERNAME",
+		}
+	case "derak":
+		return []string{
+			"DERAK_API_KEY",
+		}
+	case "desec":
+		return []string{
+			"DESEC_TOKEN",
+		}
+	case "designate":
+		return []string{
+			"OS_APPLICATION_CREDENTIAL_ID",
+			"OS_APPLICATION_CREDENTIAL_NAME",
+			"OS_APPLICATION_CREDENTIAL_SECRET",
+			"OS_AUTH_URL",
+			"OS_PASSWORD",
+			"OS_PROJECT_NAME",
+			"OS_REGION_NAME",
+			"OS_USERNAME",
+			"OS_USER_ID",
+		}
+	case "digitalocean":
+		return []string{
+			"DO_AUTH_TOKEN",
+		}
+	case "directadmin":
+		return []string{
+			"DIRECTADMIN_API_URL",
+			"DIRECTADMIN_PASSWORD",
+			"DIRECTADMIN_USERNAME",
+		}
+	case "dnshomede":
+		return []string{
+			"DNSHOMEDE_CREDENTIALS",
+		}
+	case "dnsimple":
+		return []string{
+			"DNSIMPLE_OAUTH_TOKEN",
+		}
+	case "dnsmadeeasy":
+		return []string{
+			"DNSMADEEASY_API_KEY",
+			"DNSMADEEASY_API_SECRET",
+		}
+	case "dnspod":
+		return []string{
+			"DNSPOD_API_KEY",
+		}
+	case "dode":
+		return []string{
+			"DODE_TOKEN",
+		}
+	case "domeneshop":
+		return []string{
+			"DOMENESHOP_API_SECRET",
+			"DOMENESHOP_API_TOKEN",
+		}
+	case "dreamhost":
+		return []string{
+			"DREAMHOST_API_KEY",
+		}
+	case "duckdns":
+		return []string{
+			"DUCKDNS_TOKEN",
+		}
+	case "dyn":
+		return []string{
+			"DYN_CUSTOMER_NAME",
+			"DYN_PASSWORD",
+			"DYN_USER_NAME",
+		}
+	case "dynu":
+		return []string{
+			"DYNU_API_KEY",
+		}
+	case "easydns":
+		return []string{
+			"EASYDNS_KEY",
+			"EASYDNS_TOKEN",
+		}
+	case "edgedns":
+		return []string{
+			"AKAMAI_ACCESS_TOKEN",
+			"AKAMAI_CLIENT_SECRET",
+			"AKAMAI_CLIENT_TOKEN",
+			"AKAMAI_EDGERC",
+			"AKAMAI_EDGERC_SECTION",
+			"AKAMAI_HOST",
+		}
+	case "efficientip":
+		return []string{
+			"EFFICIENTIP_DNS_NAME",
+			"EFFICIENTIP_HOSTNAME",
+			"EFFICIENTIP_PASSWORD",
+			"EFFICIENTIP_USERNAME",
+		}
+	case "epik":
+		return []string{
+			"EPIK_SIGNATURE",
+		}
+	case "exec":
+		return []string{
+			"EXEC_PATH",
+		}
+	case "exoscale":
+		return []string{
+			"EXOSCALE_API_KEY",
+			"EXOSCALE_API_SECRET",
+		}
+	case "freemyip":
+		return []string{
+			"FREEMYIP_TOKEN",
+		}
+	case "gandi":
+		return []string{
+			"GANDI_API_KEY",
+		}
+	case "gandiv5":
+		return []string{
+			"GANDIV5_API_KEY",
+			"GANDIV5_PERSONAL_ACCESS_TOKEN",
+		}
+	case "gcloud":
+		return []string{
+			"GCE_PROJECT",
+			"GCE_SERVICE_ACCOUNT",
+			"GCE_SERVICE_ACCOUNT_FILE",
+		}
+	case "gcore":
+		return []string{
+			"GCORE_PERMANENT_API_TOKEN",
+		}
+	case "glesys":
+		return []string{
+			"GLESYS_API_KEY",
+			"GLESYS_API_USER",
+		}
+	case "godaddy":
+		return []string{
+			"GODADDY_API_KEY",
+			"GODADDY_API_SECRET",
+		}
+	case "googledomains":
+		return []string{
+			"GOOGLE_DOMAINS_ACCESS_TOKEN",
+		}
+	case "hetzner":
+		return []string{
+			"HETZNER_API_KEY",
+		}
+	case "hostingde":
+		return []string{
+			"HOSTINGDE_API_KEY",
+		}
+	case "hosttech":
+		return []string{
+			"HOSTTECH_API_KEY",
+			"HOSTTECH_PASSWORD",
+		}
+	case "httpnet":
+		return []string{
+			"HTTPNET_API_KEY",
+		}
+	case "httpreq":
+		return []string{
+			"HTTPREQ_ENDPOINT",
+			"HTTPREQ_MODE",
+		}
+	case "huaweicloud":
+		return []string{
+			"HUAWEICLOUD_ACCESS_KEY_ID",
+			"HUAWEICLOUD_REGION",
+			"HUAWEICLOUD_SECRET_ACCESS_KEY",
+		}
+	case "hurricane":
+		return []string{
+			"HURRICANE_TOKENS",
+		}
+	case "ibmcloud":
+		return []string{
+			"SOFTLAYER_API_KEY",
+			"SOFTLAYER_USERNAME",
+		}
+	case "iij":
+		return []string{
+			"IIJ_API_ACCESS_KEY",
+			"IIJ_API_SECRET_KEY",
+			"IIJ_DO_SERVICE_CODE",
+		}
+	case "iijdpf":
+		return []string{
+			"IIJ_DPF_API_TOKEN",
+			"IIJ_DPF_DPM_SERVICE_CODE",
+		}
+	case "infoblox":
+		return []string{
+			"INFOBLOX_HOST",
+			"INFOBLOX_PASSWORD",
+			"INFOBLOX_USERNAME",
+		}
+	case "infomaniak":
+		return []string{
+			"INFOMANIAK_ACCESS_TOKEN",
+		}
+	case "internetbs":
+		return []string{
+			"INTERNET_BS_API_KEY",
+			"INTERNET_BS_PASSWORD",
+		}
+	case "inwx":
+		return []string{
+			"INWX_PASSWORD",
+			"INWX_USERNAME",
+		}
+	case "ionos":
+		return []string{
+			"IONOS_API_KEY",
+		}
+	case "ipv64":
+		return []string{
+			"IPV64_API_KEY",
+		}
+	case "iwantmyname":
+		return []string{
+			"IWANTMYNAME_PASSWORD",
+			"IWANTMYNAME_USERNAME",
+		}
+	case "joker":
+		return []string{
+			"JOKER_API_KEY",
+			"JOKER_API_MODE",
+			"JOKER_PASSWORD",
+			"JOKER_USERNAME",
+		}
+	case "liara":
+		return []string{
+			"LIARA_API_KEY",
+		}
+	case "lightsail":
+		return []string{
+			"AWS_ACCESS_KEY_ID",
+			"AWS_SECRET_ACCESS_KEY",
+			"DNS_ZONE",
+		}
+	case "limacity":
+		return []string{
+			"LIMACITY_API_KEY",
+		}
+	case "linode":
+		return []string{
+			"LINODE_TOKEN",
+		}
+	case "liquidweb":
+		return []string{
+			"LWAPI_PASSWORD",
+			"LWAPI_USERNAME",
+		}
+	case "loopia":
+		return []string{
+			"LOOPIA_API_PASSWORD",
+			"LOOPIA_API_USER",
+		}
+	case "luadns":
+		return []string{
+			"LUADNS_API_TOKEN",
+			"LUADNS_API_USERNAME",
+		}
+	case "mailinabox":
+		return []string{
+			"MAILINABOX_BASE_URL",
+			"MAILINABOX_EMAIL",
+			"MAILINABOX_PASSWORD",
+		}
+	case "manageengine":
+		return []string{
+			"MANAGEENGINE_CLIENT_ID",
+			"MANAGEENGINE_CLIENT_SECRET",
+		}
+	case "metaname":
+		return []string{
+			"METANAME_ACCOUNT_REFERENCE",
+			"METANAME_API_KEY",
+		}
+	case "mijnhost":
+		return []string{
+			"MIJNHOST_API_KEY",
+		}
+	case "mittwald":
+		return []string{
+			"MITTWALD_TOKEN",
+		}
+	case "myaddr":
+		return []string{
+			"MYADDR_PRIVATE_KEYS_MAPPING",
+		}
+	case "mydnsjp":
+		return []string{
+			"MYDNSJP_MASTER_ID",
+			"MYDNSJP_PASSWORD",
+		}
+	case "mythicbeasts":
+		return []string{
+			"MYTHICBEASTS_PASSWORD",
+			"MYTHICBEASTS_USERNAME",
+		}
+	case "namecheap":
+		return []string{
+			"NAMECHEAP_API_KEY",
+			"NAMECHEAP_API_USER",
+		}
+	case "namedotcom":
+		return []string{
+			"NAMECOM_API_TOKEN",
+			"NAMECOM_USERNAME",
+		}
+	case "namesilo":
+		return []string{
+			"NAMESILO_API_KEY",
+		}
+	case "nearlyfreespeech":
+		return []string{
+			"NEARLYFREESPEECH_API_KEY",
+			"NEARLYFREESPEECH_LOGIN",
+		}
+	case "netcup":
+		return []string{
+			"NETCUP_API_KEY",
+			"NETCUP_API_PASSWORD",
+			"NETCUP_CUSTOMER_NUMBER",
+		}
+	case "netlify":
+		return []string{
+			"NETLIFY_TOKEN",
+		}
+	case "nicmanager":
+		return []string{
+			"NICMANAGER_API_EMAIL",
+			"NICMANAGER_API_LOGIN",
+			"NICMANAGER_API_PASSWORD",
+			"NICMANAGER_API_USERNAME",
+		}
+	case "nifcloud":
+		return []string{
+			"NIFCLOUD_ACCESS_KEY_ID",
+			"NIFCLOUD_SECRET_ACCESS_KEY",
+		}
+	case "njalla":
+		return []string{
+			"NJALLA_TOKEN",
+		}
+	case "nodion":
+		return []string{
+			"NODION_API_TOKEN",
+		}
+	case "ns1":
+		return []string{
+			"NS1_API_KEY",
+		}
+	case "oraclecloud":
+		return []string{
+			"OCI_COMPARTMENT_OCID",
+			"OCI_PRIVKEY_FILE",
+			"OCI_PRIVKEY_PASS",
+			"OCI_PUBKEY_FINGERPRINT",
+			"OCI_REGION",
+			"OCI_TENANCY_OCID",
+			"OCI_USER_OCID",
+		}
+	case "otc":
+		return []string{
+			"OTC_DOMAIN_NAME",
+			"OTC_IDENTITY_ENDPOINT",
+			"OTC_PASSWORD",
+			"OTC_PROJECT_NAME",
+			"OTC_USER_NAME",
+		}
+	case "ovh":
+		return []string{
+			"OVH_ACCESS_TOKEN",
+			"OVH_APPLICATION_KEY",
+			"OVH_APPLICATION_SECRET",
+			"OVH_CLIENT_ID",
+			"OVH_CLIENT_SECRET",
+			"OVH_CONSUMER_KEY",
+			"OVH_ENDPOINT",
+		}
+	case "pdns":
+		return []string{
+			"PDNS_API_KEY",
+			"PDNS_API_URL",
+		}
+	case "plesk":
+		return []string{
+			"PLESK_PASSWORD",
+			"PLESK_SERVER_BASE_URL",
+			"PLESK_USERNAME",
+		}
+	case "porkbun":
+		return []string{
+			"PORKBUN_API_KEY",
+			"PORKBUN_SECRET_API_KEY",
+		}
+	case "rackspace":
+		return []string{
+			"RACKSPACE_API_KEY",
+			"RACKSPACE_USER",
+		}
+	case "rainyun":
+		return []string{
+			"RAINYUN_API_KEY",
+		}
+	case "rcodezero":
+		return []string{
+			"RCODEZERO_API_TOKEN",
+		}
+	case "regfish":
+		return []string{
+			"REGFISH_API_KEY",
+		}
+	case "regru":
+		return []string{
+			"REGRU_PASSWORD",
+			"REGRU_USERNAME",
+		}
+	case "rfc2136":
+		return []string{
+			"RFC2136_TSIG_ALGORITHM",
+			"RFC2136_TSIG_KEY",
+			"RFC2136_TSIG_SECRET",
+		}
+	case "rimuhosting":
+		return []string{
+			"RIMUHOSTING_API_KEY",
+		}
+	case "route53":
+		return []string{
+			"AWS_ACCESS_KEY_ID",
+			"AWS_ASSUME_ROLE_ARN",
+			"AWS_EXTERNAL_ID",
+			"AWS_HOSTED_ZONE_ID",
+			"AWS_PROFILE",
+			"AWS_REGION",
+			"AWS_SDK_LOAD_CONFIG",
+			"AWS_SECRET_ACCESS_KEY",
+			"AWS_SESSION_TOKEN",
+			"AWS_WAIT_FOR_RECORD_SETS_CHANGED",
+		}
+	case "safedns":
+		return []string{
+			"SAFEDNS_AUTH_TOKEN",
+		}
+	case "sakuracloud":
+		return []string{
+			"SAKURACLOUD_ACCESS_TOKEN",
+			"SAKURACLOUD_ACCESS_TOKEN_SECRET",
+		}
+	case "scaleway":
+		return []string{
+			"SCW_PROJECT_ID",
+			"SCW_SECRET_KEY",
+		}
+	case "selectel":
+		return []string{
+			"SELECTEL_API_TOKEN",
+		}
+	case "selectelv2":
+		return []string{
+			"SELECTELV2_ACCOUNT_ID",
+			"SELECTELV2_PASSWORD",
+			"SELECTELV2_PROJECT_ID",
+			"SELECTELV2_USERNAME",
+		}
+	case "selfhostde":
+		return []string{
+			"SELFHOSTDE_PASSWORD",
+			"SELFHOSTDE_RECORDS_MAPPING",
+			"SELFHOSTDE_USERNAME",
+		}
+	case "servercow":
+		return []string{
+			"SERVERCOW_PASSWORD",
+			"SERVERCOW_USERNAME",
+		}
+	case "shellrent":
+		return []string{
+			"SHELLRENT_TOKEN",
+			"SHELLRENT_USERNAME",
+		}
+	case "simply":
+		return []string{
+			"SIMPLY_ACCOUNT_NAME",
+			"SIMPLY_API_KEY",
+		}
+	case "sonic":
+		return []string{
+			"SONIC_API_KEY",
+			"SONIC_USER_ID",
+		}
+	case "stackpath":
+		return []string{
+			"STACKPATH_CLIENT_ID",
+			"STACKPATH_CLIENT_SECRET",
+			"STACKPATH_STACK_ID",
+		}
+	case "standalone":
+		return []string{
+			"STANDALONE_ZONE",
+		}
+	case "technitium":
+		return []string{
+			"TECHNITIUM_API_TOKEN",
+			"TECHNITIUM_SERVER_BASE_URL",
+		}
+	case "tencentcloud":
+		return []string{
+			"TENCENTCLOUD_SECRET_ID",
+			"TENCENTCLOUD_SECRET_KEY",
+		}
+	case "timewebcloud":
+		return []string{
+			"TIMEWEBCLOUD_AUTH_TOKEN",
+		}
+	case "transip":
+		return []string{
+			"TRANSIP_ACCOUNT_NAME",
+			"TRANSIP_PRIVATE_KEY_PATH",
+		}
+	case "ultradns":
+		return []string{
+			"ULTRADNS_PASSWORD",
+			"ULTRADNS_USERNAME",
+		}
+	case "variomedia":
+		return []string{
+			"VARIOMEDIA_API_TOKEN",
+		}
+	case "vegadns":
+		return []string{
+			"SECRET_VEGADNS_KEY",
+			"SECRET_VEGADNS_SECRET",
+			"VEGADNS_URL",
+		}
+	case "vercel":
+		return []string{
+			"VERCEL_API_TOKEN",
+		}
+	case "versio":
+		return []string{
+			"VERSIO_PASSWORD",
+			"VERSIO_USERNAME",
+		}
+	case "vinyldns":
+		return []string{
+			"VINYLDNS_ACCESS_KEY",
+			"VINYLDNS_HOST",
+			"VINYLDNS_SECRET_KEY",
+		}
+	case "vkcloud":
+		return []string{
+			"VK_CLOUD_PASSWORD",
+			"VK_CLOUD_PROJECT_ID",
+			"VK_CLOUD_USERNAME",
+		}
+	case "volcengine":
+		return []string{
+			"VOLC_ACCESSKEY",
+			"VOLC_SECRETKEY",
+		}
+	case "vscale":
+		return []string{
+			"VSCALE_API_TOKEN",
+		}
+	case "vultr":
+		return []string{
+			"VULTR_API_KEY",
+		}
+	case "webnames":
+		return []string{
+			"WEBNAMES_API_KEY",
+		}
+	case "websupport":
+		return []string{
+			"WEBSUPPORT_API_KEY",
+			"WEBSUPPORT_SECRET",
+		}
+	case "wedos":
+		return []string{
+			"WEDOS_USERNAME",
+			"WEDOS_WAPI_PASSWORD",
+		}
+	case "westcn":
+		return []string{
+			"WESTCN_PASSWORD",
+			"WESTCN_USERNAME",
+		}
+	case "yandex":
+		return []string{
+			"YANDEX_PDD_TOKEN",
+		}
+	case "yandex360":
+		return []string{
+			"YANDEX360_OAUTH_TOKEN",
+			"YANDEX360_ORG_ID",
+		}
+	case "yandexcloud":
+		return []string{
+			"YANDEX_CLOUD_FOLDER_ID",
+			"YANDEX_CLOUD_IAM_TOKEN",
+		}
+	case "zoneee":
+		return []string{
+			"ZONEEE_API_KEY",
+			"ZONEEE_API_USER",
+		}
+	case "zonomi":
+		return []string{
+			"ZONOMI_API_KEY",
+		}
+	default:
+		return nil
+	}
+}
+
+// dnsCatalog returns the description of all the DNS providers.
+func dnsCatalog() []dnsProviderInfo {
+	return []dnsProviderInfo{
+		{
+			Code:        "acme-dns",
+			Aliases:     []string{"acmedns"},
+			Name:        "Joohoi's ACME-DNS",
+			URL:         "https://github.com/joohoi/acme-dns",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ACME_DNS_API_BASE", Description: "The ACME-DNS API address"},
+				{Name: "ACME_DNS_STORAGE_BASE_URL", Description: "The ACME-DNS JSON account data server."},
+				{Name: "ACME_DNS_STORAGE_PATH", Description: "The ACME-DNS JSON account data file. A per-domain account will be registered/persisted to this file and used for TXT updates."},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "alidns",
+			Name:        "Alibaba Cloud DNS",
+			URL:         "https://www.alibabacloud.com/product/dns",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ALICLOUD_ACCESS_KEY", Description: "Access key ID"},
+				{Name: "ALICLOUD_RAM_ROLE", Description: "Your instance RAM role (https://www.alibabacloud.com/help/doc-detail/54579.htm)"},
+				{Name: "ALICLOUD_SECRET_KEY", Description: "Access Key secret"},
+				{Name: "ALICLOUD_SECURITY_TOKEN", Description: "STS Security Token (optional)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ALICLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "ALICLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "ALICLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "ALICLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "allinkl",
+			Name:        "all-inkl",
+			URL:         "https://all-inkl.com",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ALL_INKL_LOGIN", Description: "KAS login"},
+				{Name: "ALL_INKL_PASSWORD", Description: "KAS password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ALL_INKL_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "ALL_INKL_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "ALL_INKL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "arvancloud",
+			Name:        "ArvanCloud",
+			URL:         "https://arvancloud.ir",
+			Since:       "v3.8.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ARVANCLOUD_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ARVANCLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "ARVANCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "ARVANCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "ARVANCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "auroradns",
+			Name:        "Aurora DNS",
+			URL:         "https://www.pcextreme.com/dns-health-checks",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AURORA_API_KEY", Description: "API key or username to used"},
+				{Name: "AURORA_SECRET", Description: "Secret password to be used"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AURORA_ENDPOINT", Description: "API endpoint URL"},
+				{Name: "AURORA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "AURORA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "AURORA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "autodns",
+			Name:        "Autodns",
+			URL:         "https://www.internetx.com/domains/autodns/",
+			Since:       "v3.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AUTODNS_API_PASSWORD", Description: "User Password"},
+				{Name: "AUTODNS_API_USER", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AUTODNS_CONTEXT", Description: "API context (4 for production, 1 for testing. Defaults to 4)"},
+				{Name: "AUTODNS_ENDPOINT", Description: "API endpoint URL, defaults to https://api.autodns.com/v1/"},
+				{Name: "AUTODNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "AUTODNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "AUTODNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "AUTODNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "azure",
+			Name:        "Azure (deprecated)",
+			URL:         "https://azure.microsoft.com/services/dns/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AZURE_CLIENT_ID", Description: "Client ID"},
+				{Name: "AZURE_CLIENT_SECRET", Description: "Client secret"},
+				{Name: "AZURE_ENVIRONMENT", Description: "Azure environment, one of: public, usgovernment, german, and china"},
+				{Name: "AZURE_RESOURCE_GROUP", Description: "Resource group"},
+				{Name: "AZURE_SUBSCRIPTION_ID", Description: "Subscription ID"},
+				{Name: "AZURE_TENANT_ID", Description: "Tenant ID"},
+				{Name: "instance metadata service", Description: "If the credentials are **not** set via the environment, then it will attempt to get a bearer token via the [instance metadata service](https://docs.microsoft.com/en-us/azure/virtual-machines/windows/instance-metadata-service)."},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AZURE_METADATA_ENDPOINT", Description: "Metadata Service endpoint URL"},
+				{Name: "AZURE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "AZURE_PRIVATE_ZONE", Description: "Set to true to use Azure Private DNS Zones and not public"},
+				{Name: "AZURE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "AZURE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+				{Name: "AZURE_ZONE_NAME", Description: "Zone name to use inside Azure DNS service to add the TXT record in"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "azuredns",
+			Name:        "Azure DNS",
+			URL:         "https://azure.microsoft.com/services/dns/",
+			Since:       "v4.13.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AZURE_CLIENT_CERTIFICATE_PATH", Description: "Client certificate path"},
+				{Name: "AZURE_CLIENT_ID", Description: "Client ID"},
+				{Name: "AZURE_CLIENT_SECRET", Description: "Client secret"},
+				{Name: "AZURE_TENANT_ID", Description: "Tenant ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AZURE_AUTH_METHOD", Description: "Specify which authentication method to use"},
+				{Name: "AZURE_AUTH_MSI_TIMEOUT", Description: "Managed Identity timeout duration"},
+				{Name: "AZURE_ENVIRONMENT", Description: "Azure environment, one of: public, usgovernment, and china"},
+				{Name: "AZURE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "AZURE_PRIVATE_ZONE", Description: "Set to true to use Azure Private DNS Zones and not public"},
+				{Name: "AZURE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "AZURE_RESOURCE_GROUP", Description: "DNS zone resource group"},
+				{Name: "AZURE_SERVICEDISCOVERY_FILTER", Description: "Advanced ServiceDiscovery filter using Kusto query condition"},
+				{Name: "AZURE_SUBSCRIPTION_ID", Description: "DNS zone subscription ID"},
+				{Name: "AZURE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+				{Name: "AZURE_ZONE_NAME", Description: "Zone name to use inside Azure DNS service to add the TXT record in"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "bindman",
+			Name:        "Bindman",
+			URL:         "https://github.com/labbsr0x/bindman-dns-webhook",
+			Since:       "v2.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "BINDMAN_MANAGER_ADDRESS", Description: "The server URL, should have scheme, hostname, and port (if required) of the Bindman-DNS Manager server"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "BINDMAN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "BINDMAN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "BINDMAN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "bluecat",
+			Name:        "Bluecat",
+			URL:         "https://www.bluecatnetworks.com",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "BLUECAT_CONFIG_NAME", Description: "Configuration name"},
+				{Name: "BLUECAT_DNS_VIEW", Description: "External DNS View Name"},
+				{Name: "BLUECAT_PASSWORD", Description: "API password"},
+				{Name: "BLUECAT_SERVER_URL", Description: "The server URL, should have scheme, hostname, and port (if required) of the authoritative Bluecat BAM serve"},
+				{Name: "BLUECAT_USER_NAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "BLUECAT_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "BLUECAT_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "BLUECAT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "BLUECAT_SKIP_DEPLOY", Description: "Skip deployements"},
+				{Name: "BLUECAT_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "brandit",
+			Name:        "Brandit (deprecated)",
+			URL:         "https://www.brandit.com/",
+			Since:       "v4.11.0",
+			Description: "Brandit has been acquired by Abion.\nAbion has a different API.\n\nIf you are a Brandit/Albion user, you can try the PR https://github.com/go-acme/lego/pull/2112.\n",
+			Required: []dnsEnvVarInfo{
+				{Name: "BRANDIT_API_KEY", Description: "The API key"},
+				{Name: "BRANDIT_API_USERNAME", Description: "The API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "BRANDIT_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "BRANDIT_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "BRANDIT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 600)"},
+				{Name: "BRANDIT_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "bunny",
+			Name:        "Bunny",
+			URL:         "https://bunny.net",
+			Since:       "v4.11.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "BUNNY_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "BUNNY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "BUNNY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "BUNNY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "checkdomain",
+			Name:        "Checkdomain",
+			URL:         "https://checkdomain.de/",
+			Since:       "v3.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CHECKDOMAIN_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CHECKDOMAIN_ENDPOINT", Description: "API endpoint URL, defaults to https://api.checkdomain.de"},
+				{Name: "CHECKDOMAIN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CHECKDOMAIN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 300)"},
+				{Name: "CHECKDOMAIN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 7)"},
+				{Name: "CHECKDOMAIN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "civo",
+			Name:        "Civo",
+			URL:         "https://civo.com",
+			Since:       "v4.9.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CIVO_TOKEN", Description: "Authentication token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CIVO_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 30)"},
+				{Name: "CIVO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+				{Name: "CIVO_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "clouddns",
+			Name:        "CloudDNS",
+			URL:         "https://vshosting.eu/",
+			Since:       "v3.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CLOUDDNS_CLIENT_ID", Description: "Client ID"},
+				{Name: "CLOUDDNS_EMAIL", Description: "Account email"},
+				{Name: "CLOUDDNS_PASSWORD", Description: "Account password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CLOUDDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CLOUDDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "CLOUDDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "CLOUDDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "cloudflare",
+			Name:        "Cloudflare",
+			URL:         "https://www.cloudflare.com/dns/",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CF_API_EMAIL", Description: "Account email"},
+				{Name: "CF_API_KEY", Description: "API key"},
+				{Name: "CF_DNS_API_TOKEN", Description: "API token with DNS:Edit permission (since v3.1.0)"},
+				{Name: "CF_ZONE_API_TOKEN", Description: "API token with Zone:Read permission (since v3.1.0)"},
+				{Name: "CLOUDFLARE_API_KEY", Description: "Alias to CF_API_KEY"},
+				{Name: "CLOUDFLARE_DNS_API_TOKEN", Description: "Alias to CF_DNS_API_TOKEN"},
+				{Name: "CLOUDFLARE_EMAIL", Description: "Alias to CF_API_EMAIL"},
+				{Name: "CLOUDFLARE_ZONE_API_TOKEN", Description: "Alias to CF_ZONE_API_TOKEN"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CLOUDFLARE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: )"},
+				{Name: "CLOUDFLARE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "CLOUDFLARE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "CLOUDFLARE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "cloudns",
+			Name:        "ClouDNS",
+			URL:         "https://www.cloudns.net",
+			Since:       "v2.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CLOUDNS_AUTH_ID", Description: "The API user ID"},
+				{Name: "CLOUDNS_AUTH_PASSWORD", Description: "The password for API user ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CLOUDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CLOUDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "CLOUDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 180)"},
+				{Name: "CLOUDNS_SUB_AUTH_ID", Description: "The API sub user ID"},
+				{Name: "CLOUDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "cloudru",
+			Name:        "Cloud.ru",
+			URL:         "https://cloud.ru",
+			Since:       "v4.14.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CLOUDRU_KEY_ID", Description: "Key ID (login)"},
+				{Name: "CLOUDRU_SECRET", Description: "Key Secret"},
+				{Name: "CLOUDRU_SERVICE_INSTANCE_ID", Description: "Service Instance ID (parentId)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CLOUDRU_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CLOUDRU_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "CLOUDRU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+				{Name: "CLOUDRU_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 120)"},
+				{Name: "CLOUDRU_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "cloudxns",
+			Name:        "CloudXNS (Deprecated)",
+			URL:         "https://github.com/go-acme/lego/issues/2323",
+			Since:       "v0.5.0",
+			Description: "The CloudXNS DNS provider has shut down.\n",
+			Required: []dnsEnvVarInfo{
+				{Name: "CLOUDXNS_API_KEY", Description: "The API key"},
+				{Name: "CLOUDXNS_SECRET_KEY", Description: "The API secret key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CLOUDXNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: )"},
+				{Name: "CLOUDXNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: )"},
+				{Name: "CLOUDXNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: )"},
+				{Name: "CLOUDXNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: )"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "conoha",
+			Name:        "ConoHa",
+			URL:         "https://www.conoha.jp/",
+			Since:       "v1.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CONOHA_API_PASSWORD", Description: "The API password"},
+				{Name: "CONOHA_API_USERNAME", Description: "The API username"},
+				{Name: "CONOHA_TENANT_ID", Description: "Tenant ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CONOHA_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CONOHA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "CONOHA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "CONOHA_REGION", Description: "The region (Default: tyo1)"},
+				{Name: "CONOHA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "constellix",
+			Name:        "Constellix",
+			URL:         "https://constellix.com",
+			Since:       "v3.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CONSTELLIX_API_KEY", Description: "User API key"},
+				{Name: "CONSTELLIX_SECRET_KEY", Description: "User secret key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CONSTELLIX_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CONSTELLIX_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "CONSTELLIX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "CONSTELLIX_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "corenetworks",
+			Name:        "Core-Networks",
+			URL:         "https://www.core-networks.de/",
+			Since:       "v4.20.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CORENETWORKS_LOGIN", Description: "The username of the API account"},
+				{Name: "CORENETWORKS_PASSWORD", Description: "The password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CORENETWORKS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CORENETWORKS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "CORENETWORKS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "CORENETWORKS_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "CORENETWORKS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "cpanel",
+			Name:        "CPanel/WHM",
+			URL:         "https://cpanel.net/",
+			Since:       "v4.16.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "CPANEL_BASE_URL", Description: "API server URL"},
+				{Name: "CPANEL_TOKEN", Description: "API token"},
+				{Name: "CPANEL_USERNAME", Description: "username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "CPANEL_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "CPANEL_MODE", Description: "use cpanel API or WHM API (Default: cpanel)"},
+				{Name: "CPANEL_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "CPANEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "CPANEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "derak",
+			Name:        "Derak Cloud",
+			URL:         "https://derak.cloud/",
+			Since:       "v4.12.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DERAK_API_KEY", Description: "The API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DERAK_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DERAK_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "DERAK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "DERAK_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				{Name: "DERAK_WEBSITE_ID", Description: "Force the zone/website ID"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "desec",
+			Name:        "deSEC.io",
+			URL:         "https://desec.io",
+			Since:       "v3.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DESEC_TOKEN", Description: "Domain token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DESEC_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DESEC_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "DESEC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "DESEC_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "designate",
+			Name:        "Designate DNSaaS for Openstack",
+			URL:         "https://docs.openstack.org/designate/latest/",
+			Since:       "v2.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "OS_APPLICATION_CREDENTIAL_ID", Description: "Application credential ID"},
+				{Name: "OS_APPLICATION_CREDENTIAL_NAME", Description: "Application credential name"},
+				{Name: "OS_APPLICATION_CREDENTIAL_SECRET", Description: "Application credential secret"},
+				{Name: "OS_AUTH_URL", Description: "Identity endpoint URL"},
+				{Name: "OS_PASSWORD", Description: "Password"},
+				{Name: "OS_PROJECT_NAME", Description: "Project name"},
+				{Name: "OS_REGION_NAME", Description: "Region name"},
+				{Name: "OS_USERNAME", Description: "Username"},
+				{Name: "OS_USER_ID", Description: "User ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DESIGNATE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "DESIGNATE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 600)"},
+				{Name: "DESIGNATE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 10)"},
+				{Name: "DESIGNATE_ZONE_NAME", Description: "The zone name to use in the OpenStack Project to manage TXT records."},
+				{Name: "OS_PROJECT_ID", Description: "Project ID"},
+				{Name: "OS_TENANT_NAME", Description: "Tenant name (deprecated see OS_PROJECT_NAME and OS_PROJECT_ID)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "digitalocean",
+			Name:        "Digital Ocean",
+			URL:         "https://www.digitalocean.com/docs/networking/dns/",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DO_AUTH_TOKEN", Description: "Authentication token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DO_API_URL", Description: "The URL of the API"},
+				{Name: "DO_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DO_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "DO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DO_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 30)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:    true,
+				MultipleTXT: capability(true),
+			},
+		},
+		{
+			Code:        "directadmin",
+			Name:        "DirectAdmin",
+			URL:         "https://www.directadmin.com",
+			Since:       "v4.18.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DIRECTADMIN_API_URL", Description: "URL of the API"},
+				{Name: "DIRECTADMIN_PASSWORD", Description: "API password"},
+				{Name: "DIRECTADMIN_USERNAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DIRECTADMIN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DIRECTADMIN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "DIRECTADMIN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DIRECTADMIN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 30)"},
+				{Name: "DIRECTADMIN_ZONE_NAME", Description: "Zone name used to add the TXT record"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dnshomede",
+			Name:        "dnsHome.de",
+			URL:         "https://www.dnshome.de",
+			Since:       "v4.10.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DNSHOMEDE_CREDENTIALS", Description: "Comma-separated list of domain:password credential pairs"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DNSHOMEDE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DNSHOMEDE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 1200)"},
+				{Name: "DNSHOMEDE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 2)"},
+				{Name: "DNSHOMEDE_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				MultipleTXT:       capability(false),
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "dnsimple",
+			Name:        "DNSimple",
+			URL:         "https://dnsimple.com/",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DNSIMPLE_OAUTH_TOKEN", Description: "OAuth token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DNSIMPLE_BASE_URL", Description: "API endpoint URL"},
+				{Name: "DNSIMPLE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DNSIMPLE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DNSIMPLE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dnsmadeeasy",
+			Name:        "DNS Made Easy",
+			URL:         "https://dnsmadeeasy.com/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DNSMADEEASY_API_KEY", Description: "The API key"},
+				{Name: "DNSMADEEASY_API_SECRET", Description: "The API Secret key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DNSMADEEASY_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "DNSMADEEASY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DNSMADEEASY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DNSMADEEASY_SANDBOX", Description: "Activate the sandbox (boolean)"},
+				{Name: "DNSMADEEASY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dnspod",
+			Name:        "DNSPod (deprecated)",
+			URL:         "https://www.dnspod.com/",
+			Since:       "v0.4.0",
+			Description: "Use the Tencent Cloud provider instead.\n",
+			Required: []dnsEnvVarInfo{
+				{Name: "DNSPOD_API_KEY", Description: "The user token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DNSPOD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DNSPOD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DNSPOD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DNSPOD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dode",
+			Name:        "Domain Offensive (do.de)",
+			URL:         "https://www.do.de/",
+			Since:       "v2.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DODE_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DODE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DODE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DODE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DODE_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "DODE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				MultipleTXT:       capability(false),
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "domeneshop",
+			Aliases:     []string{"domainnameshop"},
+			Name:        "Domeneshop",
+			URL:         "https://domene.shop",
+			Since:       "v4.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DOMENESHOP_API_SECRET", Description: "API secret"},
+				{Name: "DOMENESHOP_API_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DOMENESHOP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DOMENESHOP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 20)"},
+				{Name: "DOMENESHOP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dreamhost",
+			Name:        "DreamHost",
+			URL:         "https://www.dreamhost.com",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DREAMHOST_API_KEY", Description: "The API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DREAMHOST_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DREAMHOST_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 60)"},
+				{Name: "DREAMHOST_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "duckdns",
+			Name:        "Duck DNS",
+			URL:         "https://www.duckdns.org/",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DUCKDNS_TOKEN", Description: "Account token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DUCKDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DUCKDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DUCKDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DUCKDNS_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "DUCKDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				MultipleTXT:       capability(false),
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "dyn",
+			Name:        "Dyn",
+			URL:         "https://dyn.com/",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DYN_CUSTOMER_NAME", Description: "Customer name"},
+				{Name: "DYN_PASSWORD", Description: "Password"},
+				{Name: "DYN_USER_NAME", Description: "User name"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DYN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "DYN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "DYN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "DYN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "dynu",
+			Name:        "Dynu",
+			URL:         "https://www.dynu.com/",
+			Since:       "v3.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "DYNU_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "DYNU_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "DYNU_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "DYNU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 180)"},
+				{Name: "DYNU_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "easydns",
+			Name:        "EasyDNS",
+			URL:         "https://easydns.com/",
+			Since:       "v2.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "EASYDNS_KEY", Description: "API Key"},
+				{Name: "EASYDNS_TOKEN", Description: "API Token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "EASYDNS_ENDPOINT", Description: "The endpoint URL of the API Server"},
+				{Name: "EASYDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "EASYDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "EASYDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "EASYDNS_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "EASYDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:    true,
+				MultipleTXT: capability(true),
+			},
+		},
+		{
+			Code:        "edgedns",
+			Aliases:     []string{"fastdns"},
+			Name:        "Akamai EdgeDNS",
+			URL:         "https://www.akamai.com/us/en/products/security/edge-dns.jsp",
+			Since:       "v3.9.0",
+			Description: "Akamai edgedns supersedes FastDNS; implementing a DNS provider for solving the DNS-01 challenge using Akamai EdgeDNS\n",
+			Required: []dnsEnvVarInfo{
+				{Name: "AKAMAI_ACCESS_TOKEN", Description: "Access token, managed by the Akamai EdgeGrid client"},
+				{Name: "AKAMAI_CLIENT_SECRET", Description: "Client secret, managed by the Akamai EdgeGrid client"},
+				{Name: "AKAMAI_CLIENT_TOKEN", Description: "Client token, managed by the Akamai EdgeGrid client"},
+				{Name: "AKAMAI_EDGERC", Description: "Path to the .edgerc file, managed by the Akamai EdgeGrid client"},
+				{Name: "AKAMAI_EDGERC_SECTION", Description: "Configuration section, managed by the Akamai EdgeGrid client"},
+				{Name: "AKAMAI_HOST", Description: "API host, managed by the Akamai EdgeGrid client"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AKAMAI_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 15)"},
+				{Name: "AKAMAI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 180)"},
+				{Name: "AKAMAI_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "efficientip",
+			Name:        "Efficient IP",
+			URL:         "https://efficientip.com/",
+			Since:       "v4.13.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "EFFICIENTIP_DNS_NAME", Description: "DNS name (ex: dns.smart)"},
+				{Name: "EFFICIENTIP_HOSTNAME", Description: "Hostname (ex: foo.example.com)"},
+				{Name: "EFFICIENTIP_PASSWORD", Description: "Password"},
+				{Name: "EFFICIENTIP_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "EFFICIENTIP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "EFFICIENTIP_INSECURE_SKIP_VERIFY", Description: "Whether or not to verify EfficientIP API certificate"},
+				{Name: "EFFICIENTIP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "EFFICIENTIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "EFFICIENTIP_VIEW_NAME", Description: "View name (ex: external)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "epik",
+			Name:        "Epik",
+			URL:         "https://www.epik.com/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "EPIK_SIGNATURE", Description: "Epik API signature (https://registrar.epik.com/account/api-settings/)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "EPIK_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "EPIK_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "EPIK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "EPIK_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "exec",
+			Name:        "External program",
+			URL:         "/dns/exec",
+			Since:       "v0.5.0",
+			Description: "Solving the DNS-01 challenge using an external program.",
+			Required: []dnsEnvVarInfo{
+				{Name: "EXEC_PATH", Description: "The path of the the external program."},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "EXEC_MODE", Description: "`RAW`, `JSON`, none"},
+				{Name: "EXEC_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 3)"},
+				{Name: "EXEC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "EXEC_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "EXEC_TIMEOUT", Description: "Time allowed to each call of the program in seconds (Default: 0, no limit)"},
+				{Name: "EXEC_TTL", Description: "The TTL of the TXT record used for the DNS challenge (`JSON` mode only) (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "exoscale",
+			Name:        "Exoscale",
+			URL:         "https://www.exoscale.com/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "EXOSCALE_API_KEY", Description: "API key"},
+				{Name: "EXOSCALE_API_SECRET", Description: "API secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "EXOSCALE_ENDPOINT", Description: "API endpoint URL"},
+				{Name: "EXOSCALE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "EXOSCALE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "EXOSCALE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "EXOSCALE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "freemyip",
+			Name:        "freemyip.com",
+			URL:         "https://freemyip.com/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "FREEMYIP_TOKEN", Description: "Account token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "FREEMYIP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "FREEMYIP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "FREEMYIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "FREEMYIP_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "FREEMYIP_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				MultipleTXT:       capability(false),
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "gandi",
+			Name:        "Gandi",
+			URL:         "https://www.gandi.net",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GANDI_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GANDI_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "GANDI_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 60)"},
+				{Name: "GANDI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 2400)"},
+				{Name: "GANDI_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "gandiv5",
+			Name:        "Gandi Live DNS (v5)",
+			URL:         "https://www.gandi.net",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GANDIV5_API_KEY", Description: "API key (Deprecated)"},
+				{Name: "GANDIV5_PERSONAL_ACCESS_TOKEN", Description: "Personal Access Token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GANDIV5_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "GANDIV5_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 20)"},
+				{Name: "GANDIV5_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 1200)"},
+				{Name: "GANDIV5_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "gcloud",
+			Name:        "Google Cloud",
+			URL:         "https://cloud.google.com",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "Application Default Credentials", Description: "[Documentation](https://cloud.google.com/docs/authentication/production#providing_credentials_to_your_application)"},
+				{Name: "GCE_PROJECT", Description: "Project name (by default, the project name is auto-detected by using the metadata service)"},
+				{Name: "GCE_SERVICE_ACCOUNT", Description: "Account"},
+				{Name: "GCE_SERVICE_ACCOUNT_FILE", Description: "Account file path"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GCE_ALLOW_PRIVATE_ZONE", Description: "Allows requested domain to be in private DNS zone, works only with a private ACME server (by default: false)"},
+				{Name: "GCE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "GCE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 180)"},
+				{Name: "GCE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				{Name: "GCE_ZONE_ID", Description: "Allows to skip the automatic detection of the zone"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "gcore",
+			Name:        "G-Core",
+			URL:         "https://gcore.com/dns/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GCORE_PERMANENT_API_TOKEN", Description: "Permanent API token (https://gcore.com/blog/permanent-api-token-explained/)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GCORE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "GCORE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 20)"},
+				{Name: "GCORE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 360)"},
+				{Name: "GCORE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "glesys",
+			Name:        "Glesys",
+			URL:         "https://glesys.com/",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GLESYS_API_KEY", Description: "API key"},
+				{Name: "GLESYS_API_USER", Description: "API user"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GLESYS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "GLESYS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 20)"},
+				{Name: "GLESYS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 1200)"},
+				{Name: "GLESYS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "godaddy",
+			Name:        "Go Daddy",
+			URL:         "https://godaddy.com",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GODADDY_API_KEY", Description: "API key"},
+				{Name: "GODADDY_API_SECRET", Description: "API secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GODADDY_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "GODADDY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "GODADDY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "GODADDY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "googledomains",
+			Name:        "Google Domains",
+			URL:         "https://domains.google",
+			Since:       "v4.11.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "GOOGLE_DOMAINS_ACCESS_TOKEN", Description: "Access token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "GOOGLE_DOMAINS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "GOOGLE_DOMAINS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "GOOGLE_DOMAINS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "hetzner",
+			Name:        "Hetzner",
+			URL:         "https://hetzner.com",
+			Since:       "v3.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HETZNER_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HETZNER_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HETZNER_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HETZNER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "HETZNER_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "hostingde",
+			Name:        "Hosting.de",
+			URL:         "https://www.hosting.de/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HOSTINGDE_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HOSTINGDE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HOSTINGDE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HOSTINGDE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "HOSTINGDE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				{Name: "HOSTINGDE_ZONE_NAME", Description: "Zone name in ACE format"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "hosttech",
+			Name:        "Hosttech",
+			URL:         "https://www.hosttech.eu/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HOSTTECH_API_KEY", Description: "API login"},
+				{Name: "HOSTTECH_PASSWORD", Description: "API password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HOSTTECH_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HOSTTECH_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HOSTTECH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "HOSTTECH_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "httpnet",
+			Name:        "http.net",
+			URL:         "https://www.http.net/",
+			Since:       "v4.15.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HTTPNET_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HTTPNET_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HTTPNET_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HTTPNET_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "HTTPNET_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				{Name: "HTTPNET_ZONE_NAME", Description: "Zone name in ACE format"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "httpreq",
+			Name:        "HTTP request",
+			URL:         "/lego/dns/httpreq/",
+			Since:       "v2.0.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HTTPREQ_ENDPOINT", Description: "The URL of the server"},
+				{Name: "HTTPREQ_MODE", Description: "`RAW`, none"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HTTPREQ_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HTTPREQ_PASSWORD", Description: "Basic authentication password"},
+				{Name: "HTTPREQ_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HTTPREQ_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "HTTPREQ_USERNAME", Description: "Basic authentication username"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "huaweicloud",
+			Name:        "Huawei Cloud",
+			URL:         "https://huaweicloud.com",
+			Since:       "v4.19",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HUAWEICLOUD_ACCESS_KEY_ID", Description: "Access key ID"},
+				{Name: "HUAWEICLOUD_REGION", Description: "Region"},
+				{Name: "HUAWEICLOUD_SECRET_ACCESS_KEY", Description: "Access Key secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HUAWEICLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HUAWEICLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HUAWEICLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "HUAWEICLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "hurricane",
+			Name:        "Hurricane Electric DNS",
+			URL:         "https://dns.he.net/",
+			Since:       "v4.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "HURRICANE_TOKENS", Description: "TXT record names and tokens"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "HURRICANE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HURRICANE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "HURRICANE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation (Default: 300)"},
+				{Name: "HURRICANE_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				MultipleTXT:       capability(false),
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "hyperone",
+			Name:        "HyperOne",
+			URL:         "https://www.hyperone.com",
+			Since:       "v3.9.0",
+			Description: "",
+			Optional: []dnsEnvVarInfo{
+				{Name: "HYPERONE_API_URL", Description: "Allows to pass custom API Endpoint to be used in the challenge (default https://api.hyperone.com/v2)"},
+				{Name: "HYPERONE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "HYPERONE_LOCATION_ID", Description: "Specifies location (region) to be used in API calls. (default pl-waw-1)"},
+				{Name: "HYPERONE_PASSPORT_LOCATION", Description: "Allows to pass custom passport file location (default ~/.h1/passport.json)"},
+				{Name: "HYPERONE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 60)"},
+				{Name: "HYPERONE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 2)"},
+				{Name: "HYPERONE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ibmcloud",
+			Name:        "IBM Cloud (SoftLayer)",
+			URL:         "https://www.ibm.com/cloud/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SOFTLAYER_API_KEY", Description: "Classic Infrastructure API key"},
+				{Name: "SOFTLAYER_USERNAME", Description: "Username (IBM Cloud is <accountID>_<emailAddress>)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SOFTLAYER_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SOFTLAYER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "SOFTLAYER_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SOFTLAYER_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "iij",
+			Name:        "Internet Initiative Japan",
+			URL:         "https://www.iij.ad.jp/en/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "IIJ_API_ACCESS_KEY", Description: "API access key"},
+				{Name: "IIJ_API_SECRET_KEY", Description: "API secret key"},
+				{Name: "IIJ_DO_SERVICE_CODE", Description: "DO service code"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "IIJ_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "IIJ_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 240)"},
+				{Name: "IIJ_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "iijdpf",
+			Name:        "IIJ DNS Platform Service",
+			URL:         "https://www.iij.ad.jp/en/biz/dns-pfm/",
+			Since:       "v4.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "IIJ_DPF_API_TOKEN", Description: "API token"},
+				{Name: "IIJ_DPF_DPM_SERVICE_CODE", Description: "IIJ Managed DNS Service's service code"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "IIJ_DPF_API_ENDPOINT", Description: "API endpoint URL, defaults to https://api.dns-platform.jp/dpf/v1"},
+				{Name: "IIJ_DPF_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "IIJ_DPF_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 660)"},
+				{Name: "IIJ_DPF_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "infoblox",
+			Name:        "Infoblox",
+			URL:         "https://www.infoblox.com/",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "INFOBLOX_HOST", Description: "Host URI"},
+				{Name: "INFOBLOX_PASSWORD", Description: "Account Password"},
+				{Name: "INFOBLOX_USERNAME", Description: "Account Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "INFOBLOX_DNS_VIEW", Description: "The view for the TXT records (Default: External)"},
+				{Name: "INFOBLOX_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "INFOBLOX_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "INFOBLOX_PORT", Description: "The port for the infoblox grid manager  (Default: 443)"},
+				{Name: "INFOBLOX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "INFOBLOX_SSL_VERIFY", Description: "Whether or not to verify the TLS certificate  (Default: true)"},
+				{Name: "INFOBLOX_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+				{Name: "INFOBLOX_WAPI_VERSION", Description: "The version of WAPI being used  (Default: 2.11)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "infomaniak",
+			Name:        "Infomaniak",
+			URL:         "https://www.infomaniak.com/",
+			Since:       "v4.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "INFOMANIAK_ACCESS_TOKEN", Description: "Access token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "INFOMANIAK_ENDPOINT", Description: "https://api.infomaniak.com"},
+				{Name: "INFOMANIAK_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "INFOMANIAK_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "INFOMANIAK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "INFOMANIAK_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "internetbs",
+			Name:        "Internet.bs",
+			URL:         "https://internetbs.net",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "INTERNET_BS_API_KEY", Description: "API key"},
+				{Name: "INTERNET_BS_PASSWORD", Description: "API password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "INTERNET_BS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "INTERNET_BS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "INTERNET_BS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "INTERNET_BS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "inwx",
+			Name:        "INWX",
+			URL:         "https://www.inwx.de/en",
+			Since:       "v2.0.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "INWX_PASSWORD", Description: "Password"},
+				{Name: "INWX_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "INWX_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "INWX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 360)"},
+				{Name: "INWX_SANDBOX", Description: "Activate the sandbox (boolean)"},
+				{Name: "INWX_SHARED_SECRET", Description: "shared secret related to 2FA"},
+				{Name: "INWX_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ionos",
+			Name:        "Ionos",
+			URL:         "https://ionos.com",
+			Since:       "v4.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "IONOS_API_KEY", Description: "API key `<prefix>.<secret>` https://developer.hosting.ionos.com/docs/getstarted"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "IONOS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "IONOS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "IONOS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "IONOS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ipv64",
+			Name:        "IPv64",
+			URL:         "https://ipv64.net/",
+			Since:       "v4.13.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "IPV64_API_KEY", Description: "Account API Key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "IPV64_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "IPV64_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "IPV64_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "iwantmyname",
+			Name:        "iwantmyname",
+			URL:         "https://iwantmyname.com",
+			Since:       "v4.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "IWANTMYNAME_PASSWORD", Description: "API password"},
+				{Name: "IWANTMYNAME_USERNAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "IWANTMYNAME_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "IWANTMYNAME_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "IWANTMYNAME_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "IWANTMYNAME_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "joker",
+			Name:        "Joker",
+			URL:         "https://joker.com",
+			Since:       "v2.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "JOKER_API_KEY", Description: "API key (only with DMAPI mode)"},
+				{Name: "JOKER_API_MODE", Description: "'DMAPI' or 'SVC'. DMAPI is for resellers accounts. (Default: DMAPI)"},
+				{Name: "JOKER_PASSWORD", Description: "Joker.com password"},
+				{Name: "JOKER_USERNAME", Description: "Joker.com username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "JOKER_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "JOKER_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "JOKER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "JOKER_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60), only with 'SVC' mode"},
+				{Name: "JOKER_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "liara",
+			Name:        "Liara",
+			URL:         "https://liara.ir",
+			Since:       "v4.10.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LIARA_API_KEY", Description: "The API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LIARA_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "LIARA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "LIARA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "LIARA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "lightsail",
+			Name:        "Amazon Lightsail",
+			URL:         "https://aws.amazon.com/lightsail/",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AWS_ACCESS_KEY_ID", Description: "Managed by the AWS client. Access key ID (`AWS_ACCESS_KEY_ID_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)"},
+				{Name: "AWS_SECRET_ACCESS_KEY", Description: "Managed by the AWS client. Secret access key (`AWS_SECRET_ACCESS_KEY_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)"},
+				{Name: "DNS_ZONE", Description: "Domain name of the DNS zone"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AWS_SHARED_CREDENTIALS_FILE", Description: "Managed by the AWS client. Shared credentials file."},
+				{Name: "LIGHTSAIL_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "LIGHTSAIL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "limacity",
+			Name:        "Lima-City",
+			URL:         "https://www.lima-city.de",
+			Since:       "v4.18.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LIMACITY_API_KEY", Description: "The API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LIMACITY_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "LIMACITY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 80)"},
+				{Name: "LIMACITY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 480)"},
+				{Name: "LIMACITY_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 90)"},
+				{Name: "LIMACITY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "linode",
+			Aliases:     []string{"linodev4"},
+			Name:        "Linode (v4)",
+			URL:         "https://www.linode.com/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LINODE_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LINODE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "LINODE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 15)"},
+				{Name: "LINODE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "LINODE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "liquidweb",
+			Name:        "Liquid Web",
+			URL:         "https://liquidweb.com",
+			Since:       "v3.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LWAPI_PASSWORD", Description: "Liquid Web API Password"},
+				{Name: "LWAPI_USERNAME", Description: "Liquid Web API Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LWAPI_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "LWAPI_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "LWAPI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "LWAPI_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+				{Name: "LWAPI_URL", Description: "Liquid Web API endpoint"},
+				{Name: "LWAPI_ZONE", Description: "DNS Zone"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "loopia",
+			Name:        "Loopia",
+			URL:         "https://loopia.com",
+			Since:       "v4.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LOOPIA_API_PASSWORD", Description: "API password"},
+				{Name: "LOOPIA_API_USER", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LOOPIA_API_URL", Description: "API endpoint. Ex: https://api.loopia.se/RPCSERV or https://api.loopia.rs/RPCSERV"},
+				{Name: "LOOPIA_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "LOOPIA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2400)"},
+				{Name: "LOOPIA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "LOOPIA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "luadns",
+			Name:        "LuaDNS",
+			URL:         "https://luadns.com",
+			Since:       "v3.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "LUADNS_API_TOKEN", Description: "API token"},
+				{Name: "LUADNS_API_USERNAME", Description: "Username (your email)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "LUADNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "LUADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "LUADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "LUADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "mailinabox",
+			Name:        "Mail-in-a-Box",
+			URL:         "https://mailinabox.email",
+			Since:       "v4.16.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MAILINABOX_BASE_URL", Description: "Base API URL (ex: https://box.example.com)"},
+				{Name: "MAILINABOX_EMAIL", Description: "User email"},
+				{Name: "MAILINABOX_PASSWORD", Description: "User password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MAILINABOX_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "MAILINABOX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "manageengine",
+			Name:        "ManageEngine CloudDNS",
+			URL:         "https://clouddns.manageengine.com",
+			Since:       "v4.21.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MANAGEENGINE_CLIENT_ID", Description: "Client ID"},
+				{Name: "MANAGEENGINE_CLIENT_SECRET", Description: "Client Secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MANAGEENGINE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "MANAGEENGINE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "MANAGEENGINE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "metaname",
+			Name:        "Metaname",
+			URL:         "https://metaname.net",
+			Since:       "v4.13.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "METANAME_ACCOUNT_REFERENCE", Description: "The four-digit reference of a Metaname account"},
+				{Name: "METANAME_API_KEY", Description: "API Key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "METANAME_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "METANAME_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "METANAME_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "mijnhost",
+			Name:        "mijn.host",
+			URL:         "https://mijn.host/",
+			Since:       "v4.18.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MIJNHOST_API_KEY", Description: "The API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MIJNHOST_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "MIJNHOST_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "MIJNHOST_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "MIJNHOST_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "MIJNHOST_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "mittwald",
+			Name:        "Mittwald",
+			URL:         "https://www.mittwald.de/",
+			Since:       "v1.48.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MITTWALD_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MITTWALD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "MITTWALD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "MITTWALD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "MITTWALD_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 120)"},
+				{Name: "MITTWALD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "myaddr",
+			Name:        "myaddr.{tools,dev,io}",
+			URL:         "https://myaddr.tools/",
+			Since:       "v4.22.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MYADDR_PRIVATE_KEYS_MAPPING", Description: "Mapping between subdomains and private keys. The format is: `<subdomain1>:<private_key1>,<subdomain2>:<private_key2>,<subdomain3>:<private_key3>`"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MYADDR_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "MYADDR_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "MYADDR_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "MYADDR_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 2)"},
+				{Name: "MYADDR_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "mydnsjp",
+			Name:        "MyDNS.jp",
+			URL:         "https://www.mydns.jp",
+			Since:       "v1.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MYDNSJP_MASTER_ID", Description: "Master ID"},
+				{Name: "MYDNSJP_PASSWORD", Description: "Password"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MYDNSJP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "MYDNSJP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "MYDNSJP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "mythicbeasts",
+			Name:        "MythicBeasts",
+			URL:         "https://www.mythic-beasts.com/",
+			Since:       "v0.3.7",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "MYTHICBEASTS_PASSWORD", Description: "Password"},
+				{Name: "MYTHICBEASTS_USERNAME", Description: "User name"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "MYTHICBEASTS_API_ENDPOINT", Description: "The endpoint for the API (must implement v2)"},
+				{Name: "MYTHICBEASTS_AUTH_API_ENDPOINT", Description: "The endpoint for Mythic Beasts' Authentication"},
+				{Name: "MYTHICBEASTS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "MYTHICBEASTS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "MYTHICBEASTS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "MYTHICBEASTS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "namecheap",
+			Name:        "Namecheap",
+			URL:         "https://www.namecheap.com",
+			Since:       "v0.3.0",
+			Description: "\nConfiguration for [Namecheap](https://www.namecheap.com).\n\n**To enable API access on the Namecheap production environment, some opaque requirements must be met.**\nMore information in the section [Enabling API Access](https://www.namecheap.com/support/api/intro/) of the Namecheap documentation.\n(2020-08: Account balance of $50+, 20+ domains in your account, or purchases totaling $50+ within the last 2 years.)\n",
+			Required: []dnsEnvVarInfo{
+				{Name: "NAMECHEAP_API_KEY", Description: "API key"},
+				{Name: "NAMECHEAP_API_USER", Description: "API user"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NAMECHEAP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "NAMECHEAP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 15)"},
+				{Name: "NAMECHEAP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 3600)"},
+				{Name: "NAMECHEAP_SANDBOX", Description: "Activate the sandbox (boolean)"},
+				{Name: "NAMECHEAP_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "namedotcom",
+			Name:        "Name.com",
+			URL:         "https://www.name.com",
+			Since:       "v0.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NAMECOM_API_TOKEN", Description: "API token"},
+				{Name: "NAMECOM_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NAMECOM_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "NAMECOM_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 20)"},
+				{Name: "NAMECOM_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 900)"},
+				{Name: "NAMECOM_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "namesilo",
+			Name:        "Namesilo",
+			URL:         "https://www.namesilo.com/",
+			Since:       "v2.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NAMESILO_API_KEY", Description: "Client ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NAMESILO_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NAMESILO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60), it is better to set larger than 15 minutes"},
+				{Name: "NAMESILO_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600), should be in [3600, 2592000]"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "nearlyfreespeech",
+			Name:        "NearlyFreeSpeech.NET",
+			URL:         "https://nearlyfreespeech.net/",
+			Since:       "v4.8.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NEARLYFREESPEECH_API_KEY", Description: "API Key for API requests"},
+				{Name: "NEARLYFREESPEECH_LOGIN", Description: "Username for API requests"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NEARLYFREESPEECH_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "NEARLYFREESPEECH_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NEARLYFREESPEECH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "NEARLYFREESPEECH_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "NEARLYFREESPEECH_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "netcup",
+			Name:        "Netcup",
+			URL:         "https://www.netcup.eu/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NETCUP_API_KEY", Description: "API key"},
+				{Name: "NETCUP_API_PASSWORD", Description: "API password"},
+				{Name: "NETCUP_CUSTOMER_NUMBER", Description: "Customer number"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NETCUP_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "NETCUP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 30)"},
+				{Name: "NETCUP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 900)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "netlify",
+			Name:        "Netlify",
+			URL:         "https://www.netlify.com",
+			Since:       "v3.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NETLIFY_TOKEN", Description: "Token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NETLIFY_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "NETLIFY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NETLIFY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "NETLIFY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "nicmanager",
+			Name:        "Nicmanager",
+			URL:         "https://www.nicmanager.com/",
+			Since:       "v4.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NICMANAGER_API_EMAIL", Description: "Email-based login"},
+				{Name: "NICMANAGER_API_LOGIN", Description: "Login, used for Username-based login"},
+				{Name: "NICMANAGER_API_PASSWORD", Description: "Password, always required"},
+				{Name: "NICMANAGER_API_USERNAME", Description: "Username, used for Username-based login"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NICMANAGER_API_MODE", Description: "mode: 'anycast' or 'zone' (default: 'anycast')"},
+				{Name: "NICMANAGER_API_OTP", Description: "TOTP Secret (optional)"},
+				{Name: "NICMANAGER_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "NICMANAGER_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NICMANAGER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+				{Name: "NICMANAGER_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 900)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "nifcloud",
+			Name:        "NIFCloud",
+			URL:         "https://www.nifcloud.com/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NIFCLOUD_ACCESS_KEY_ID", Description: "Access key"},
+				{Name: "NIFCLOUD_SECRET_ACCESS_KEY", Description: "Secret access key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NIFCLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "NIFCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NIFCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "NIFCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "njalla",
+			Name:        "Njalla",
+			URL:         "https://njal.la",
+			Since:       "v4.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NJALLA_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NJALLA_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "NJALLA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NJALLA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "NJALLA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "nodion",
+			Name:        "Nodion",
+			URL:         "https://www.nodion.com",
+			Since:       "v4.11.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NODION_API_TOKEN", Description: "The API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NODION_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "NODION_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NODION_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "NODION_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ns1",
+			Name:        "NS1",
+			URL:         "https://ns1.com",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "NS1_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "NS1_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "NS1_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "NS1_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "NS1_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "oraclecloud",
+			Name:        "Oracle Cloud",
+			URL:         "https://cloud.oracle.com/home",
+			Since:       "v2.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "OCI_COMPARTMENT_OCID", Description: "Compartment OCID"},
+				{Name: "OCI_PRIVKEY_FILE", Description: "Private key file"},
+				{Name: "OCI_PRIVKEY_PASS", Description: "Private key password"},
+				{Name: "OCI_PUBKEY_FINGERPRINT", Description: "Public key fingerprint"},
+				{Name: "OCI_REGION", Description: "Region"},
+				{Name: "OCI_TENANCY_OCID", Description: "Tenancy OCID"},
+				{Name: "OCI_USER_OCID", Description: "User OCID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "OCI_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 60)"},
+				{Name: "OCI_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "OCI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "OCI_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "otc",
+			Name:        "Open Telekom Cloud",
+			URL:         "https://cloud.telekom.de/en",
+			Since:       "v0.4.1",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "OTC_DOMAIN_NAME", Description: "Domain name"},
+				{Name: "OTC_IDENTITY_ENDPOINT", Description: "Identity endpoint URL"},
+				{Name: "OTC_PASSWORD", Description: "Password"},
+				{Name: "OTC_PROJECT_NAME", Description: "Project name"},
+				{Name: "OTC_USER_NAME", Description: "User name"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "OTC_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "OTC_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "OTC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "OTC_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "OTC_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ovh",
+			Name:        "OVH",
+			URL:         "https://www.ovh.com/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "OVH_ACCESS_TOKEN", Description: "Access token"},
+				{Name: "OVH_APPLICATION_KEY", Description: "Application key (Application Key authentication)"},
+				{Name: "OVH_APPLICATION_SECRET", Description: "Application secret (Application Key authentication)"},
+				{Name: "OVH_CLIENT_ID", Description: "Client ID (OAuth2)"},
+				{Name: "OVH_CLIENT_SECRET", Description: "Client secret (OAuth2)"},
+				{Name: "OVH_CONSUMER_KEY", Description: "Consumer key (Application Key authentication)"},
+				{Name: "OVH_ENDPOINT", Description: "Endpoint URL (ovh-eu or ovh-ca)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "OVH_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 180)"},
+				{Name: "OVH_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "OVH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "OVH_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "pdns",
+			Name:        "PowerDNS",
+			URL:         "https://www.powerdns.com/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "PDNS_API_KEY", Description: "API key"},
+				{Name: "PDNS_API_URL", Description: "API URL"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "PDNS_API_VERSION", Description: "Skip API version autodetection and use the provided version number."},
+				{Name: "PDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "PDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "PDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "PDNS_SERVER_NAME", Description: "Name of the server in the URL, 'localhost' by default"},
+				{Name: "PDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:    true,
+				MultipleTXT: capability(true),
+			},
+		},
+		{
+			Code:        "plesk",
+			Name:        "plesk.com",
+			URL:         "https://www.plesk.com/",
+			Since:       "v4.11.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "PLESK_PASSWORD", Description: "API password"},
+				{Name: "PLESK_SERVER_BASE_URL", Description: "Base URL of the server (ex: https://plesk.myserver.com:8443)"},
+				{Name: "PLESK_USERNAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "PLESK_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "PLESK_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "PLESK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "PLESK_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "porkbun",
+			Name:        "Porkbun",
+			URL:         "https://porkbun.com/",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "PORKBUN_API_KEY", Description: "API key"},
+				{Name: "PORKBUN_SECRET_API_KEY", Description: "secret API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "PORKBUN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "PORKBUN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "PORKBUN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 600)"},
+				{Name: "PORKBUN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "rackspace",
+			Name:        "Rackspace",
+			URL:         "https://www.rackspace.com/",
+			Since:       "v0.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "RACKSPACE_API_KEY", Description: "API key"},
+				{Name: "RACKSPACE_USER", Description: "API user"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "RACKSPACE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "RACKSPACE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 3)"},
+				{Name: "RACKSPACE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "RACKSPACE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "rainyun",
+			Name:        "Rain Yun/雨云",
+			URL:         "https://www.rainyun.com",
+			Since:       "v4.21.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "RAINYUN_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "RAINYUN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "RAINYUN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "RAINYUN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "RAINYUN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "rcodezero",
+			Name:        "RcodeZero",
+			URL:         "https://www.rcodezero.at/",
+			Since:       "v4.13",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "RCODEZERO_API_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "RCODEZERO_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "RCODEZERO_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "RCODEZERO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 240)"},
+				{Name: "RCODEZERO_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "regfish",
+			Name:        "Regfish",
+			URL:         "https://regfish.de/",
+			Since:       "v4.20.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "REGFISH_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "REGFISH_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "REGFISH_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "REGFISH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "REGFISH_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "regru",
+			Name:        "reg.ru",
+			URL:         "https://www.reg.ru/",
+			Since:       "v3.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "REGRU_PASSWORD", Description: "API password"},
+				{Name: "REGRU_USERNAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "REGRU_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "REGRU_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "REGRU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "REGRU_TLS_CERT", Description: "authentication certificate"},
+				{Name: "REGRU_TLS_KEY", Description: "authentication private key"},
+				{Name: "REGRU_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "rfc2136",
+			Name:        "RFC2136",
+			URL:         "https://www.rfc-editor.org/rfc/rfc2136.html",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "RFC2136_TSIG_ALGORITHM", Description: "TSIG algorithm (Default: `hmac-sha1.`). See [miekg/dns#tsig.go](https://github.com/miekg/dns/blob/master/tsig.go) for supported values. To disable TSIG authentication, leave the `RFC2136_TSIG_KEY` or `RFC2136_TSIG_SECRET` variables unset."},
+				{Name: "RFC2136_TSIG_KEY", Description: "Name of the secret key as defined in DNS server configuration. To disable TSIG authentication, leave the `RFC2136_TSIG_KEY` variable unset."},
+				{Name: "RFC2136_TSIG_SECRET", Description: "Secret key payload. To disable TSIG authentication, leave the `RFC2136_TSIG_SECRET` variable unset."},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "RFC2136_DNS_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "RFC2136_NAMESERVER", Description: "Network address in the form \"host\" or \"host:port\". If unset, the updates are sent to the primary nameserver of the zone (the MNAME of the SOA record)."},
+				{Name: "RFC2136_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "RFC2136_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "RFC2136_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "RFC2136_SIG0_FILE", Description: "Path to the `K*.private` file of a SIG(0) key generated by `dnssec-keygen -T KEY` (the `.key` file must be in the same directory). The key name and the algorithm are read from the key files. Cannot be used with TSIG."},
+				{Name: "RFC2136_TSIG_FILE", Description: "Path to a key file generated by tsig-keygen"},
+				{Name: "RFC2136_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "rimuhosting",
+			Name:        "RimuHosting",
+			URL:         "https://rimuhosting.com",
+			Since:       "v0.3.5",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "RIMUHOSTING_API_KEY", Description: "User API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "RIMUHOSTING_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "RIMUHOSTING_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "RIMUHOSTING_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "RIMUHOSTING_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "route53",
+			Name:        "Amazon Route 53",
+			URL:         "https://aws.amazon.com/route53/",
+			Since:       "v0.3.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "AWS_ACCESS_KEY_ID", Description: "Managed by the AWS client. Access key ID (`AWS_ACCESS_KEY_ID_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)"},
+				{Name: "AWS_ASSUME_ROLE_ARN", Description: "Managed by the AWS Role ARN (`AWS_ASSUME_ROLE_ARN_FILE` is not supported)"},
+				{Name: "AWS_EXTERNAL_ID", Description: "Managed by STS AssumeRole API operation (`AWS_EXTERNAL_ID_FILE` is not supported)"},
+				{Name: "AWS_HOSTED_ZONE_ID", Description: "Override the hosted zone ID."},
+				{Name: "AWS_PROFILE", Description: "Managed by the AWS client (`AWS_PROFILE_FILE` is not supported)"},
+				{Name: "AWS_REGION", Description: "Managed by the AWS client (`AWS_REGION_FILE` is not supported)"},
+				{Name: "AWS_SDK_LOAD_CONFIG", Description: "Managed by the AWS client. Retrieve the region from the CLI config file (`AWS_SDK_LOAD_CONFIG_FILE` is not supported)"},
+				{Name: "AWS_SECRET_ACCESS_KEY", Description: "Managed by the AWS client. Secret access key (`AWS_SECRET_ACCESS_KEY_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)"},
+				{Name: "AWS_SESSION_TOKEN", Description: "Managed by the AWS client. Session token of temporary credentials (`AWS_SESSION_TOKEN_FILE` is not supported)"},
+				{Name: "AWS_WAIT_FOR_RECORD_SETS_CHANGED", Description: "Wait for changes to be INSYNC (it can be unstable)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "AWS_MAX_RETRIES", Description: "The number of maximum returns the service will use to make an individual API request"},
+				{Name: "AWS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "AWS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "AWS_SHARED_CREDENTIALS_FILE", Description: "Managed by the AWS client. Shared credentials file."},
+				{Name: "AWS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 10)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "safedns",
+			Name:        "UKFast SafeDNS",
+			URL:         "https://www.ukfast.co.uk/dns-hosting.html",
+			Since:       "v4.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SAFEDNS_AUTH_TOKEN", Description: "Authentication token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SAFEDNS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SAFEDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SAFEDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "SAFEDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "sakuracloud",
+			Name:        "Sakura Cloud",
+			URL:         "https://cloud.sakura.ad.jp/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SAKURACLOUD_ACCESS_TOKEN", Description: "Access token"},
+				{Name: "SAKURACLOUD_ACCESS_TOKEN_SECRET", Description: "Access token secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SAKURACLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "SAKURACLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SAKURACLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "SAKURACLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "scaleway",
+			Name:        "Scaleway",
+			URL:         "https://developers.scaleway.com/",
+			Since:       "v3.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SCW_PROJECT_ID", Description: "Project to use (optional)"},
+				{Name: "SCW_SECRET_KEY", Description: "Secret key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SCW_ACCESS_KEY", Description: "Access key"},
+				{Name: "SCW_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "SCW_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "SCW_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "selectel",
+			Name:        "Selectel",
+			URL:         "https://kb.selectel.com/",
+			Since:       "v1.2.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SELECTEL_API_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SELECTEL_BASE_URL", Description: "API endpoint URL"},
+				{Name: "SELECTEL_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SELECTEL_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SELECTEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "SELECTEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "selectelv2",
+			Name:        "Selectel v2",
+			URL:         "https://selectel.ru",
+			Since:       "v4.17.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SELECTELV2_ACCOUNT_ID", Description: "Selectel account ID (INT)"},
+				{Name: "SELECTELV2_PASSWORD", Description: "Openstack username's password"},
+				{Name: "SELECTELV2_PROJECT_ID", Description: "Cloud project ID (UUID)"},
+				{Name: "SELECTELV2_USERNAME", Description: "Openstack username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SELECTELV2_BASE_URL", Description: "API endpoint URL"},
+				{Name: "SELECTELV2_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SELECTELV2_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "SELECTELV2_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "SELECTELV2_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "selfhostde",
+			Name:        "SelfHost.(de|eu)",
+			URL:         "https://www.selfhost.de",
+			Since:       "v4.19.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SELFHOSTDE_PASSWORD", Description: "Password"},
+				{Name: "SELFHOSTDE_RECORDS_MAPPING", Description: "Record IDs mapping with domains (ex: example.com:123:456,example.org:789,foo.example.com:147)"},
+				{Name: "SELFHOSTDE_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SELFHOSTDE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SELFHOSTDE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 30)"},
+				{Name: "SELFHOSTDE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 240)"},
+				{Name: "SELFHOSTDE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "servercow",
+			Name:        "Servercow",
+			URL:         "https://servercow.de/",
+			Since:       "v3.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SERVERCOW_PASSWORD", Description: "API password"},
+				{Name: "SERVERCOW_USERNAME", Description: "API username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SERVERCOW_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SERVERCOW_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SERVERCOW_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "SERVERCOW_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "shellrent",
+			Name:        "Shellrent",
+			URL:         "https://www.shellrent.com/",
+			Since:       "v4.16.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SHELLRENT_TOKEN", Description: "Token"},
+				{Name: "SHELLRENT_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SHELLRENT_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SHELLRENT_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "SHELLRENT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+				{Name: "SHELLRENT_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "simply",
+			Name:        "Simply.com",
+			URL:         "https://www.simply.com/en/domains/",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SIMPLY_ACCOUNT_NAME", Description: "Account name"},
+				{Name: "SIMPLY_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SIMPLY_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "SIMPLY_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "SIMPLY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+				{Name: "SIMPLY_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "sonic",
+			Name:        "Sonic",
+			URL:         "https://www.sonic.com/",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SONIC_API_KEY", Description: "API Key"},
+				{Name: "SONIC_USER_ID", Description: "User ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "SONIC_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "SONIC_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "SONIC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "SONIC_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "SONIC_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "stackpath",
+			Name:        "Stackpath",
+			URL:         "https://www.stackpath.com/",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "STACKPATH_CLIENT_ID", Description: "Client ID"},
+				{Name: "STACKPATH_CLIENT_SECRET", Description: "Client secret"},
+				{Name: "STACKPATH_STACK_ID", Description: "Stack ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "STACKPATH_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "STACKPATH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "STACKPATH_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "standalone",
+			Name:        "Standalone (embedded DNS server)",
+			URL:         "/dns/standalone",
+			Since:       "v4.23.0",
+			Description: "An embedded authoritative DNS server for a delegated zone.",
+			Required: []dnsEnvVarInfo{
+				{Name: "STANDALONE_ZONE", Description: "The zone delegated to the DNS server (e.g. `acme.example.com`)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "STANDALONE_ADDRESS", Description: "The listening address of the DNS server (Default: `:53`)"},
+				{Name: "STANDALONE_MBOX", Description: "The mailbox of the SOA record (Default: `hostmaster.<zone>`)"},
+				{Name: "STANDALONE_NAMESERVERS", Description: "Comma-separated names of the nameservers of the zone, used by the NS and SOA records (Default: `ns.<zone>`)"},
+				{Name: "STANDALONE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "STANDALONE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "STANDALONE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard:          true,
+				ZoneAutoDiscovery: capability(false),
+			},
+		},
+		{
+			Code:        "technitium",
+			Name:        "Technitium",
+			URL:         "https://technitium.com/",
+			Since:       "v4.20.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "TECHNITIUM_API_TOKEN", Description: "API token"},
+				{Name: "TECHNITIUM_SERVER_BASE_URL", Description: "Server base URL"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "TECHNITIUM_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "TECHNITIUM_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "TECHNITIUM_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "TECHNITIUM_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "tencentcloud",
+			Name:        "Tencent Cloud DNS",
+			URL:         "https://cloud.tencent.com/product/cns",
+			Since:       "v4.6.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "TENCENTCLOUD_SECRET_ID", Description: "Access key ID"},
+				{Name: "TENCENTCLOUD_SECRET_KEY", Description: "Access Key secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "TENCENTCLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "TENCENTCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "TENCENTCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "TENCENTCLOUD_REGION", Description: "Region"},
+				{Name: "TENCENTCLOUD_SESSION_TOKEN", Description: "Access Key token"},
+				{Name: "TENCENTCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "timewebcloud",
+			Name:        "Timeweb Cloud",
+			URL:         "https://timeweb.cloud/",
+			Since:       "v4.20.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "TIMEWEBCLOUD_AUTH_TOKEN", Description: "Authentication token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "TIMEWEBCLOUD_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 10)"},
+				{Name: "TIMEWEBCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "TIMEWEBCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "transip",
+			Name:        "TransIP",
+			URL:         "https://www.transip.nl/",
+			Since:       "v2.0.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "TRANSIP_ACCOUNT_NAME", Description: "Account name"},
+				{Name: "TRANSIP_PRIVATE_KEY_PATH", Description: "Private key path"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "TRANSIP_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "TRANSIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 600)"},
+				{Name: "TRANSIP_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 10)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "ultradns",
+			Name:        "Ultradns",
+			URL:         "https://vercara.com/authoritative-dns",
+			Since:       "v4.10.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ULTRADNS_PASSWORD", Description: "API Password"},
+				{Name: "ULTRADNS_USERNAME", Description: "API Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ULTRADNS_ENDPOINT", Description: "API endpoint URL, defaults to https://api.ultradns.com/"},
+				{Name: "ULTRADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "ULTRADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "ULTRADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "variomedia",
+			Name:        "Variomedia",
+			URL:         "https://www.variomedia.de/",
+			Since:       "v4.8.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VARIOMEDIA_API_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VARIOMEDIA_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "VARIOMEDIA_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "VARIOMEDIA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "VARIOMEDIA_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "VARIOMEDIA_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vegadns",
+			Name:        "VegaDNS",
+			URL:         "https://github.com/shupp/VegaDNS-API",
+			Since:       "v1.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "SECRET_VEGADNS_KEY", Description: "API key"},
+				{Name: "SECRET_VEGADNS_SECRET", Description: "API secret"},
+				{Name: "VEGADNS_URL", Description: "API endpoint URL"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VEGADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 60)"},
+				{Name: "VEGADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 720)"},
+				{Name: "VEGADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 10)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vercel",
+			Name:        "Vercel",
+			URL:         "https://vercel.com",
+			Since:       "v4.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VERCEL_API_TOKEN", Description: "Authentication token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VERCEL_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "VERCEL_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "VERCEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "VERCEL_TEAM_ID", Description: "Team ID (ex: team_xxxxxxxxxxxxxxxxxxxxxxxx)"},
+				{Name: "VERCEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "versio",
+			Name:        "Versio.[nl|eu|uk]",
+			URL:         "https://www.versio.nl/domeinnamen",
+			Since:       "v2.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VERSIO_PASSWORD", Description: "Basic authentication password"},
+				{Name: "VERSIO_USERNAME", Description: "Basic authentication username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VERSIO_ENDPOINT", Description: "The endpoint URL of the API Server"},
+				{Name: "VERSIO_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "VERSIO_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "VERSIO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "VERSIO_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "VERSIO_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vinyldns",
+			Name:        "VinylDNS",
+			URL:         "https://www.vinyldns.io",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VINYLDNS_ACCESS_KEY", Description: "The VinylDNS API key"},
+				{Name: "VINYLDNS_HOST", Description: "The VinylDNS API URL"},
+				{Name: "VINYLDNS_SECRET_KEY", Description: "The VinylDNS API Secret key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VINYLDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 4)"},
+				{Name: "VINYLDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "VINYLDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 30)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vkcloud",
+			Name:        "VK Cloud",
+			URL:         "https://mcs.mail.ru/",
+			Since:       "v4.9.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VK_CLOUD_PASSWORD", Description: "Password for VK Cloud account"},
+				{Name: "VK_CLOUD_PROJECT_ID", Description: "String ID of project in VK Cloud"},
+				{Name: "VK_CLOUD_USERNAME", Description: "Email of VK Cloud account"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VK_CLOUD_DNS_ENDPOINT", Description: "URL of DNS API. Defaults to https://mcs.mail.ru/public-dns but can be changed for usage with private clouds"},
+				{Name: "VK_CLOUD_DOMAIN_NAME", Description: "Openstack users domain name. Defaults to `users` but can be changed for usage with private clouds"},
+				{Name: "VK_CLOUD_IDENTITY_ENDPOINT", Description: "URL of OpenStack Auth API, Defaults to https://infra.mail.ru:35357/v3/ but can be changed for usage with private clouds"},
+				{Name: "VK_CLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "VK_CLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "VK_CLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "volcengine",
+			Name:        "Volcano Engine/火山引擎",
+			URL:         "https://www.volcengine.com/",
+			Since:       "v4.19.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VOLC_ACCESSKEY", Description: "Access Key ID (AK)"},
+				{Name: "VOLC_SECRETKEY", Description: "Secret Access Key (SK)"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VOLC_HOST", Description: "API host"},
+				{Name: "VOLC_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 15)"},
+				{Name: "VOLC_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "VOLC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 240)"},
+				{Name: "VOLC_REGION", Description: "Region"},
+				{Name: "VOLC_SCHEME", Description: "API scheme"},
+				{Name: "VOLC_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vscale",
+			Name:        "Vscale",
+			URL:         "https://vscale.io/",
+			Since:       "v2.0.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VSCALE_API_TOKEN", Description: "API token"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VSCALE_BASE_URL", Description: "API endpoint URL"},
+				{Name: "VSCALE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "VSCALE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "VSCALE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "VSCALE_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "vultr",
+			Name:        "Vultr",
+			URL:         "https://www.vultr.com/",
+			Since:       "v0.3.1",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "VULTR_API_KEY", Description: "API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "VULTR_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "VULTR_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "VULTR_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "VULTR_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "webnames",
+			Name:        "Webnames",
+			URL:         "https://www.webnames.ru/",
+			Since:       "v4.15.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "WEBNAMES_API_KEY", Description: "Domain API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "WEBNAMES_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "WEBNAMES_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "WEBNAMES_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "websupport",
+			Name:        "Websupport",
+			URL:         "https://websupport.sk",
+			Since:       "v4.10.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "WEBSUPPORT_API_KEY", Description: "API key"},
+				{Name: "WEBSUPPORT_SECRET", Description: "API secret"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "WEBSUPPORT_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "WEBSUPPORT_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "WEBSUPPORT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "WEBSUPPORT_SEQUENCE_INTERVAL", Description: "Time between sequential requests in seconds (Default: 60)"},
+				{Name: "WEBSUPPORT_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "wedos",
+			Name:        "WEDOS",
+			URL:         "https://www.wedos.com",
+			Since:       "v4.4.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "WEDOS_USERNAME", Description: "Username is the same as for the admin account"},
+				{Name: "WEDOS_WAPI_PASSWORD", Description: "Password needs to be generated and IP allowed in the admin interface"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "WEDOS_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "WEDOS_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "WEDOS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 600)"},
+				{Name: "WEDOS_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "westcn",
+			Name:        "West.cn/西部数码",
+			URL:         "https://www.west.cn",
+			Since:       "v4.21.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "WESTCN_PASSWORD", Description: "API password"},
+				{Name: "WESTCN_USERNAME", Description: "Username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "WESTCN_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "WESTCN_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 10)"},
+				{Name: "WESTCN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 120)"},
+				{Name: "WESTCN_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "yandex",
+			Name:        "Yandex PDD",
+			URL:         "https://pdd.yandex.com",
+			Since:       "v3.7.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "YANDEX_PDD_TOKEN", Description: "Basic authentication username"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "YANDEX_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "YANDEX_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "YANDEX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "YANDEX_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 21600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "yandex360",
+			Name:        "Yandex 360",
+			URL:         "https://360.yandex.ru",
+			Since:       "v4.14.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "YANDEX360_OAUTH_TOKEN", Description: "The OAuth Token"},
+				{Name: "YANDEX360_ORG_ID", Description: "The organization ID"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "YANDEX360_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "YANDEX360_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "YANDEX360_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "YANDEX360_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 21600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "yandexcloud",
+			Name:        "Yandex Cloud",
+			URL:         "https://cloud.yandex.com",
+			Since:       "v4.9.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "YANDEX_CLOUD_FOLDER_ID", Description: "The string id of folder (aka project) in Yandex Cloud"},
+				{Name: "YANDEX_CLOUD_IAM_TOKEN", Description: "The base64 encoded json which contains information about iam token of service account with `dns.admin` permissions"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "YANDEX_CLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "YANDEX_CLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "YANDEX_CLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 60)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "zoneee",
+			Name:        "Zone.ee",
+			URL:         "https://www.zone.ee/",
+			Since:       "v2.1.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ZONEEE_API_KEY", Description: "API key"},
+				{Name: "ZONEEE_API_USER", Description: "API user"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ZONEEE_ENDPOINT", Description: "API endpoint URL"},
+				{Name: "ZONEEE_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "ZONEEE_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 5)"},
+				{Name: "ZONEEE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 300)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+		{
+			Code:        "zonomi",
+			Name:        "Zonomi",
+			URL:         "https://zonomi.com",
+			Since:       "v3.5.0",
+			Description: "",
+			Required: []dnsEnvVarInfo{
+				{Name: "ZONOMI_API_KEY", Description: "User API key"},
+			},
+			Optional: []dnsEnvVarInfo{
+				{Name: "ZONOMI_HTTP_TIMEOUT", Description: "API request timeout in seconds (Default: 30)"},
+				{Name: "ZONOMI_POLLING_INTERVAL", Description: "Time between DNS propagation check in seconds (Default: 2)"},
+				{Name: "ZONOMI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation in seconds (Default: 60)"},
+				{Name: "ZONOMI_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds (Default: 3600)"},
+			},
+			Capabilities: dnsCapabilities{
+				Wildcard: true,
+			},
+		},
+	}
+}
+
 func displayDNSHelp(w io.Writer, name string) error {
 	w = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
 	ew := &errWriter{w: w}
@@ -1095,6 +4881,18 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`Since:	'v0.5.0'`)
 		ew.writeln()
 
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "EXEC_PATH":	The path of the the external program.`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "EXEC_MODE":	'RAW', 'JSON', none`)
+		ew.writeln(`	- "EXEC_POLLING_INTERVAL":	Time between DNS propagation check in seconds (Default: 3)`)
+		ew.writeln(`	- "EXEC_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation in seconds (Default: 60)`)
+		ew.writeln(`	- "EXEC_SEQUENCE_INTERVAL":	Time between sequential requests in seconds (Default: 60)`)
+		ew.writeln(`	- "EXEC_TIMEOUT":	Time allowed to each call of the program in seconds (Default: 0, no limit)`)
+		ew.writeln(`	- "EXEC_TTL":	The TTL of the TXT record used for the DNS challenge ('JSON' mode only) (Default: 120)`)
+
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/exec`)
 
@@ -2490,17 +6288,18 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Credentials:`)
-		ew.writeln(`	- "RFC2136_NAMESERVER":	Network address in the form "host" or "host:port"`)
-		ew.writeln(`	- "RFC2136_TSIG_ALGORITHM":	TSIG algorithm. See [miekg/dns#tsig.go](https://github.com/miekg/dns/blob/master/tsig.go) for supported values. To disable TSIG authentication, leave the 'RFC2136_TSIG_KEY' or 'RFC2136_TSIG_SECRET' variables unset.`)
+		ew.writeln(`	- "RFC2136_TSIG_ALGORITHM":	TSIG algorithm (Default: 'hmac-sha1.'). See [miekg/dns#tsig.go](https://github.com/miekg/dns/blob/master/tsig.go) for supported values. To disable TSIG authentication, leave the 'RFC2136_TSIG_KEY' or 'RFC2136_TSIG_SECRET' variables unset.`)
 		ew.writeln(`	- "RFC2136_TSIG_KEY":	Name of the secret key as defined in DNS server configuration. To disable TSIG authentication, leave the 'RFC2136_TSIG_KEY' variable unset.`)
 		ew.writeln(`	- "RFC2136_TSIG_SECRET":	Secret key payload. To disable TSIG authentication, leave the 'RFC2136_TSIG_SECRET' variable unset.`)
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
 		ew.writeln(`	- "RFC2136_DNS_TIMEOUT":	API request timeout in seconds (Default: 10)`)
+		ew.writeln(`	- "RFC2136_NAMESERVER":	Network address in the form "host" or "host:port". If unset, the updates are sent to the primary nameserver of the zone (the MNAME of the SOA record).`)
 		ew.writeln(`	- "RFC2136_POLLING_INTERVAL":	Time between DNS propagation check in seconds (Default: 2)`)
 		ew.writeln(`	- "RFC2136_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation in seconds (Default: 60)`)
 		ew.writeln(`	- "RFC2136_SEQUENCE_INTERVAL":	Time between sequential requests in seconds (Default: 60)`)
+		ew.writeln(`	- "RFC2136_SIG0_FILE":	Path to the 'K*.private' file of a SIG(0) key generated by 'dnssec-keygen -T KEY' (the '.key' file must be in the same directory). The key name and the algorithm are read from the key files. Cannot be used with TSIG.`)
 		ew.writeln(`	- "RFC2136_TSIG_FILE":	Path to a key file generated by tsig-keygen`)
 		ew.writeln(`	- "RFC2136_TTL":	The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)`)
 
@@ -2543,6 +6342,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "AWS_REGION":	Managed by the AWS client ('AWS_REGION_FILE' is not supported)`)
 		ew.writeln(`	- "AWS_SDK_LOAD_CONFIG":	Managed by the AWS client. Retrieve the region from the CLI config file ('AWS_SDK_LOAD_CONFIG_FILE' is not supported)`)
 		ew.writeln(`	- "AWS_SECRET_ACCESS_KEY":	Managed by the AWS client. Secret access key ('AWS_SECRET_ACCESS_KEY_FILE' is not supported, use 'AWS_SHARED_CREDENTIALS_FILE' instead)`)
+		ew.writeln(`	- "AWS_SESSION_TOKEN":	Managed by the AWS client. Session token of temporary credentials ('AWS_SESSION_TOKEN_FILE' is not supported)`)
 		ew.writeln(`	- "AWS_WAIT_FOR_RECORD_SETS_CHANGED":	Wait for changes to be INSYNC (it can be unstable)`)
 		ew.writeln()
 
@@ -2791,6 +6591,28 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/stackpath`)
 
+	case "standalone":
+		// generated from: providers/dns/standalone/standalone.toml
+		ew.writeln(`Configuration for Standalone (embedded DNS server).`)
+		ew.writeln(`Code:	'standalone'`)
+		ew.writeln(`Since:	'v4.23.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "STANDALONE_ZONE":	The zone delegated to the DNS server (e.g. 'acme.example.com')`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "STANDALONE_ADDRESS":	The listening address of the DNS server (Default: ':53')`)
+		ew.writeln(`	- "STANDALONE_MBOX":	The mailbox of the SOA record (Default: 'hostmaster.<zone>')`)
+		ew.writeln(`	- "STANDALONE_NAMESERVERS":	Comma-separated names of the nameservers of the zone, used by the NS and SOA records (Default: 'ns.<zone>')`)
+		ew.writeln(`	- "STANDALONE_POLLING_INTERVAL":	Time between DNS propagation check in seconds (Default: 2)`)
+		ew.writeln(`	- "STANDALONE_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation in seconds (Default: 60)`)
+		ew.writeln(`	- "STANDALONE_TTL":	The TTL of the TXT record used for the DNS challenge in seconds (Default: 120)`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/standalone`)
+
 	case "technitium":
 		// generated from: providers/dns/technitium/technitium.toml
 		ew.writeln(`Configuration for Technitium.`)
@@ -31,6 +31,7 @@ require (
 	github.com/exoscale/egoscale/v3 v3.1.7
 	github.com/go-jose/go-jose/v4 v4.0.4
 	github.com/go-viper/mapstructure/v2 v2.2.1
+	github.com/gofrs/flock v0.12.1
 	github.com/google/go-querystring v1.1.0
 	github.com/gophercloud/gophercloud v1.14.1
 	github.com/gophercloud/utils v0.0.0-20231010081019-80377eca5d56
@@ -134,7 +135,6 @@ require (
 	github.com/go-playground/validator/v10 v10.16.0 // indirect
 	github.com/go-resty/resty/v2 v2.16.2 // indirect
 	github.com/goccy/go-json v0.10.4 // indirect
-	github.com/gofrs/flock v0.12.1 // indirect
 	github.com/golang-jwt/jwt/v4 v4.5.1 // indirect
 	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
 	github.com/google/s2a-go v0.1.8 // indirect
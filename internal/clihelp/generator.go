@@ -56,6 +56,8 @@ func generate() error {
 		{"lego", "help", "renew"},
 		{"lego", "help", "revoke"},
 		{"lego", "help", "list"},
+		{"lego", "dns", "help", "cleanup"},
+		{"lego", "provider", "help", "check"},
 		{"lego", "dnshelp"},
 	} {
 		content, err := run(app, args)
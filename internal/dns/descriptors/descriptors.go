@@ -20,6 +20,7 @@ type Provider struct {
 	Description   string         // Provider summary
 	Example       string         // CLI example
 	Configuration *Configuration // Environment variables
+	Capabilities  Capabilities   // Supported features
 	Links         *Links         // Links
 	Additional    string         // Extra documentation
 	GeneratedFrom string         // Source file
@@ -30,6 +31,14 @@ type Configuration struct {
 	Additional  map[string]string
 }
 
+// Capabilities of a DNS provider.
+// An undefined capability is unknown.
+// The wildcard certificates are not a capability: they are supported by the DNS-01 challenge.
+type Capabilities struct {
+	MultipleTXT       *bool // Several TXT values for the same record name (i.e. a domain and its wildcard)
+	ZoneAutoDiscovery *bool // The zone of a domain is found without configuration
+}
+
 type Links struct {
 	API      string
 	GoClient string
@@ -13,6 +13,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 	"text/template"
@@ -23,6 +24,10 @@ import (
 //go:embed templates
 var templateFS embed.FS
 
+// envVarName matches the names of environment variables,
+// the other keys of the credentials describe alternatives (i.e. "Application Default Credentials").
+var envVarName = regexp.MustCompile(`^[A-Z0-9_]+$`)
+
 const (
 	root = "../../../"
 
@@ -100,6 +105,10 @@ func generateCLIHelp(models *descriptors.Providers) error {
 			"safe": func(src string) string {
 				return strings.ReplaceAll(src, "`", "'")
 			},
+			"deref": func(supported *bool) bool {
+				return *supported
+			},
+			"isEnvVar": envVarName.MatchString,
 		}).ParseFS(templateFS, cliTemplate),
 	).Execute(b, models)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -51,6 +52,8 @@ func generate() error {
 			"cleanName": func(src string) string {
 				return strings.ReplaceAll(src, "-", "")
 			},
+			"envVars":      envVars,
+			"configurable": configurable,
 		}).Parse(srcTemplate),
 	).Execute(b, info)
 	if err != nil {
@@ -72,3 +75,48 @@ func generate() error {
 
 	return nil
 }
+
+// envVars returns the sorted names of the environment variables of the configuration of a provider.
+func envVars(provider descriptors.Provider) []string {
+	var names []string
+
+	for name := range provider.Configuration.Credentials {
+		names = append(names, name)
+	}
+
+	for name := range provider.Configuration.Additional {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+// configurable returns true if the provider can be configured from values instead of the environment.
+// The providers reading the environment through a third-party client (i.e. an SDK) don't define the `NewDNSProviderFrom` function.
+func configurable(provider descriptors.Provider) (bool, error) {
+	dir := filepath.Join(root, "providers", "dns", strings.ReplaceAll(provider.Code, "-", ""))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return false, err
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return false, err
+		}
+
+		if bytes.Contains(content, []byte("\nfunc NewDNSProviderFrom(")) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
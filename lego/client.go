@@ -45,7 +45,12 @@ func NewClient(config *Config) (*Client, error) {
 		kid = reg.URI
 	}
 
-	core, err := api.New(config.HTTPClient, config.UserAgent, config.CADirURL, kid, privateKey)
+	var opts []api.Option
+	if config.Debug.HTTP {
+		opts = append(opts, api.WithHTTPDebug(config.Debug.Transcript))
+	}
+
+	core, err := api.New(config.HTTPClient, config.UserAgent, config.CADirURL, kid, privateKey, opts...)
 	if err != nil {
 		return nil, err
 	}
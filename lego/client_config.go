@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -46,6 +47,7 @@ type Config struct {
 	UserAgent   string
 	HTTPClient  *http.Client
 	Certificate CertificateConfig
+	Debug       DebugConfig
 }
 
 func NewConfig(user registration.User) *Config {
@@ -66,6 +68,15 @@ type CertificateConfig struct {
 	OverallRequestLimit int
 }
 
+// DebugConfig configures the debug of the HTTP exchanges with the ACME server.
+type DebugConfig struct {
+	// HTTP logs the requests and the responses at the debug level (see log.SetLevel).
+	// The JWS are decoded, and the secrets (keys, signatures, EAB HMAC, bearer tokens, CSR) are redacted.
+	HTTP bool
+	// Transcript is an optional writer where each exchange is written as a JSON line (requires HTTP).
+	Transcript io.Writer
+}
+
 // createDefaultHTTPClient Creates an HTTP client with a reasonable timeout value
 // and potentially a custom *x509.CertPool
 // based on the caCertificatesEnvVar environment variable (see the `initCertPool` function).
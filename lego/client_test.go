@@ -2,10 +2,18 @@ package lego
 
 import (
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"net"
+	"strconv"
 	"testing"
 
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +42,72 @@ func TestNewClient(t *testing.T) {
 	assert.NotNil(t, client)
 }
 
+func TestClient_Obtain_challengeFallback(t *testing.T) {
+	httpPort, tlsPort := freePort(t), freePort(t)
+
+	server := tester.NewACMEServer(t, tester.WithHTTP01Port(httpPort), tester.WithTLSALPN01Port(tlsPort))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	user := &testUser{key: key}
+
+	config := NewConfig(user)
+	config.CADirURL = server.URL()
+	config.Certificate.KeyType = certcrypto.EC256
+
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	user.registration, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	require.NoError(t, err)
+
+	// tls-alpn-01 is tried first, but nothing listens on the port.
+	err = client.Challenge.SetTLSALPN01Provider(tlsalpn01.NewConfigProvider())
+	require.NoError(t, err)
+
+	err = client.Challenge.SetHTTP01Provider(http01.NewProviderServer("127.0.0.1", strconv.Itoa(httpPort)))
+	require.NoError(t, err)
+
+	certRes, err := client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com", "www.example.com"}})
+	require.NoError(t, err)
+
+	cert, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+
+	// All the challenges fail: nothing serves the HTTP-01 challenge.
+	err = client.Challenge.SetHTTP01Provider(tlsalpn01.NewConfigProvider())
+	require.NoError(t, err)
+
+	_, err = client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com"}})
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "tls-alpn-01: ")
+	assert.Contains(t, err.Error(), "http-01: ")
+}
+
+type testUser struct {
+	key          crypto.PrivateKey
+	registration *registration.Resource
+}
+
+func (u *testUser) GetEmail() string                        { return "" }
+func (u *testUser) GetRegistration() *registration.Resource { return u.registration }
+func (u *testUser) GetPrivateKey() crypto.PrivateKey        { return u.key }
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer func() { _ = l.Close() }()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
 type mockUser struct {
 	email      string
 	regres     *registration.Resource
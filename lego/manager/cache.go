@@ -0,0 +1,89 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ErrCacheMiss is returned by a Cache when the key is not found.
+var ErrCacheMiss = errors.New("manager: certificate cache miss")
+
+// Cache is the storage of the certificates and their private keys.
+// The data are PEM encoded (private key and certificate chain).
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the data for the key, or ErrCacheMiss if the key is not found.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores the data for the key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the key from the cache.
+	// It must not return an error if the key doesn't exist.
+	Delete(ctx context.Context, key string) error
+}
+
+// DirCache implements Cache using a directory on the local filesystem.
+// The directory is created with 0700 permissions if it doesn't exist.
+type DirCache string
+
+// Get implements Cache.
+func (d DirCache) Get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(string(d), key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrCacheMiss
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("manager: read %s: %w", key, err)
+	}
+
+	return data, nil
+}
+
+// Put implements Cache.
+// The file is written atomically (temporary file and rename).
+func (d DirCache) Put(_ context.Context, key string, data []byte) error {
+	err := os.MkdirAll(string(d), 0o700)
+	if err != nil {
+		return fmt.Errorf("manager: create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(string(d), "."+key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("manager: write %s: %w", key, err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("manager: write %s: %w", key, err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("manager: write %s: %w", key, err)
+	}
+
+	err = os.Rename(tmp.Name(), filepath.Join(string(d), key))
+	if err != nil {
+		return fmt.Errorf("manager: write %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Delete implements Cache.
+func (d DirCache) Delete(_ context.Context, key string) error {
+	err := os.Remove(filepath.Join(string(d), key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("manager: delete %s: %w", key, err)
+	}
+
+	return nil
+}
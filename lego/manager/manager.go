@@ -0,0 +1,450 @@
+// Package manager provides an on-demand TLS certificate manager based on the lego client.
+//
+// The Manager issues, loads, and renews the certificates when they are requested by the TLS handshakes
+// (tls.Config.GetCertificate), and serves the tls-alpn-01 challenges inline.
+package manager
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/log"
+)
+
+const (
+	defaultRenewBefore = 30 * 24 * time.Hour
+	renewRetryDelay    = time.Hour
+)
+
+// HostPolicy decides whether a certificate can be issued for a host.
+// It must return an error to deny the host.
+type HostPolicy func(ctx context.Context, host string) error
+
+// HostAllowlist returns a HostPolicy that only allows the specified hosts (exact match, case-insensitive).
+func HostAllowlist(hosts ...string) HostPolicy {
+	allowed := make(map[string]struct{}, len(hosts))
+	for _, h := range hosts {
+		allowed[strings.ToLower(strings.TrimSuffix(h, "."))] = struct{}{}
+	}
+
+	return func(_ context.Context, host string) error {
+		if _, ok := allowed[host]; !ok {
+			return fmt.Errorf("manager: host %q not allowed", host)
+		}
+
+		return nil
+	}
+}
+
+// Options configures a Manager.
+type Options struct {
+	// Cache stores the certificates and the private keys.
+	// If nil, the certificates are only kept in memory.
+	Cache Cache
+
+	// HostPolicy controls which hosts can have a certificate.
+	// If nil, all the hosts are allowed: it's strongly recommended to define a policy.
+	HostPolicy HostPolicy
+
+	// RenewBefore is the duration before the expiration of a certificate to renew it.
+	// Default: 30 days.
+	RenewBefore time.Duration
+
+	// PreferredChain is the common name of the root of the preferred certificate chain.
+	PreferredChain string
+}
+
+// Manager is a certificate manager that obtains the certificates on demand.
+type Manager struct {
+	client  *lego.Client
+	options Options
+
+	mu         sync.Mutex
+	certs      map[string]*certState
+	challenges map[string]*tls.Certificate
+	closed     bool
+}
+
+// certState is the state of the certificate of a host.
+type certState struct {
+	mu    sync.Mutex
+	cert  *tls.Certificate
+	timer *time.Timer
+
+	// removed is true when the state has been removed from the Manager (no certificate obtained).
+	removed bool
+}
+
+// New creates a new Manager.
+// The client must have a registered account.
+// The Manager is registered as the tls-alpn-01 provider of the client:
+// the challenges are served by the GetCertificate method.
+func New(client *lego.Client, options Options) (*Manager, error) {
+	if client == nil {
+		return nil, errors.New("manager: the client cannot be nil")
+	}
+
+	if options.RenewBefore <= 0 {
+		options.RenewBefore = defaultRenewBefore
+	}
+
+	m := &Manager{
+		client:     client,
+		options:    options,
+		certs:      make(map[string]*certState),
+		challenges: make(map[string]*tls.Certificate),
+	}
+
+	err := client.Challenge.SetTLSALPN01Provider(m)
+	if err != nil {
+		return nil, fmt.Errorf("manager: %w", err)
+	}
+
+	return m, nil
+}
+
+// TLSConfig returns a TLS configuration that uses the Manager to get the certificates.
+// The "acme-tls/1" protocol is enabled to serve the tls-alpn-01 challenges.
+func (m *Manager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: m.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1", tlsalpn01.ACMETLS1Protocol},
+		MinVersion:     tls.VersionTLS12,
+	}
+}
+
+// Present implements challenge.Provider for the tls-alpn-01 challenge.
+func (m *Manager) Present(domain, _, keyAuth string) error {
+	cert, err := tlsalpn01.ChallengeCert(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.challenges[tlsalpn01.ServerName(domain)] = cert
+
+	return nil
+}
+
+// CleanUp implements challenge.Provider for the tls-alpn-01 challenge.
+func (m *Manager) CleanUp(domain, _, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.challenges, tlsalpn01.ServerName(domain))
+
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+// It returns the tls-alpn-01 challenge certificates,
+// and the certificates from memory, from the cache, or obtained from the ACME server.
+func (m *Manager) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	name := strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))
+
+	if name == "" {
+		return nil, errors.New("manager: missing server name")
+	}
+
+	if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return nil, fmt.Errorf("manager: invalid server name %q", name)
+	}
+
+	if slices.Contains(hello.SupportedProtos, tlsalpn01.ACMETLS1Protocol) {
+		m.mu.Lock()
+		cert, ok := m.challenges[name]
+		m.mu.Unlock()
+
+		if !ok {
+			return nil, fmt.Errorf("manager: no tls-alpn-01 challenge for %q", name)
+		}
+
+		return cert, nil
+	}
+
+	ctx := hello.Context()
+
+	if cert := m.getValidCertificate(name); cert != nil {
+		return cert, nil
+	}
+
+	// The policy is checked before creating a state: the states of the denied hosts are never stored.
+	if m.options.HostPolicy != nil {
+		if err := m.options.HostPolicy(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		state, err := m.getState(name)
+		if err != nil {
+			return nil, err
+		}
+
+		state.mu.Lock()
+
+		if state.removed {
+			// The state has been removed while waiting for its lock.
+			state.mu.Unlock()
+			continue
+		}
+
+		cert, err := m.getOrObtain(ctx, name, state)
+
+		state.mu.Unlock()
+
+		return cert, err
+	}
+}
+
+// getOrObtain returns the certificate of the state, or loads it from the cache, or obtains it (the state lock must be held).
+// The state is removed when no certificate can be obtained.
+func (m *Manager) getOrObtain(ctx context.Context, name string, state *certState) (*tls.Certificate, error) {
+	if state.cert != nil && time.Now().Before(state.cert.Leaf.NotAfter) {
+		return state.cert, nil
+	}
+
+	cert, err := m.loadFromCache(ctx, name)
+	if err != nil {
+		log.Info("Obtaining a certificate on demand.", log.Domain(name))
+
+		cert, err = m.obtain(ctx, name)
+		if err != nil {
+			if state.cert == nil {
+				m.removeState(name, state)
+			}
+
+			return nil, err
+		}
+	}
+
+	m.setCertificate(name, state, cert)
+
+	return cert, nil
+}
+
+// getValidCertificate returns the certificate in memory of a host, if it is not expired.
+func (m *Manager) getValidCertificate(name string) *tls.Certificate {
+	m.mu.Lock()
+	state, ok := m.certs[name]
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.cert != nil && time.Now().Before(state.cert.Leaf.NotAfter) {
+		return state.cert
+	}
+
+	return nil
+}
+
+// Close stops the background renewals.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	m.closed = true
+
+	states := make([]*certState, 0, len(m.certs))
+	for _, state := range m.certs {
+		states = append(states, state)
+	}
+	m.mu.Unlock()
+
+	for _, state := range states {
+		state.mu.Lock()
+		if state.timer != nil {
+			state.timer.Stop()
+		}
+		state.mu.Unlock()
+	}
+}
+
+func (m *Manager) getState(name string) (*certState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, errors.New("manager: closed")
+	}
+
+	state, ok := m.certs[name]
+	if !ok {
+		state = &certState{}
+		m.certs[name] = state
+	}
+
+	return state, nil
+}
+
+// removeState removes a state without certificate (the state lock must be held).
+func (m *Manager) removeState(name string, state *certState) {
+	state.removed = true
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.certs[name] == state {
+		delete(m.certs, name)
+	}
+}
+
+// setCertificate stores the certificate in memory and schedules its renewal (the state lock must be held).
+func (m *Manager) setCertificate(name string, state *certState, cert *tls.Certificate) {
+	state.cert = cert
+
+	renewAt := cert.Leaf.NotAfter.Add(-m.options.RenewBefore)
+
+	m.scheduleRenewal(name, state, time.Until(renewAt))
+}
+
+// scheduleRenewal schedules the renewal of a certificate (the state lock must be held).
+func (m *Manager) scheduleRenewal(name string, state *certState, delay time.Duration) {
+	if state.timer != nil {
+		state.timer.Stop()
+	}
+
+	state.timer = time.AfterFunc(max(delay, 0), func() { m.renew(name, state) })
+}
+
+func (m *Manager) renew(name string, state *certState) {
+	m.mu.Lock()
+	closed := m.closed
+	m.mu.Unlock()
+
+	if closed {
+		return
+	}
+
+	log.Info("Renewing the certificate.", log.Domain(name))
+
+	// The current certificate is still served during the renewal.
+	cert, err := m.obtain(context.Background(), name)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if err != nil {
+		log.Warn("Unable to renew the certificate.", log.Domain(name), "error", err)
+
+		// Retry later, but not after the expiration of the current certificate.
+		delay := renewRetryDelay
+		if state.cert != nil {
+			delay = min(delay, max(time.Until(state.cert.Leaf.NotAfter)/2, time.Second))
+		}
+
+		m.scheduleRenewal(name, state, delay)
+
+		return
+	}
+
+	m.setCertificate(name, state, cert)
+}
+
+// obtain obtains a certificate from the ACME server and stores it in the cache.
+func (m *Manager) obtain(ctx context.Context, name string) (*tls.Certificate, error) {
+	res, err := m.client.Certificate.Obtain(certificate.ObtainRequest{
+		Domains:        []string{name},
+		Bundle:         true,
+		PreferredChain: m.options.PreferredChain,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("manager: obtain certificate for %q: %w", name, err)
+	}
+
+	data := slices.Concat(res.PrivateKey, res.Certificate)
+
+	cert, err := parseCertificate(data)
+	if err != nil {
+		return nil, fmt.Errorf("manager: %q: %w", name, err)
+	}
+
+	if m.options.Cache != nil {
+		if err = m.options.Cache.Put(ctx, name, data); err != nil {
+			log.Warn("Unable to store the certificate in the cache.", log.Domain(name), "error", err)
+		}
+	}
+
+	return cert, nil
+}
+
+// loadFromCache loads a certificate from the cache.
+// An error is returned if the certificate is not found, is invalid, or is expired.
+// A certificate that must be renewed is returned: the renewal is done in the background.
+func (m *Manager) loadFromCache(ctx context.Context, name string) (*tls.Certificate, error) {
+	if m.options.Cache == nil {
+		return nil, ErrCacheMiss
+	}
+
+	data, err := m.options.Cache.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := parseCertificate(data)
+	if err != nil {
+		log.Warn("Invalid certificate in the cache.", log.Domain(name), "error", err)
+		return nil, err
+	}
+
+	if err = cert.Leaf.VerifyHostname(name); err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(cert.Leaf.NotAfter) {
+		return nil, errors.New("the certificate is expired")
+	}
+
+	return cert, nil
+}
+
+// parseCertificate parses PEM data containing a private key and a certificate chain.
+func parseCertificate(data []byte) (*tls.Certificate, error) {
+	var certPEM, keyPEM bytes.Buffer
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			_ = pem.Encode(&keyPEM, block)
+		} else {
+			_ = pem.Encode(&certPEM, block)
+		}
+	}
+
+	cert, err := tls.X509KeyPair(certPEM.Bytes(), keyPEM.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if cert.Leaf == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &cert, nil
+}
@@ -0,0 +1,208 @@
+package manager
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/registration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_GetCertificate(t *testing.T) {
+	listener := listenTLS(t)
+
+	server := tester.NewACMEServer(t, tester.WithTLSALPN01Port(listener.port))
+
+	cache := DirCache(t.TempDir())
+
+	m, err := New(newClient(t, server.URL()), Options{
+		Cache:      cache,
+		HostPolicy: HostAllowlist("example.com"),
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(m.Close)
+
+	listener.serve(t, m.TLSConfig())
+
+	cert := handshake(t, listener.addr, "example.com", server.Roots()[0])
+
+	assert.Equal(t, []string{"example.com"}, cert.DNSNames)
+
+	// From memory.
+	again := handshake(t, listener.addr, "example.com", server.Roots()[0])
+
+	assert.Equal(t, cert.SerialNumber, again.SerialNumber)
+
+	// From the cache.
+	_, err = cache.Get(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	server.InjectFault(tester.EndpointNewOrder, tester.FaultRateLimited, 1)
+
+	m2, err := New(newClient(t, server.URL()), Options{Cache: cache})
+	require.NoError(t, err)
+
+	t.Cleanup(m2.Close)
+
+	fromCache, err := m2.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+	require.NoError(t, err)
+
+	assert.Equal(t, cert.SerialNumber, fromCache.Leaf.SerialNumber)
+}
+
+func TestManager_GetCertificate_hostPolicy(t *testing.T) {
+	server := tester.NewACMEServer(t)
+
+	m, err := New(newClient(t, server.URL()), Options{HostPolicy: HostAllowlist("example.com")})
+	require.NoError(t, err)
+
+	t.Cleanup(m.Close)
+
+	_, err = m.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.org"})
+	require.EqualError(t, err, `manager: host "example.org" not allowed`)
+
+	_, err = m.GetCertificate(&tls.ClientHelloInfo{ServerName: "../example.com"})
+	require.EqualError(t, err, `manager: invalid server name "../example.com"`)
+
+	_, err = m.GetCertificate(&tls.ClientHelloInfo{})
+	require.EqualError(t, err, "manager: missing server name")
+
+	_, err = m.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com", SupportedProtos: []string{"acme-tls/1"}})
+	require.EqualError(t, err, `manager: no tls-alpn-01 challenge for "example.com"`)
+
+	// The denied hosts are not stored.
+	assert.Empty(t, m.certs)
+}
+
+func TestManager_GetCertificate_obtainError(t *testing.T) {
+	server := tester.NewACMEServer(t)
+
+	server.InjectFault(tester.EndpointNewOrder, tester.FaultRateLimited, 1)
+
+	m, err := New(newClient(t, server.URL()), Options{})
+	require.NoError(t, err)
+
+	t.Cleanup(m.Close)
+
+	_, err = m.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+	require.Error(t, err)
+
+	// The state of a failed obtain is removed.
+	assert.Empty(t, m.certs)
+}
+
+func TestManager_renewal(t *testing.T) {
+	listener := listenTLS(t)
+
+	server := tester.NewACMEServer(t, tester.WithTLSALPN01Port(listener.port), tester.WithCertificateLifetime(time.Hour))
+
+	m, err := New(newClient(t, server.URL()), Options{RenewBefore: time.Hour - 2*time.Second})
+	require.NoError(t, err)
+
+	t.Cleanup(m.Close)
+
+	listener.serve(t, m.TLSConfig())
+
+	cert := handshake(t, listener.addr, "example.com", server.Roots()[0])
+
+	assert.Eventually(t, func() bool {
+		renewed := handshake(t, listener.addr, "example.com", server.Roots()[0])
+
+		return renewed.SerialNumber.Cmp(cert.SerialNumber) != 0
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
+type tlsListener struct {
+	net.Listener
+
+	addr string
+	port int
+}
+
+func listenTLS(t *testing.T) *tlsListener {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = l.Close() })
+
+	return &tlsListener{Listener: l, addr: l.Addr().String(), port: l.Addr().(*net.TCPAddr).Port}
+}
+
+// serve completes the TLS handshakes.
+func (l *tlsListener) serve(t *testing.T, config *tls.Config) {
+	t.Helper()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				tlsConn := tls.Server(conn, config)
+				_ = tlsConn.Handshake()
+				_ = tlsConn.Close()
+			}()
+		}
+	}()
+}
+
+func handshake(t *testing.T, addr, serverName string, root *x509.Certificate) *x509.Certificate {
+	t.Helper()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(root)
+
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: serverName, RootCAs: pool})
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	return conn.ConnectionState().PeerCertificates[0]
+}
+
+type testUser struct {
+	key          crypto.PrivateKey
+	registration *registration.Resource
+}
+
+func (u *testUser) GetEmail() string                        { return "" }
+func (u *testUser) GetRegistration() *registration.Resource { return u.registration }
+func (u *testUser) GetPrivateKey() crypto.PrivateKey        { return u.key }
+
+func newClient(t *testing.T, dirURL string) *lego.Client {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	user := &testUser{key: key}
+
+	config := lego.NewConfig(user)
+	config.CADirURL = dirURL
+	config.Certificate.KeyType = certcrypto.EC256
+
+	client, err := lego.NewClient(config)
+	require.NoError(t, err)
+
+	user.registration, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	require.NoError(t, err)
+
+	return client
+}
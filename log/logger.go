@@ -1,8 +1,11 @@
 package log
 
 import (
+	"fmt"
 	"log"
+	"log/slog"
 	"os"
+	"strings"
 )
 
 // Logger is an optional custom logger.
@@ -21,39 +24,80 @@ type StdLogger interface {
 // Fatal writes a log entry.
 // It uses Logger if not nil, otherwise it uses the default log.Logger.
 func Fatal(args ...interface{}) {
+	if fatal(fmt.Sprint(args...)) {
+		return
+	}
+
 	Logger.Fatal(args...)
 }
 
 // Fatalf writes a log entry.
 // It uses Logger if not nil, otherwise it uses the default log.Logger.
 func Fatalf(format string, args ...interface{}) {
+	if fatal(fmt.Sprintf(format, args...)) {
+		return
+	}
+
 	Logger.Fatalf(format, args...)
 }
 
 // Print writes a log entry.
 // It uses Logger if not nil, otherwise it uses the default log.Logger.
 func Print(args ...interface{}) {
+	if l := structured.Load(); l != nil {
+		l.Info(fmt.Sprint(args...))
+		return
+	}
+
+	if !printEnabled() {
+		return
+	}
+
 	Logger.Print(args...)
 }
 
 // Println writes a log entry.
 // It uses Logger if not nil, otherwise it uses the default log.Logger.
 func Println(args ...interface{}) {
+	if l := structured.Load(); l != nil {
+		l.Info(strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+		return
+	}
+
+	if !printEnabled() {
+		return
+	}
+
 	Logger.Println(args...)
 }
 
 // Printf writes a log entry.
 // It uses Logger if not nil, otherwise it uses the default log.Logger.
 func Printf(format string, args ...interface{}) {
+	if l := structured.Load(); l != nil {
+		l.Info(fmt.Sprintf(format, args...))
+		return
+	}
+
+	if !printEnabled() {
+		return
+	}
+
 	Logger.Printf(format, args...)
 }
 
-// Warnf writes a log entry.
+// Warnf writes a log entry at the warn level.
 func Warnf(format string, args ...interface{}) {
-	Printf("[WARN] "+format, args...)
+	Warn(fmt.Sprintf(format, args...))
 }
 
-// Infof writes a log entry.
+// Infof writes a log entry at the info level.
 func Infof(format string, args ...interface{}) {
-	Printf("[INFO] "+format, args...)
+	Info(fmt.Sprintf(format, args...))
+}
+
+// printEnabled reports whether the Print functions write to Logger:
+// their messages are at the info level.
+func printEnabled() bool {
+	return level.Level() <= slog.LevelInfo
 }
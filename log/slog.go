@@ -0,0 +1,263 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Attribute keys used by lego.
+const (
+	KeyDomain        = "domain"
+	KeyOrderURL      = "order"
+	KeyAuthzURL      = "authz"
+	KeyChallengeType = "challenge"
+)
+
+// level is the minimum level of the messages written by the StdLogger adapter.
+var level = new(slog.LevelVar)
+
+// structured is the optional custom structured logger.
+var structured atomic.Pointer[slog.Logger]
+
+// SetDefault sets the structured logger used by lego.
+// If l is nil, the messages are written to Logger (StdLogger) through an adapter.
+func SetDefault(l *slog.Logger) {
+	structured.Store(l)
+}
+
+// Default returns the structured logger used by lego.
+func Default() *slog.Logger {
+	if l := structured.Load(); l != nil {
+		return l
+	}
+
+	return slog.New(&StdHandler{level: level, legacy: true})
+}
+
+// SetLevel sets the minimum level of the messages written to Logger (StdLogger).
+// It has no effect on a custom structured logger defined with SetDefault.
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+// ParseLevel parses a level name: debug, info, warn (or warning), error.
+func ParseLevel(s string) (slog.Level, error) {
+	var l slog.Level
+
+	if strings.EqualFold(s, "warning") {
+		s = "warn"
+	}
+
+	err := l.UnmarshalText([]byte(s))
+	if err != nil {
+		return l, fmt.Errorf("invalid log level %q: %w", s, err)
+	}
+
+	return l, nil
+}
+
+// Debug writes a log entry at the debug level.
+func Debug(msg string, args ...any) {
+	Default().Debug(msg, args...)
+}
+
+// Info writes a log entry at the info level.
+func Info(msg string, args ...any) {
+	Default().Info(msg, args...)
+}
+
+// Warn writes a log entry at the warn level.
+func Warn(msg string, args ...any) {
+	Default().Warn(msg, args...)
+}
+
+// Error writes a log entry at the error level.
+func Error(msg string, args ...any) {
+	Default().Error(msg, args...)
+}
+
+// Debugf writes a log entry at the debug level.
+func Debugf(format string, args ...interface{}) {
+	Debug(fmt.Sprintf(format, args...))
+}
+
+// Domain creates the attribute of a domain.
+func Domain(domain string) slog.Attr {
+	return slog.String(KeyDomain, domain)
+}
+
+// OrderURL creates the attribute of an order URL.
+func OrderURL(orderURL string) slog.Attr {
+	return slog.String(KeyOrderURL, orderURL)
+}
+
+// AuthzURL creates the attribute of an authorization URL.
+func AuthzURL(authzURL string) slog.Attr {
+	return slog.String(KeyAuthzURL, authzURL)
+}
+
+// ChallengeType creates the attribute of a challenge type.
+func ChallengeType(chlgType string) slog.Attr {
+	return slog.String(KeyChallengeType, chlgType)
+}
+
+// StdHandler is a slog.Handler that writes the log entries to a StdLogger,
+// using the historical lego format:
+//
+//	[INFO] [example.com] acme: message key=value
+//
+// The domain attribute is used as a prefix, the other attributes are appended to the message.
+type StdHandler struct {
+	logger StdLogger
+	level  slog.Leveler
+	attrs  []slog.Attr
+	group  string
+
+	// legacy drops the attributes other than the domain,
+	// to keep the format of the messages written by default (without SetDefault).
+	legacy bool
+}
+
+// NewStdHandler creates a new StdHandler.
+// If logger is nil, the package Logger is used (evaluated for each log entry).
+// If lvl is nil, the minimum level is info.
+func NewStdHandler(logger StdLogger, lvl slog.Leveler) *StdHandler {
+	if lvl == nil {
+		lvl = slog.LevelInfo
+	}
+
+	return &StdHandler{logger: logger, level: lvl}
+}
+
+// Enabled implements slog.Handler.
+func (h *StdHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= h.level.Level()
+}
+
+// Handle implements slog.Handler.
+func (h *StdHandler) Handle(_ context.Context, record slog.Record) error {
+	var domain string
+	var suffix strings.Builder
+
+	appendAttr := func(a slog.Attr) bool {
+		a.Value = a.Value.Resolve()
+
+		if a.Key == KeyDomain && domain == "" {
+			domain = a.Value.String()
+			return true
+		}
+
+		if h.legacy || a.Equal(slog.Attr{}) {
+			return true
+		}
+
+		_, _ = fmt.Fprintf(&suffix, " %s=%s", a.Key, formatValue(a.Value))
+
+		return true
+	}
+
+	for _, a := range h.attrs {
+		appendAttr(a)
+	}
+
+	record.Attrs(func(a slog.Attr) bool {
+		if h.group != "" {
+			a.Key = h.group + "." + a.Key
+		}
+
+		return appendAttr(a)
+	})
+
+	var b strings.Builder
+	b.WriteString("[" + levelName(record.Level) + "] ")
+
+	if domain != "" {
+		b.WriteString("[" + domain + "] ")
+	}
+
+	b.WriteString(record.Message)
+	b.WriteString(suffix.String())
+
+	logger := h.logger
+	if logger == nil {
+		logger = Logger
+	}
+
+	logger.Printf("%s", b.String())
+
+	return nil
+}
+
+// WithAttrs implements slog.Handler.
+func (h *StdHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	h2.attrs = slices.Clone(h.attrs)
+
+	for _, a := range attrs {
+		if h.group != "" {
+			a.Key = h.group + "." + a.Key
+		}
+
+		h2.attrs = append(h2.attrs, a)
+	}
+
+	return &h2
+}
+
+// WithGroup implements slog.Handler.
+func (h *StdHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	if h.group != "" {
+		h2.group = h.group + "." + name
+	} else {
+		h2.group = name
+	}
+
+	return &h2
+}
+
+func levelName(l slog.Level) string {
+	switch {
+	case l < slog.LevelInfo:
+		return "DEBUG"
+	case l < slog.LevelWarn:
+		return "INFO"
+	case l < slog.LevelError:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+func formatValue(v slog.Value) string {
+	s := v.String()
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return s
+}
+
+// fatal writes a log entry at the error level and exits
+// when a custom structured logger is defined.
+// It returns false when the StdLogger must be used.
+func fatal(msg string) bool {
+	l := structured.Load()
+	if l == nil {
+		return false
+	}
+
+	l.Error(msg)
+	os.Exit(1)
+
+	return true
+}
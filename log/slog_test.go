@@ -0,0 +1,168 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	stdlog "log"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdHandler(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		log      func(l *slog.Logger)
+		expected string
+	}{
+		{
+			desc:     "message only",
+			log:      func(l *slog.Logger) { l.Info("acme: Obtaining SAN certificate") },
+			expected: "[INFO] acme: Obtaining SAN certificate\n",
+		},
+		{
+			desc:     "domain",
+			log:      func(l *slog.Logger) { l.Warn("acme: cleaning up failed", Domain("example.com")) },
+			expected: "[WARN] [example.com] acme: cleaning up failed\n",
+		},
+		{
+			desc: "attributes",
+			log: func(l *slog.Logger) {
+				l.Info("acme: use solver", Domain("example.com"), ChallengeType("http-01"), AuthzURL("https://example.com/authz/1"))
+			},
+			expected: "[INFO] [example.com] acme: use solver challenge=http-01 authz=https://example.com/authz/1\n",
+		},
+		{
+			desc:     "quoted value",
+			log:      func(l *slog.Logger) { l.Error("failure", "reason", "not found") },
+			expected: "[ERROR] failure reason=\"not found\"\n",
+		},
+		{
+			desc:     "with attributes",
+			log:      func(l *slog.Logger) { l.With(Domain("example.com")).WithGroup("g").Info("message", "a", 1) },
+			expected: "[INFO] [example.com] message g.a=1\n",
+		},
+		{
+			desc:     "debug filtered",
+			log:      func(l *slog.Logger) { l.Debug("message") },
+			expected: "",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+
+			test.log(slog.New(NewStdHandler(stdlog.New(buf, "", 0), nil)))
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestInfof_structured(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	SetDefault(slog.New(slog.NewJSONHandler(buf, nil)))
+	t.Cleanup(func() { SetDefault(nil) })
+
+	Infof("acme: %s", "message")
+
+	var entry map[string]any
+	err := json.Unmarshal(buf.Bytes(), &entry)
+	require.NoError(t, err)
+
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, "acme: message", entry["msg"])
+}
+
+func TestInfof_stdLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	backupLogger := Logger
+	Logger = stdlog.New(buf, "", 0)
+	t.Cleanup(func() { Logger = backupLogger })
+
+	Infof("acme: %s", "message")
+	Debugf("hidden")
+	Warnf("warning")
+
+	assert.Equal(t, "[INFO] acme: message\n[WARN] warning\n", buf.String())
+}
+
+func TestInfo_stdLogger_legacyFormat(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	backupLogger := Logger
+	Logger = stdlog.New(buf, "", 0)
+	t.Cleanup(func() { Logger = backupLogger })
+
+	Info("acme: use solver", Domain("example.com"), ChallengeType("http-01"), AuthzURL("https://example.com/authz/1"))
+
+	assert.Equal(t, "[INFO] [example.com] acme: use solver\n", buf.String())
+}
+
+func TestPrintf_stdLogger_level(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	backupLogger := Logger
+	Logger = stdlog.New(buf, "", 0)
+	t.Cleanup(func() { Logger = backupLogger })
+
+	SetLevel(slog.LevelError)
+	t.Cleanup(func() { SetLevel(slog.LevelInfo) })
+
+	Print("hidden")
+	Println("hidden")
+	Printf("%s", "hidden")
+	Warnf("hidden")
+	Error("visible")
+
+	assert.Equal(t, "[ERROR] visible\n", buf.String())
+}
+
+func TestPrintln_structured(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	SetDefault(slog.New(slog.NewJSONHandler(buf, nil)))
+	t.Cleanup(func() { SetDefault(nil) })
+
+	Println("acme:", 1, 2)
+
+	var entry map[string]any
+	err := json.Unmarshal(buf.Bytes(), &entry)
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme: 1 2", entry["msg"])
+}
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected slog.Level
+	}{
+		{input: "debug", expected: slog.LevelDebug},
+		{input: "INFO", expected: slog.LevelInfo},
+		{input: "warn", expected: slog.LevelWarn},
+		{input: "warning", expected: slog.LevelWarn},
+		{input: "error", expected: slog.LevelError},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			level, err := ParseLevel(test.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, level)
+		})
+	}
+
+	_, err := ParseLevel("verbose")
+	require.Error(t, err)
+}
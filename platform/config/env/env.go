@@ -11,13 +11,39 @@ import (
 	"github.com/go-acme/lego/v4/log"
 )
 
+// Source reads configuration values by name.
+// The environment variables are the default source, but the values can also come from a map (i.e. a configuration file).
+type Source struct {
+	lookup func(name string) string
+}
+
+var environment = &Source{lookup: os.Getenv}
+
+// Environment returns the Source of the environment variables.
+func Environment() *Source {
+	return environment
+}
+
+// Values returns a Source of the given values.
+// The environment variables are not used: a missing value is empty.
+// The `_FILE` suffix is supported as for the environment variables (i.e. "CF_DNS_API_TOKEN_FILE").
+func Values(values map[string]string) *Source {
+	return &Source{lookup: func(name string) string { return values[name] }}
+}
+
 // Get environment variables.
 func Get(names ...string) (map[string]string, error) {
+	return environment.Get(names...)
+}
+
+// Get gets the values of the names.
+// An error is returned when a value is missing.
+func (s *Source) Get(names ...string) (map[string]string, error) {
 	values := map[string]string{}
 
 	var missingEnvVars []string
 	for _, envVar := range names {
-		value := GetOrFile(envVar)
+		value := s.GetOrFile(envVar)
 		if value == "" {
 			missingEnvVars = append(missingEnvVars, envVar)
 		}
@@ -55,6 +81,11 @@ func Get(names ...string) (map[string]string, error) {
 //	env.GetWithFallback([]string{"LEGO_ONE", "LEGO_TWO"})
 //	// => error
 func GetWithFallback(groups ...[]string) (map[string]string, error) {
+	return environment.GetWithFallback(groups...)
+}
+
+// GetWithFallback gets the values of groups of names (see the GetWithFallback function).
+func (s *Source) GetWithFallback(groups ...[]string) (map[string]string, error) {
 	values := map[string]string{}
 
 	var missingEnvVars []string
@@ -63,7 +94,7 @@ func GetWithFallback(groups ...[]string) (map[string]string, error) {
 			return nil, errors.New("undefined environment variable names")
 		}
 
-		value, envVar := getOneWithFallback(names[0], names[1:]...)
+		value, envVar := s.getOneWithFallback(names[0], names[1:]...)
 		if value == "" {
 			missingEnvVars = append(missingEnvVars, envVar)
 			continue
@@ -79,7 +110,12 @@ func GetWithFallback(groups ...[]string) (map[string]string, error) {
 }
 
 func GetOneWithFallback[T any](main string, defaultValue T, fn func(string) (T, error), names ...string) T {
-	v, _ := getOneWithFallback(main, names...)
+	return GetOneWithFallbackFrom(environment, main, defaultValue, fn, names...)
+}
+
+// GetOneWithFallbackFrom is GetOneWithFallback with the values of a Source.
+func GetOneWithFallbackFrom[T any](s *Source, main string, defaultValue T, fn func(string) (T, error), names ...string) T {
+	v, _ := s.getOneWithFallback(main, names...)
 
 	value, err := fn(v)
 	if err != nil {
@@ -89,14 +125,14 @@ func GetOneWithFallback[T any](main string, defaultValue T, fn func(string) (T,
 	return value
 }
 
-func getOneWithFallback(main string, names ...string) (string, string) {
-	value := GetOrFile(main)
+func (s *Source) getOneWithFallback(main string, names ...string) (string, string) {
+	value := s.GetOrFile(main)
 	if value != "" {
 		return value, main
 	}
 
 	for _, name := range names {
-		value := GetOrFile(name)
+		value := s.GetOrFile(name)
 		if value != "" {
 			return value, main
 		}
@@ -108,29 +144,53 @@ func getOneWithFallback(main string, names ...string) (string, string) {
 // GetOrDefaultString returns the given environment variable value as a string.
 // Returns the default if the env var cannot be found.
 func GetOrDefaultString(envVar string, defaultValue string) string {
-	return getOrDefault(envVar, defaultValue, ParseString)
+	return environment.GetOrDefaultString(envVar, defaultValue)
+}
+
+// GetOrDefaultString returns the value as a string.
+// Returns the default if the value cannot be found.
+func (s *Source) GetOrDefaultString(name string, defaultValue string) string {
+	return getOrDefault(s, name, defaultValue, ParseString)
 }
 
 // GetOrDefaultBool returns the given environment variable value as a boolean.
 // Returns the default if the env var cannot be coopered to a boolean, or is not found.
 func GetOrDefaultBool(envVar string, defaultValue bool) bool {
-	return getOrDefault(envVar, defaultValue, strconv.ParseBool)
+	return environment.GetOrDefaultBool(envVar, defaultValue)
+}
+
+// GetOrDefaultBool returns the value as a boolean.
+// Returns the default if the value cannot be coopered to a boolean, or is not found.
+func (s *Source) GetOrDefaultBool(name string, defaultValue bool) bool {
+	return getOrDefault(s, name, defaultValue, strconv.ParseBool)
 }
 
 // GetOrDefaultInt returns the given environment variable value as an integer.
 // Returns the default if the env var cannot be coopered to an int, or is not found.
 func GetOrDefaultInt(envVar string, defaultValue int) int {
-	return getOrDefault(envVar, defaultValue, strconv.Atoi)
+	return environment.GetOrDefaultInt(envVar, defaultValue)
+}
+
+// GetOrDefaultInt returns the value as an integer.
+// Returns the default if the value cannot be coopered to an int, or is not found.
+func (s *Source) GetOrDefaultInt(name string, defaultValue int) int {
+	return getOrDefault(s, name, defaultValue, strconv.Atoi)
 }
 
 // GetOrDefaultSecond returns the given environment variable value as a time.Duration (second).
 // Returns the default if the env var cannot be coopered to an int, or is not found.
 func GetOrDefaultSecond(envVar string, defaultValue time.Duration) time.Duration {
-	return getOrDefault(envVar, defaultValue, ParseSecond)
+	return environment.GetOrDefaultSecond(envVar, defaultValue)
 }
 
-func getOrDefault[T any](envVar string, defaultValue T, fn func(string) (T, error)) T {
-	v, err := fn(GetOrFile(envVar))
+// GetOrDefaultSecond returns the value as a time.Duration (second).
+// Returns the default if the value cannot be coopered to an int, or is not found.
+func (s *Source) GetOrDefaultSecond(name string, defaultValue time.Duration) time.Duration {
+	return getOrDefault(s, name, defaultValue, ParseSecond)
+}
+
+func getOrDefault[T any](s *Source, envVar string, defaultValue T, fn func(string) (T, error)) T {
+	v, err := fn(s.GetOrFile(envVar))
 	if err != nil {
 		return defaultValue
 	}
@@ -142,15 +202,22 @@ func getOrDefault[T any](envVar string, defaultValue T, fn func(string) (T, erro
 // Failing that, it will check to see if '<key>_FILE' exists.
 // If so, it will attempt to read from the referenced file to populate a value.
 func GetOrFile(envVar string) string {
-	envVarValue := os.Getenv(envVar)
-	if envVarValue != "" {
-		return envVarValue
+	return environment.GetOrFile(envVar)
+}
+
+// GetOrFile resolves the value of the name.
+// Failing that, it will check to see if '<name>_FILE' exists.
+// If so, it will attempt to read from the referenced file to populate a value.
+func (s *Source) GetOrFile(name string) string {
+	value := s.lookup(name)
+	if value != "" {
+		return value
 	}
 
-	fileVar := envVar + "_FILE"
-	fileVarValue := os.Getenv(fileVar)
+	fileVar := name + "_FILE"
+	fileVarValue := s.lookup(fileVar)
 	if fileVarValue == "" {
-		return envVarValue
+		return value
 	}
 
 	fileContents, err := os.ReadFile(fileVarValue)
@@ -2,6 +2,7 @@ package env
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -408,3 +409,51 @@ func TestGetOrFile_PrefersEnvVars(t *testing.T) {
 
 	assert.Equal(t, "lego_env", value)
 }
+
+func TestValues(t *testing.T) {
+	t.Setenv("TEST_LEGO_ENV_VAR_ONE", "env_one")
+	t.Setenv("TEST_LEGO_ENV_VAR_TWO", "env_two")
+
+	src := Values(map[string]string{
+		"TEST_LEGO_ENV_VAR_ONE":  "value_one",
+		"TEST_LEGO_ENV_VAR_INT":  "42",
+		"TEST_LEGO_ENV_VAR_BOOL": "true",
+	})
+
+	assert.Equal(t, "value_one", src.GetOrFile("TEST_LEGO_ENV_VAR_ONE"))
+	assert.Empty(t, src.GetOrFile("TEST_LEGO_ENV_VAR_TWO"))
+	assert.Equal(t, 42, src.GetOrDefaultInt("TEST_LEGO_ENV_VAR_INT", 1))
+	assert.True(t, src.GetOrDefaultBool("TEST_LEGO_ENV_VAR_BOOL", false))
+	assert.Equal(t, "default", src.GetOrDefaultString("TEST_LEGO_ENV_VAR_TWO", "default"))
+
+	_, err := src.Get("TEST_LEGO_ENV_VAR_ONE", "TEST_LEGO_ENV_VAR_TWO")
+	require.EqualError(t, err, "some credentials information are missing: TEST_LEGO_ENV_VAR_TWO")
+
+	assert.Equal(t, "env_one", GetOrFile("TEST_LEGO_ENV_VAR_ONE"))
+	assert.Equal(t, "env_one", Environment().GetOrFile("TEST_LEGO_ENV_VAR_ONE"))
+}
+
+func TestValues_file(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "lego")
+
+	err := os.WriteFile(file, []byte("lego_file\n"), 0o644)
+	require.NoError(t, err)
+
+	t.Setenv("TEST_LEGO_ENV_VAR_FILE", "/nonexistent")
+
+	src := Values(map[string]string{
+		"TEST_LEGO_ENV_VAR_FILE": file,
+	})
+
+	assert.Equal(t, "lego_file", src.GetOrFile("TEST_LEGO_ENV_VAR"))
+	assert.Empty(t, Values(nil).GetOrFile("TEST_LEGO_ENV_VAR"))
+}
+
+func TestGetOneWithFallbackFrom(t *testing.T) {
+	t.Setenv("TEST_LEGO_ENV_VAR_ONE", "env_one")
+
+	src := Values(map[string]string{"TEST_LEGO_ENV_VAR_TWO": "value_two"})
+
+	value := GetOneWithFallbackFrom(src, "TEST_LEGO_ENV_VAR_ONE", "default", ParseString, "TEST_LEGO_ENV_VAR_TWO")
+	assert.Equal(t, "value_two", value)
+}
@@ -0,0 +1,474 @@
+package tester
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	jose "github.com/go-jose/go-jose/v4"
+)
+
+// Endpoints of the ACMEServer, used to target the injected faults.
+const (
+	EndpointAny         = ""
+	EndpointDirectory   = "directory"
+	EndpointNewNonce    = "newNonce"
+	EndpointNewAccount  = "newAccount"
+	EndpointAccount     = "account"
+	EndpointNewOrder    = "newOrder"
+	EndpointOrder       = "order"
+	EndpointAuthz       = "authz"
+	EndpointChallenge   = "challenge"
+	EndpointFinalize    = "finalize"
+	EndpointCertificate = "certificate"
+	EndpointRevokeCert  = "revokeCert"
+	EndpointRenewalInfo = "renewalInfo"
+)
+
+// Faults that can be injected into the ACMEServer responses.
+// The values are the ACME error types (without the "urn:ietf:params:acme:error:" prefix).
+const (
+	FaultBadNonce       = "badNonce"
+	FaultRateLimited    = "rateLimited"
+	FaultServerInternal = "serverInternal"
+	FaultUnauthorized   = "unauthorized"
+)
+
+const errNS = "urn:ietf:params:acme:error:"
+
+// ACMEServerOption configures an ACMEServer.
+type ACMEServerOption func(*ACMEServer)
+
+// WithHTTP01Port defines the port used to validate the http-01 challenges (default: 80).
+func WithHTTP01Port(port int) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.http01Port = port
+	}
+}
+
+// WithTLSALPN01Port defines the port used to validate the tls-alpn-01 challenges (default: 443).
+func WithTLSALPN01Port(port int) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.tlsALPN01Port = port
+	}
+}
+
+// WithDNSServer defines the DNS server (host:port) used to validate the dns-01 challenges.
+// By default, the system resolver is used.
+func WithDNSServer(addr string) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.dnsServer = addr
+	}
+}
+
+// WithValidationHost defines the host used to reach the http-01 and tls-alpn-01 challenge servers
+// for the DNS identifiers (default: 127.0.0.1).
+// The IP identifiers are always reached directly.
+func WithValidationHost(host string) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.validationHost = host
+	}
+}
+
+// WithProcessingDelay makes the validations and the finalizations asynchronous:
+// the challenges and the orders stay in the "processing" state during the delay.
+func WithProcessingDelay(delay time.Duration) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.processingDelay = delay
+	}
+}
+
+// WithAlternateChains adds alternate certificate chains (`Link: <...>;rel="alternate"`).
+// Each alternate chain is issued by a root named "Tester Alternate Root CA <n>".
+func WithAlternateChains(count int) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.alternateChains = count
+	}
+}
+
+// WithExternalAccountBinding makes the External Account Binding mandatory,
+// kid and hmacKey are the only accepted EAB credentials.
+func WithExternalAccountBinding(kid string, hmacKey []byte) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.eabKID = kid
+		s.eabHMAC = hmacKey
+	}
+}
+
+// WithCertificateLifetime defines the lifetime of the certificates (default: 90 days).
+func WithCertificateLifetime(lifetime time.Duration) ACMEServerOption {
+	return func(s *ACMEServer) {
+		s.lifetime = lifetime
+	}
+}
+
+// ACMEServer is an in-memory ACME server (RFC 8555) for tests.
+//
+// It supports accounts (including EAB), orders, authorizations,
+// http-01, dns-01, and tls-alpn-01 validations (against local listeners),
+// finalization, alternate chains, ARI (RFC 9773), and revocation.
+// Faults (badNonce, rateLimited, etc.) can be injected with InjectFault.
+type ACMEServer struct {
+	server *httptest.Server
+	pki    *caPKI
+
+	http01Port      int
+	tlsALPN01Port   int
+	dnsServer       string
+	validationHost  string
+	processingDelay time.Duration
+	alternateChains int
+	eabKID          string
+	eabHMAC         []byte
+	lifetime        time.Duration
+
+	mu       sync.Mutex
+	nonces   map[string]struct{}
+	faults   []*fault
+	accounts map[string]*caAccount
+	orders   map[string]*caOrder
+	authzs   map[string]*caAuthz
+	chlgs    map[string]*caChallenge
+	certs    map[string]*caCertificate
+	counter  int
+}
+
+type fault struct {
+	endpoint string
+	errType  string
+	count    int
+}
+
+// NewACMEServer starts an in-memory ACME server.
+// The server is stopped at the end of the test.
+func NewACMEServer(t *testing.T, opts ...ACMEServerOption) *ACMEServer {
+	t.Helper()
+
+	s := &ACMEServer{
+		http01Port:     80,
+		tlsALPN01Port:  443,
+		validationHost: "127.0.0.1",
+		lifetime:       90 * 24 * time.Hour,
+		nonces:         make(map[string]struct{}),
+		accounts:       make(map[string]*caAccount),
+		orders:         make(map[string]*caOrder),
+		authzs:         make(map[string]*caAuthz),
+		chlgs:          make(map[string]*caChallenge),
+		certs:          make(map[string]*caCertificate),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	pki, err := newCAPKI(s.alternateChains)
+	if err != nil {
+		t.Fatalf("ACME server: create PKI: %v", err)
+	}
+
+	s.pki = pki
+
+	mux := http.NewServeMux()
+	s.route(mux)
+
+	s.server = httptest.NewServer(mux)
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the URL of the directory.
+func (s *ACMEServer) URL() string {
+	return s.server.URL + "/dir"
+}
+
+// Roots returns the root certificates: the first one is the default root,
+// the others are the roots of the alternate chains.
+func (s *ACMEServer) Roots() []*x509.Certificate {
+	var roots []*x509.Certificate
+	for _, chain := range s.pki.chains {
+		roots = append(roots, chain.root.cert)
+	}
+
+	return roots
+}
+
+// InjectFault makes the next count requests to the endpoint fail with the errType ACME error.
+// An empty endpoint (EndpointAny) targets all the endpoints.
+// FaultBadNonce only affects the POST requests.
+func (s *ACMEServer) InjectFault(endpoint, errType string, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault{endpoint: endpoint, errType: errType, count: count})
+}
+
+// IsRevoked reports whether the certificate has been revoked.
+func (s *ACMEServer) IsRevoked(cert *x509.Certificate) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.certs[ariCertID(cert)]
+
+	return ok && c.revoked
+}
+
+// SetRenewalWindow overrides the ARI suggested window of a certificate.
+func (s *ACMEServer) SetRenewalWindow(cert *x509.Certificate, window acme.Window) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.certs[ariCertID(cert)]; ok {
+		c.window = &window
+	}
+}
+
+func (s *ACMEServer) route(mux *http.ServeMux) {
+	s.handle(mux, "GET /dir", EndpointDirectory, s.handleDirectory)
+	s.handle(mux, "GET /nonce", EndpointNewNonce, s.handleNonce)
+	s.handle(mux, "POST /new-account", EndpointNewAccount, s.handleNewAccount)
+	s.handle(mux, "POST /account/{id}", EndpointAccount, s.handleAccount)
+	s.handle(mux, "POST /account/{id}/orders", EndpointAccount, s.handleAccountOrders)
+	s.handle(mux, "POST /new-order", EndpointNewOrder, s.handleNewOrder)
+	s.handle(mux, "POST /order/{id}", EndpointOrder, s.handleOrder)
+	s.handle(mux, "POST /order/{id}/finalize", EndpointFinalize, s.handleFinalize)
+	s.handle(mux, "POST /authz/{id}", EndpointAuthz, s.handleAuthz)
+	s.handle(mux, "POST /chall/{id}", EndpointChallenge, s.handleChallenge)
+	s.handle(mux, "POST /cert/{id}", EndpointCertificate, s.handleCertificate)
+	s.handle(mux, "POST /cert/{id}/{chain}", EndpointCertificate, s.handleCertificate)
+	s.handle(mux, "POST /revoke-cert", EndpointRevokeCert, s.handleRevokeCert)
+	s.handle(mux, "GET /renewal-info/{id}", EndpointRenewalInfo, s.handleRenewalInfo)
+}
+
+// handle adds the common headers (Replay-Nonce, Link index) and injects the faults.
+func (s *ACMEServer) handle(mux *http.ServeMux, pattern, endpoint string, handler http.HandlerFunc) {
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Cache-Control", "no-store")
+
+		if endpoint != EndpointDirectory {
+			rw.Header().Set("Replay-Nonce", s.newNonce())
+			rw.Header().Add("Link", link(s.URL(), "index"))
+		}
+
+		if p := s.takeFault(endpoint, req.Method); p != nil {
+			writeProblem(rw, p)
+			return
+		}
+
+		handler(rw, req)
+	})
+}
+
+func (s *ACMEServer) takeFault(endpoint, method string) *acme.ProblemDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.endpoint != EndpointAny && f.endpoint != endpoint {
+			continue
+		}
+
+		if f.errType == FaultBadNonce && method != http.MethodPost {
+			continue
+		}
+
+		f.count--
+		if f.count <= 0 {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+
+		return newProblem(f.errType, "injected fault")
+	}
+
+	return nil
+}
+
+func (s *ACMEServer) newNonce() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nonce := randomToken()
+	s.nonces[nonce] = struct{}{}
+
+	return nonce
+}
+
+// useNonce consumes a nonce.
+func (s *ACMEServer) useNonce(nonce string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.nonces[nonce]
+	delete(s.nonces, nonce)
+
+	return ok
+}
+
+// nextID returns a new object identifier (must be called with the lock held).
+func (s *ACMEServer) nextID() string {
+	s.counter++
+	return strconv.Itoa(s.counter)
+}
+
+func (s *ACMEServer) url(format string, args ...any) string {
+	return s.server.URL + fmt.Sprintf(format, args...)
+}
+
+// jwsRequest is a verified JWS request.
+type jwsRequest struct {
+	payload []byte
+	// account is defined when the JWS uses a "kid".
+	account *caAccount
+	// jwk is defined when the JWS uses an embedded "jwk".
+	jwk *jose.JSONWebKey
+}
+
+// isPostAsGet reports whether the request is a POST-as-GET request.
+func (r *jwsRequest) isPostAsGet() bool {
+	return len(r.payload) == 0
+}
+
+// verifyJWS verifies a request signed with a JWS.
+// A JWK is only allowed when allowJWK is true, otherwise the "kid" must reference a valid account.
+func (s *ACMEServer) verifyJWS(req *http.Request, allowJWK bool) (*jwsRequest, *acme.ProblemDetails) {
+	if ct := req.Header.Get("Content-Type"); ct != "application/jose+json" {
+		return nil, newProblem("malformed", "invalid Content-Type: "+ct)
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, req.Body, 1024*1024))
+	if err != nil {
+		return nil, newProblem("malformed", err.Error())
+	}
+
+	sig, err := jose.ParseSigned(string(body), []jose.SignatureAlgorithm{jose.RS256, jose.ES256, jose.ES384, jose.ES512})
+	if err != nil {
+		return nil, newProblem("malformed", "invalid JWS: "+err.Error())
+	}
+
+	if len(sig.Signatures) != 1 {
+		return nil, newProblem("malformed", "JWS must have exactly one signature")
+	}
+
+	header := sig.Signatures[0].Protected
+
+	if !s.useNonce(header.Nonce) {
+		return nil, newProblem(FaultBadNonce, "invalid nonce")
+	}
+
+	if u, _ := header.ExtraHeaders["url"].(string); u != s.server.URL+req.URL.Path {
+		return nil, newProblem(FaultUnauthorized, fmt.Sprintf("invalid url %q", u))
+	}
+
+	result := &jwsRequest{}
+
+	switch {
+	case header.JSONWebKey != nil && header.KeyID == "":
+		if !allowJWK {
+			return nil, newProblem("malformed", "jwk is not allowed on this endpoint")
+		}
+
+		result.jwk = header.JSONWebKey
+		result.payload, err = sig.Verify(header.JSONWebKey)
+
+	case header.JSONWebKey == nil && header.KeyID != "":
+		account, p := s.getAccountByURL(header.KeyID)
+		if p != nil {
+			return nil, p
+		}
+
+		result.account = account
+		result.payload, err = sig.Verify(account.key)
+
+	default:
+		return nil, newProblem("malformed", "JWS must have either a jwk or a kid")
+	}
+
+	if err != nil {
+		return nil, newProblem("malformed", "invalid JWS signature: "+err.Error())
+	}
+
+	return result, nil
+}
+
+// getAccountByURL returns the valid account identified by the URL.
+func (s *ACMEServer) getAccountByURL(accountURL string) (*caAccount, *acme.ProblemDetails) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, account := range s.accounts {
+		if account.url != accountURL {
+			continue
+		}
+
+		if account.status != acme.StatusValid {
+			return nil, newProblem(FaultUnauthorized, "account is "+account.status)
+		}
+
+		return account, nil
+	}
+
+	return nil, newProblem("accountDoesNotExist", "unknown account "+accountURL)
+}
+
+func newProblem(errType, detail string) *acme.ProblemDetails {
+	status := http.StatusBadRequest
+
+	switch errType {
+	case FaultRateLimited:
+		status = http.StatusTooManyRequests
+	case FaultServerInternal:
+		status = http.StatusInternalServerError
+	case FaultUnauthorized, "orderNotReady":
+		status = http.StatusForbidden
+	}
+
+	return &acme.ProblemDetails{Type: errNS + errType, Detail: detail, HTTPStatus: status}
+}
+
+func writeProblem(rw http.ResponseWriter, p *acme.ProblemDetails) {
+	if p.Type == errNS+FaultRateLimited {
+		rw.Header().Set("Retry-After", "1")
+	}
+
+	rw.Header().Set("Content-Type", "application/problem+json")
+	rw.WriteHeader(p.HTTPStatus)
+
+	_ = json.NewEncoder(rw).Encode(p)
+}
+
+func writeJSON(rw http.ResponseWriter, status int, body any) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+
+	_ = json.NewEncoder(rw).Encode(body)
+}
+
+func link(u, rel string) string {
+	return fmt.Sprintf("<%s>;rel=%q", u, rel)
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodePayload decodes the JSON payload of a JWS request.
+func decodePayload(r *jwsRequest, v any) *acme.ProblemDetails {
+	if err := json.Unmarshal(r.payload, v); err != nil {
+		return newProblem("malformed", "invalid payload: "+err.Error())
+	}
+
+	return nil
+}
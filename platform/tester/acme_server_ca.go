@@ -0,0 +1,186 @@
+package tester
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// oidTLSFeature is the OID of the TLS Feature extension (OCSP Must-Staple).
+// https://www.rfc-editor.org/rfc/rfc7633.html#section-6
+var oidTLSFeature = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}
+
+// caIssuer is a certificate authority: a certificate and its private key.
+type caIssuer struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+// caChain is a certificate chain used to issue certificates.
+// The intermediate is shared by all the chains, only its issuer (the root) changes.
+type caChain struct {
+	root         *caIssuer
+	intermediate *x509.Certificate
+}
+
+// caPKI contains the roots and the intermediate used to issue the certificates.
+type caPKI struct {
+	intermediate *caIssuer
+	chains       []caChain
+}
+
+// newCAPKI creates a PKI with a root, an intermediate,
+// and alternates roots that cross-sign the intermediate.
+func newCAPKI(alternates int) (*caPKI, error) {
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	intermediateTmpl := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "Tester Intermediate CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(5, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+		SubjectKeyId:          subjectKeyID(intermediateKey.Public()),
+	}
+
+	pki := &caPKI{}
+
+	for i := range alternates + 1 {
+		name := "Tester Root CA"
+		if i > 0 {
+			name = fmt.Sprintf("Tester Alternate Root CA %d", i)
+		}
+
+		root, errR := newRootCA(name)
+		if errR != nil {
+			return nil, errR
+		}
+
+		intermediate, errR := signCertificate(intermediateTmpl, root, intermediateKey.Public())
+		if errR != nil {
+			return nil, errR
+		}
+
+		if pki.intermediate == nil {
+			pki.intermediate = &caIssuer{cert: intermediate, key: intermediateKey}
+		}
+
+		pki.chains = append(pki.chains, caChain{root: root, intermediate: intermediate})
+	}
+
+	return pki, nil
+}
+
+func newRootCA(name string) (*caIssuer, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          subjectKeyID(key.Public()),
+	}
+
+	cert, err := signCertificate(tmpl, &caIssuer{cert: tmpl, key: key}, key.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &caIssuer{cert: cert, key: key}, nil
+}
+
+// issue creates a leaf certificate for the CSR.
+func (p *caPKI) issue(csr *x509.CertificateRequest, notBefore, notAfter time.Time) (*x509.Certificate, error) {
+	tmpl := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: csr.Subject.CommonName},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		DNSNames:    csr.DNSNames,
+		IPAddresses: csr.IPAddresses,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	if _, ok := csr.PublicKey.(*rsa.PublicKey); ok {
+		tmpl.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	for _, ext := range csr.Extensions {
+		if ext.Id.Equal(oidTLSFeature) {
+			tmpl.ExtraExtensions = append(tmpl.ExtraExtensions, ext)
+		}
+	}
+
+	return signCertificate(tmpl, p.intermediate, csr.PublicKey)
+}
+
+// chainPEM returns the PEM encoded chain (leaf and intermediate) for the chain at index i.
+func (p *caPKI) chainPEM(leaf *x509.Certificate, i int) []byte {
+	buf := &bytes.Buffer{}
+
+	_ = pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})
+	_ = pem.Encode(buf, &pem.Block{Type: "CERTIFICATE", Bytes: p.chains[i].intermediate.Raw})
+
+	return buf.Bytes()
+}
+
+func signCertificate(tmpl *x509.Certificate, issuer *caIssuer, pub crypto.PublicKey) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl.SerialNumber = serial
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, issuer.cert, pub, issuer.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+func subjectKeyID(pub crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil
+	}
+
+	sum := sha1.Sum(der)
+
+	return sum[:]
+}
+
+// ariCertID computes the ARI certificate identifier: base64url(AKI) || '.' || base64url(Serial).
+// https://www.rfc-editor.org/rfc/rfc9773.html#section-4.1
+func ariCertID(cert *x509.Certificate) string {
+	der, err := asn1.Marshal(cert.SerialNumber)
+	if err != nil || len(der) < 3 {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + "." + base64.RawURLEncoding.EncodeToString(der[2:])
+}
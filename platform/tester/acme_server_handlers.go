@@ -0,0 +1,1005 @@
+package tester
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	jose "github.com/go-jose/go-jose/v4"
+)
+
+// Challenge types.
+const (
+	challengeHTTP01    = "http-01"
+	challengeDNS01     = "dns-01"
+	challengeTLSALPN01 = "tls-alpn-01"
+)
+
+type caAccount struct {
+	id         string
+	url        string
+	key        *jose.JSONWebKey
+	thumbprint string
+	status     string
+	contact    []string
+	orders     []string
+}
+
+type caOrder struct {
+	id          string
+	url         string
+	accountID   string
+	status      string
+	expires     time.Time
+	identifiers []acme.Identifier
+	authzs      []*caAuthz
+	notBefore   time.Time
+	notAfter    time.Time
+	replaces    string
+	cert        *caCertificate
+	err         *acme.ProblemDetails
+}
+
+type caAuthz struct {
+	id         string
+	url        string
+	accountID  string
+	identifier acme.Identifier
+	wildcard   bool
+	status     string
+	expires    time.Time
+	challenges []*caChallenge
+}
+
+type caChallenge struct {
+	id        string
+	url       string
+	authz     *caAuthz
+	typ       string
+	token     string
+	status    string
+	validated time.Time
+	err       *acme.ProblemDetails
+}
+
+type caCertificate struct {
+	id         string
+	url        string
+	accountID  string
+	leaf       *x509.Certificate
+	revoked    bool
+	replacedBy string
+	window     *acme.Window
+}
+
+func (s *ACMEServer) handleDirectory(rw http.ResponseWriter, _ *http.Request) {
+	writeJSON(rw, http.StatusOK, acme.Directory{
+		NewNonceURL:   s.url("/nonce"),
+		NewAccountURL: s.url("/new-account"),
+		NewOrderURL:   s.url("/new-order"),
+		RevokeCertURL: s.url("/revoke-cert"),
+		RenewalInfo:   s.url("/renewal-info"),
+		Meta: acme.Meta{
+			ExternalAccountRequired: s.eabKID != "",
+		},
+	})
+}
+
+func (s *ACMEServer) handleNonce(rw http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodGet {
+		rw.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (s *ACMEServer) handleNewAccount(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, true)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	if jr.jwk == nil {
+		writeProblem(rw, newProblem("malformed", "newAccount requires a jwk"))
+		return
+	}
+
+	var msg acme.Account
+	if p = decodePayload(jr, &msg); p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	thumbprint, err := jr.jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		writeProblem(rw, newProblem("badPublicKey", err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, account := range s.accounts {
+		if account.thumbprint == base64.RawURLEncoding.EncodeToString(thumbprint) {
+			rw.Header().Set("Location", account.url)
+			writeJSON(rw, http.StatusOK, s.accountJSON(account))
+
+			return
+		}
+	}
+
+	if msg.OnlyReturnExisting {
+		writeProblem(rw, newProblem("accountDoesNotExist", "no account for this key"))
+		return
+	}
+
+	if s.eabKID != "" {
+		if p = s.verifyEAB(msg.ExternalAccountBinding, thumbprint); p != nil {
+			writeProblem(rw, p)
+			return
+		}
+	}
+
+	id := s.nextID()
+
+	account := &caAccount{
+		id:         id,
+		url:        s.url("/account/%s", id),
+		key:        jr.jwk,
+		thumbprint: base64.RawURLEncoding.EncodeToString(thumbprint),
+		status:     acme.StatusValid,
+		contact:    msg.Contact,
+	}
+
+	s.accounts[id] = account
+
+	rw.Header().Set("Location", account.url)
+	writeJSON(rw, http.StatusCreated, s.accountJSON(account))
+}
+
+// verifyEAB verifies the External Account Binding (must be called with the lock held).
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-7.3.4
+func (s *ACMEServer) verifyEAB(raw json.RawMessage, thumbprint []byte) *acme.ProblemDetails {
+	if len(raw) == 0 {
+		return newProblem("externalAccountRequired", "externalAccountBinding is required")
+	}
+
+	sig, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.HS256, jose.HS384, jose.HS512})
+	if err != nil || len(sig.Signatures) != 1 {
+		return newProblem("malformed", "invalid externalAccountBinding")
+	}
+
+	header := sig.Signatures[0].Protected
+
+	if header.KeyID != s.eabKID {
+		return newProblem(FaultUnauthorized, "unknown EAB kid "+header.KeyID)
+	}
+
+	if u, _ := header.ExtraHeaders["url"].(string); u != s.url("/new-account") {
+		return newProblem(FaultUnauthorized, "invalid EAB url")
+	}
+
+	payload, err := sig.Verify(s.eabHMAC)
+	if err != nil {
+		return newProblem(FaultUnauthorized, "invalid EAB signature")
+	}
+
+	var jwk jose.JSONWebKey
+	if err = jwk.UnmarshalJSON(payload); err != nil {
+		return newProblem("malformed", "invalid EAB payload")
+	}
+
+	eabThumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil || string(eabThumbprint) != string(thumbprint) {
+		return newProblem(FaultUnauthorized, "the EAB key doesn't match the account key")
+	}
+
+	return nil
+}
+
+func (s *ACMEServer) handleAccount(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	if jr.account.id != req.PathValue("id") {
+		writeProblem(rw, newProblem(FaultUnauthorized, "account mismatch"))
+		return
+	}
+
+	var msg acme.Account
+	if !jr.isPostAsGet() {
+		if p = decodePayload(jr, &msg); p != nil {
+			writeProblem(rw, p)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case msg.Status == acme.StatusDeactivated:
+		jr.account.status = acme.StatusDeactivated
+	case msg.Status != "":
+		writeProblem(rw, newProblem("malformed", "invalid account status "+msg.Status))
+		return
+	case msg.Contact != nil:
+		jr.account.contact = msg.Contact
+	}
+
+	writeJSON(rw, http.StatusOK, s.accountJSON(jr.account))
+}
+
+func (s *ACMEServer) handleAccountOrders(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	if jr.account.id != req.PathValue("id") {
+		writeProblem(rw, newProblem(FaultUnauthorized, "account mismatch"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(rw, http.StatusOK, map[string][]string{"orders": slices.Clone(jr.account.orders)})
+}
+
+func (s *ACMEServer) handleNewOrder(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	var msg acme.Order
+	if p = decodePayload(jr, &msg); p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	identifiers, p := normalizeIdentifiers(msg.Identifiers)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	order := &caOrder{
+		accountID:   jr.account.id,
+		status:      acme.StatusPending,
+		expires:     time.Now().Add(7 * 24 * time.Hour),
+		identifiers: identifiers,
+		replaces:    msg.Replaces,
+	}
+
+	var err error
+
+	order.notBefore, err = parseOptionalTime(msg.NotBefore)
+	if err != nil {
+		writeProblem(rw, newProblem("malformed", "invalid notBefore: "+err.Error()))
+		return
+	}
+
+	order.notAfter, err = parseOptionalTime(msg.NotAfter)
+	if err != nil {
+		writeProblem(rw, newProblem("malformed", "invalid notAfter: "+err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order.id = s.nextID()
+	order.url = s.url("/order/%s", order.id)
+
+	if order.replaces != "" {
+		replaced, ok := s.certs[order.replaces]
+
+		switch {
+		case !ok:
+			writeProblem(rw, newProblem("malformed", "unknown certificate "+order.replaces))
+			return
+		case replaced.accountID != jr.account.id:
+			writeProblem(rw, newProblem(FaultUnauthorized, "the replaced certificate belongs to another account"))
+			return
+		case replaced.replacedBy != "" && s.orders[replaced.replacedBy].status != acme.StatusInvalid:
+			p = newProblem("alreadyReplaced", "the certificate has already been replaced")
+			p.HTTPStatus = http.StatusConflict
+			writeProblem(rw, p)
+
+			return
+		}
+
+		replaced.replacedBy = order.id
+	}
+
+	for _, ident := range identifiers {
+		order.authzs = append(order.authzs, s.newAuthz(jr.account.id, ident))
+	}
+
+	s.orders[order.id] = order
+	jr.account.orders = append(jr.account.orders, order.url)
+
+	rw.Header().Set("Location", order.url)
+	writeJSON(rw, http.StatusCreated, s.orderJSON(order))
+}
+
+// newAuthz creates an authorization (must be called with the lock held).
+func (s *ACMEServer) newAuthz(accountID string, ident acme.Identifier) *caAuthz {
+	authz := &caAuthz{
+		id:         s.nextID(),
+		accountID:  accountID,
+		identifier: ident,
+		status:     acme.StatusPending,
+		expires:    time.Now().Add(7 * 24 * time.Hour),
+	}
+
+	authz.url = s.url("/authz/%s", authz.id)
+
+	types := []string{challengeHTTP01, challengeDNS01, challengeTLSALPN01}
+
+	switch {
+	case ident.Type == "ip":
+		// https://www.rfc-editor.org/rfc/rfc8738.html#section-7
+		types = []string{challengeHTTP01, challengeTLSALPN01}
+
+	case strings.HasPrefix(ident.Value, "*."):
+		authz.wildcard = true
+		authz.identifier.Value = strings.TrimPrefix(ident.Value, "*.")
+		types = []string{challengeDNS01}
+	}
+
+	for _, typ := range types {
+		chlg := &caChallenge{
+			id:     s.nextID(),
+			authz:  authz,
+			typ:    typ,
+			token:  randomToken(),
+			status: acme.StatusPending,
+		}
+
+		chlg.url = s.url("/chall/%s", chlg.id)
+
+		s.chlgs[chlg.id] = chlg
+		authz.challenges = append(authz.challenges, chlg)
+	}
+
+	s.authzs[authz.id] = authz
+
+	return authz
+}
+
+func (s *ACMEServer) handleOrder(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	order, ok := s.orders[req.PathValue("id")]
+	if !ok || order.accountID != jr.account.id {
+		writeProblem(rw, newProblem("malformed", "unknown order"))
+		return
+	}
+
+	if order.status == acme.StatusProcessing {
+		rw.Header().Set("Retry-After", "1")
+	}
+
+	writeJSON(rw, http.StatusOK, s.orderJSON(order))
+}
+
+func (s *ACMEServer) handleAuthz(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	var msg acme.Authorization
+	if !jr.isPostAsGet() {
+		if p = decodePayload(jr, &msg); p != nil {
+			writeProblem(rw, p)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	authz, ok := s.authzs[req.PathValue("id")]
+	if !ok || authz.accountID != jr.account.id {
+		writeProblem(rw, newProblem("malformed", "unknown authorization"))
+		return
+	}
+
+	switch msg.Status {
+	case "":
+	case acme.StatusDeactivated:
+		if authz.status == acme.StatusPending || authz.status == acme.StatusValid {
+			authz.status = acme.StatusDeactivated
+			s.updateOrders(authz)
+		}
+	default:
+		writeProblem(rw, newProblem("malformed", "invalid authorization status "+msg.Status))
+		return
+	}
+
+	writeJSON(rw, http.StatusOK, authzJSON(authz))
+}
+
+func (s *ACMEServer) handleChallenge(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	s.mu.Lock()
+
+	chlg, ok := s.chlgs[req.PathValue("id")]
+	if !ok || chlg.authz.accountID != jr.account.id {
+		s.mu.Unlock()
+		writeProblem(rw, newProblem("malformed", "unknown challenge"))
+
+		return
+	}
+
+	rw.Header().Add("Link", link(chlg.authz.url, "up"))
+
+	if jr.isPostAsGet() || chlg.status != acme.StatusPending || chlg.authz.status != acme.StatusPending {
+		defer s.mu.Unlock()
+
+		writeJSON(rw, http.StatusOK, challengeJSON(chlg))
+
+		return
+	}
+
+	chlg.status = acme.StatusProcessing
+
+	task := validationTask{
+		typ:        chlg.typ,
+		identifier: chlg.authz.identifier,
+		token:      chlg.token,
+		keyAuth:    chlg.token + "." + jr.account.thumbprint,
+	}
+
+	s.mu.Unlock()
+
+	if s.processingDelay > 0 {
+		go func() {
+			time.Sleep(s.processingDelay)
+			s.completeChallenge(chlg, s.validate(task))
+		}()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		rw.Header().Set("Retry-After", "1")
+		writeJSON(rw, http.StatusOK, challengeJSON(chlg))
+
+		return
+	}
+
+	s.completeChallenge(chlg, s.validate(task))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(rw, http.StatusOK, challengeJSON(chlg))
+}
+
+// completeChallenge updates the challenge, the authorization, and the orders with the result of a validation.
+func (s *ACMEServer) completeChallenge(chlg *caChallenge, p *acme.ProblemDetails) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p != nil {
+		p.Detail = chlg.authz.identifier.Value + ": " + p.Detail
+
+		chlg.status = acme.StatusInvalid
+		chlg.err = p
+		chlg.authz.status = acme.StatusInvalid
+	} else {
+		chlg.status = acme.StatusValid
+		chlg.validated = time.Now()
+		chlg.authz.status = acme.StatusValid
+	}
+
+	s.updateOrders(chlg.authz)
+}
+
+// updateOrders updates the status of the orders related to an authorization (must be called with the lock held).
+func (s *ACMEServer) updateOrders(authz *caAuthz) {
+orders:
+	for _, order := range s.orders {
+		if order.status != acme.StatusPending || !slices.Contains(order.authzs, authz) {
+			continue
+		}
+
+		ready := true
+
+		for _, a := range order.authzs {
+			switch a.status {
+			case acme.StatusValid:
+			case acme.StatusPending:
+				ready = false
+			default:
+				order.status = acme.StatusInvalid
+				order.err = newProblem(FaultUnauthorized, "authorization "+a.url+" is "+a.status)
+
+				for _, c := range a.challenges {
+					if c.err != nil {
+						order.err = c.err
+					}
+				}
+
+				continue orders
+			}
+		}
+
+		if ready {
+			order.status = acme.StatusReady
+		}
+	}
+}
+
+func (s *ACMEServer) handleFinalize(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	var msg acme.CSRMessage
+	if p = decodePayload(jr, &msg); p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	s.mu.Lock()
+
+	order, ok := s.orders[req.PathValue("id")]
+	if !ok || order.accountID != jr.account.id {
+		s.mu.Unlock()
+		writeProblem(rw, newProblem("malformed", "unknown order"))
+
+		return
+	}
+
+	if order.status != acme.StatusReady {
+		s.mu.Unlock()
+		writeProblem(rw, newProblem("orderNotReady", "the order is "+order.status))
+
+		return
+	}
+
+	csr, p := parseCSR(msg.Csr, order.identifiers)
+	if p != nil {
+		s.mu.Unlock()
+		writeProblem(rw, p)
+
+		return
+	}
+
+	order.status = acme.StatusProcessing
+
+	s.mu.Unlock()
+
+	if s.processingDelay > 0 {
+		go func() {
+			time.Sleep(s.processingDelay)
+			s.issue(order, jr.account, csr)
+		}()
+	} else {
+		s.issue(order, jr.account, csr)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if order.status == acme.StatusProcessing {
+		rw.Header().Set("Retry-After", "1")
+	}
+
+	rw.Header().Set("Location", order.url)
+	writeJSON(rw, http.StatusOK, s.orderJSON(order))
+}
+
+func (s *ACMEServer) issue(order *caOrder, account *caAccount, csr *x509.CertificateRequest) {
+	notBefore := order.notBefore
+	if notBefore.IsZero() {
+		notBefore = time.Now().Add(-time.Minute)
+	}
+
+	notAfter := order.notAfter
+	if notAfter.IsZero() {
+		notAfter = notBefore.Add(s.lifetime)
+	}
+
+	leaf, err := s.pki.issue(csr, notBefore, notAfter)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		order.status = acme.StatusInvalid
+		order.err = newProblem(FaultServerInternal, "issuance: "+err.Error())
+
+		return
+	}
+
+	cert := &caCertificate{
+		id:        ariCertID(leaf),
+		accountID: account.id,
+		leaf:      leaf,
+	}
+
+	cert.url = s.url("/cert/%s", s.nextID())
+
+	s.certs[cert.id] = cert
+
+	order.cert = cert
+	order.status = acme.StatusValid
+}
+
+func (s *ACMEServer) handleCertificate(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, false)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	chain := 0
+
+	if v := req.PathValue("chain"); v != "" {
+		var err error
+
+		chain, err = strconv.Atoi(v)
+		if err != nil || chain < 1 || chain >= len(s.pki.chains) {
+			writeProblem(rw, newProblem("malformed", "unknown chain"))
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	base := s.url("/cert/%s", req.PathValue("id"))
+
+	var cert *caCertificate
+
+	for _, c := range s.certs {
+		if c.url == base {
+			cert = c
+			break
+		}
+	}
+
+	if cert == nil || cert.accountID != jr.account.id {
+		writeProblem(rw, newProblem("malformed", "unknown certificate"))
+		return
+	}
+
+	for i := range s.pki.chains {
+		switch {
+		case i == chain:
+		case i == 0:
+			rw.Header().Add("Link", link(base, "alternate"))
+		default:
+			rw.Header().Add("Link", link(base+"/"+strconv.Itoa(i), "alternate"))
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/pem-certificate-chain")
+	rw.WriteHeader(http.StatusOK)
+
+	_, _ = rw.Write(s.pki.chainPEM(cert.leaf, chain))
+}
+
+func (s *ACMEServer) handleRevokeCert(rw http.ResponseWriter, req *http.Request) {
+	jr, p := s.verifyJWS(req, true)
+	if p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	var msg acme.RevokeCertMessage
+	if p = decodePayload(jr, &msg); p != nil {
+		writeProblem(rw, p)
+		return
+	}
+
+	der, err := base64.RawURLEncoding.DecodeString(msg.Certificate)
+	if err != nil {
+		writeProblem(rw, newProblem("malformed", "invalid certificate encoding"))
+		return
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		writeProblem(rw, newProblem("malformed", "invalid certificate"))
+		return
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc5280.html#section-5.3.1
+	if msg.Reason != nil && (*msg.Reason > 10 || *msg.Reason == 7) {
+		writeProblem(rw, newProblem("badRevocationReason", "allowed reasons: 0-6, 8-10"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cert, ok := s.certs[ariCertID(leaf)]
+	if !ok || !cert.leaf.Equal(leaf) {
+		writeProblem(rw, newProblem("malformed", "unknown certificate"))
+		return
+	}
+
+	if !s.canRevoke(jr, cert) {
+		writeProblem(rw, newProblem(FaultUnauthorized, "not allowed to revoke this certificate"))
+		return
+	}
+
+	if cert.revoked {
+		writeProblem(rw, newProblem("alreadyRevoked", "the certificate is already revoked"))
+		return
+	}
+
+	cert.revoked = true
+
+	rw.WriteHeader(http.StatusOK)
+}
+
+// canRevoke checks the revocation authorization (must be called with the lock held).
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-7.6
+func (s *ACMEServer) canRevoke(jr *jwsRequest, cert *caCertificate) bool {
+	if jr.jwk != nil {
+		pub, ok := cert.leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+
+		return ok && pub.Equal(jr.jwk.Key)
+	}
+
+	if cert.accountID == jr.account.id {
+		return true
+	}
+
+	// The account holds valid authorizations for all the identifiers of the certificate.
+	for _, ident := range certificateIdentifiers(cert.leaf) {
+		found := false
+
+		for _, authz := range s.authzs {
+			if authz.accountID == jr.account.id && authz.status == acme.StatusValid && authz.identifier.Value == strings.TrimPrefix(ident, "*.") {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *ACMEServer) handleRenewalInfo(rw http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cert, ok := s.certs[req.PathValue("id")]
+	if !ok {
+		p := newProblem("malformed", "unknown certificate")
+		p.HTTPStatus = http.StatusNotFound
+		writeProblem(rw, p)
+
+		return
+	}
+
+	lifetime := cert.leaf.NotAfter.Sub(cert.leaf.NotBefore)
+
+	window := acme.Window{
+		Start: cert.leaf.NotBefore.Add(lifetime * 2 / 3),
+		End:   cert.leaf.NotBefore.Add(lifetime * 5 / 6),
+	}
+
+	switch {
+	case cert.window != nil:
+		window = *cert.window
+	case cert.revoked:
+		// The certificate must be renewed immediately.
+		window = acme.Window{Start: time.Now().Add(-2 * time.Hour), End: time.Now().Add(-time.Hour)}
+	}
+
+	rw.Header().Set("Retry-After", "21600")
+	writeJSON(rw, http.StatusOK, acme.RenewalInfoResponse{SuggestedWindow: window})
+}
+
+func (s *ACMEServer) accountJSON(account *caAccount) acme.Account {
+	return acme.Account{
+		Status:  account.status,
+		Contact: account.contact,
+		Orders:  account.url + "/orders",
+	}
+}
+
+func (s *ACMEServer) orderJSON(order *caOrder) acme.Order {
+	o := acme.Order{
+		Status:      order.status,
+		Expires:     order.expires.Format(time.RFC3339),
+		Identifiers: order.identifiers,
+		Finalize:    order.url + "/finalize",
+		Error:       order.err,
+		Replaces:    order.replaces,
+	}
+
+	if !order.notBefore.IsZero() {
+		o.NotBefore = order.notBefore.Format(time.RFC3339)
+	}
+
+	if !order.notAfter.IsZero() {
+		o.NotAfter = order.notAfter.Format(time.RFC3339)
+	}
+
+	for _, authz := range order.authzs {
+		o.Authorizations = append(o.Authorizations, authz.url)
+	}
+
+	if order.cert != nil {
+		o.Certificate = order.cert.url
+	}
+
+	return o
+}
+
+func authzJSON(authz *caAuthz) acme.Authorization {
+	a := acme.Authorization{
+		Status:     authz.status,
+		Expires:    authz.expires,
+		Identifier: authz.identifier,
+		Wildcard:   authz.wildcard,
+	}
+
+	for _, chlg := range authz.challenges {
+		// For valid and invalid authorizations, only the attempted challenge is listed.
+		if authz.status != acme.StatusPending && chlg.status == acme.StatusPending {
+			continue
+		}
+
+		a.Challenges = append(a.Challenges, challengeJSON(chlg))
+	}
+
+	return a
+}
+
+func challengeJSON(chlg *caChallenge) acme.Challenge {
+	return acme.Challenge{
+		Type:      chlg.typ,
+		URL:       chlg.url,
+		Status:    chlg.status,
+		Validated: chlg.validated,
+		Error:     chlg.err,
+		Token:     chlg.token,
+	}
+}
+
+// normalizeIdentifiers checks and deduplicates the identifiers of an order.
+func normalizeIdentifiers(identifiers []acme.Identifier) ([]acme.Identifier, *acme.ProblemDetails) {
+	if len(identifiers) == 0 {
+		return nil, newProblem("malformed", "no identifiers")
+	}
+
+	var result []acme.Identifier
+
+	for _, ident := range identifiers {
+		switch ident.Type {
+		case "dns":
+			ident.Value = strings.ToLower(ident.Value)
+
+			name := strings.TrimPrefix(ident.Value, "*.")
+			if name == "" || strings.Contains(name, "*") || net.ParseIP(name) != nil || strings.HasSuffix(name, ".") {
+				return nil, newProblem("rejectedIdentifier", "invalid DNS identifier "+ident.Value)
+			}
+
+		case "ip":
+			ip := net.ParseIP(ident.Value)
+			if ip == nil {
+				return nil, newProblem("rejectedIdentifier", "invalid IP identifier "+ident.Value)
+			}
+
+			ident.Value = ip.String()
+
+		default:
+			return nil, newProblem("unsupportedIdentifier", "unsupported identifier type "+ident.Type)
+		}
+
+		if !slices.Contains(result, ident) {
+			result = append(result, ident)
+		}
+	}
+
+	return result, nil
+}
+
+// parseCSR parses the CSR and checks that it matches the identifiers of the order.
+func parseCSR(raw string, identifiers []acme.Identifier) (*x509.CertificateRequest, *acme.ProblemDetails) {
+	der, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, newProblem("badCSR", "invalid CSR encoding")
+	}
+
+	csr, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, newProblem("badCSR", "invalid CSR: "+err.Error())
+	}
+
+	if err = csr.CheckSignature(); err != nil {
+		return nil, newProblem("badCSR", "invalid CSR signature: "+err.Error())
+	}
+
+	var names []string
+	for _, name := range csr.DNSNames {
+		names = append(names, strings.ToLower(name))
+	}
+
+	for _, ip := range csr.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	if cn := strings.ToLower(csr.Subject.CommonName); cn != "" && !slices.Contains(names, cn) {
+		names = append(names, cn)
+	}
+
+	var expected []string
+	for _, ident := range identifiers {
+		expected = append(expected, ident.Value)
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+	slices.Sort(expected)
+
+	if !slices.Equal(names, expected) {
+		return nil, newProblem("badCSR", "the CSR identifiers "+strings.Join(names, ",")+" don't match the order identifiers "+strings.Join(expected, ","))
+	}
+
+	return csr, nil
+}
+
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func certificateIdentifiers(cert *x509.Certificate) []string {
+	names := slices.Clone(cert.DNSNames)
+
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	return names
+}
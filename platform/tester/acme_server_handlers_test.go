@@ -0,0 +1,31 @@
+package tester
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACMEServer_updateOrders(t *testing.T) {
+	invalid := &caAuthz{url: "https://example.com/authz/1", status: acme.StatusInvalid}
+	valid := &caAuthz{url: "https://example.com/authz/2", status: acme.StatusValid}
+
+	s := &ACMEServer{
+		orders: map[string]*caOrder{
+			"1": {status: acme.StatusPending, authzs: []*caAuthz{invalid}},
+			"2": {status: acme.StatusPending, authzs: []*caAuthz{invalid, valid}},
+			"3": {status: acme.StatusPending, authzs: []*caAuthz{valid}},
+		},
+	}
+
+	s.updateOrders(invalid)
+
+	assert.Equal(t, acme.StatusInvalid, s.orders["1"].status)
+	assert.Equal(t, acme.StatusInvalid, s.orders["2"].status)
+	assert.Equal(t, acme.StatusPending, s.orders["3"].status)
+
+	s.updateOrders(valid)
+
+	assert.Equal(t, acme.StatusReady, s.orders["3"].status)
+}
@@ -0,0 +1,308 @@
+package tester_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
+	"github.com/go-acme/lego/v4/lego"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/registration"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACMEServer_http01(t *testing.T) {
+	port := freePort(t)
+
+	server := tester.NewACMEServer(t, tester.WithHTTP01Port(port), tester.WithAlternateChains(1))
+
+	client := newLegoClient(t, server.URL())
+
+	err := client.Challenge.SetHTTP01Provider(http01.NewProviderServer("127.0.0.1", strconv.Itoa(port)))
+	require.NoError(t, err)
+
+	certRes, err := client.Certificate.Obtain(certificate.ObtainRequest{
+		Domains:        []string{"example.com", "www.example.com"},
+		Bundle:         true,
+		PreferredChain: "Tester Alternate Root CA 1",
+	})
+	require.NoError(t, err)
+
+	cert, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+
+	issuers, err := certcrypto.ParsePEMBundle(certRes.IssuerCertificate)
+	require.NoError(t, err)
+	require.Len(t, issuers, 1)
+
+	assert.Equal(t, "Tester Alternate Root CA 1", issuers[0].Issuer.CommonName)
+	require.NoError(t, issuers[0].CheckSignatureFrom(server.Roots()[1]))
+
+	// ARI
+	info, err := client.Certificate.GetRenewalInfo(certificate.RenewalInfoRequest{Cert: cert})
+	require.NoError(t, err)
+
+	assert.True(t, info.SuggestedWindow.Start.After(time.Now()))
+	assert.Equal(t, 6*time.Hour, info.RetryAfter)
+
+	// Revocation
+	require.NoError(t, client.Certificate.Revoke(certRes.Certificate))
+	assert.True(t, server.IsRevoked(cert))
+
+	err = client.Certificate.Revoke(certRes.Certificate)
+	require.ErrorContains(t, err, "alreadyRevoked")
+
+	info, err = client.Certificate.GetRenewalInfo(certificate.RenewalInfoRequest{Cert: cert})
+	require.NoError(t, err)
+
+	assert.True(t, info.SuggestedWindow.End.Before(time.Now()))
+}
+
+func TestACMEServer_tlsalpn01(t *testing.T) {
+	port := freePort(t)
+
+	server := tester.NewACMEServer(t, tester.WithTLSALPN01Port(port))
+
+	client := newLegoClient(t, server.URL())
+
+	err := client.Challenge.SetTLSALPN01Provider(tlsalpn01.NewProviderServer("127.0.0.1", strconv.Itoa(port)))
+	require.NoError(t, err)
+
+	certRes, err := client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com", "127.0.0.1"}})
+	require.NoError(t, err)
+
+	cert, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com"}, cert.DNSNames)
+	require.Len(t, cert.IPAddresses, 1)
+	assert.Equal(t, "127.0.0.1", cert.IPAddresses[0].String())
+}
+
+func TestACMEServer_dns01(t *testing.T) {
+	dnsServer := newTXTServer(t)
+
+	server := tester.NewACMEServer(t, tester.WithDNSServer(dnsServer.addr))
+
+	client := newLegoClient(t, server.URL())
+
+	err := client.Challenge.SetDNS01Provider(dnsServer,
+		dns01.AddRecursiveNameservers([]string{dnsServer.addr}),
+		dns01.WrapPreCheck(func(_, _, _ string, _ dns01.PreCheckFunc) (bool, error) {
+			return true, nil
+		}))
+	require.NoError(t, err)
+
+	certRes, err := client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"*.example.com", "example.com"}})
+	require.NoError(t, err)
+
+	cert, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"*.example.com", "example.com"}, cert.DNSNames)
+}
+
+func TestACMEServer_validationFailure(t *testing.T) {
+	port := freePort(t)
+
+	server := tester.NewACMEServer(t, tester.WithHTTP01Port(port))
+
+	client := newLegoClient(t, server.URL())
+
+	// Nothing listens on the port.
+	err := client.Challenge.SetHTTP01Provider(&noopProvider{})
+	require.NoError(t, err)
+
+	_, err = client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com"}})
+	require.ErrorContains(t, err, "urn:ietf:params:acme:error:connection")
+}
+
+func TestACMEServer_faults(t *testing.T) {
+	port := freePort(t)
+
+	server := tester.NewACMEServer(t, tester.WithHTTP01Port(port), tester.WithProcessingDelay(100*time.Millisecond))
+
+	client := newLegoClient(t, server.URL())
+
+	err := client.Challenge.SetHTTP01Provider(http01.NewProviderServer("127.0.0.1", strconv.Itoa(port)))
+	require.NoError(t, err)
+
+	server.InjectFault(tester.EndpointNewOrder, tester.FaultRateLimited, 1)
+
+	_, err = client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com"}})
+	require.ErrorContains(t, err, "urn:ietf:params:acme:error:rateLimited")
+
+	// The client retries on badNonce.
+	server.InjectFault(tester.EndpointAny, tester.FaultBadNonce, 3)
+
+	_, err = client.Certificate.Obtain(certificate.ObtainRequest{Domains: []string{"example.com"}})
+	require.NoError(t, err)
+}
+
+func TestACMEServer_externalAccountBinding(t *testing.T) {
+	hmacKey := []byte("this-is-a-secret-key-for-the-eab")
+
+	server := tester.NewACMEServer(t, tester.WithExternalAccountBinding("kid-1", hmacKey))
+
+	user := newUser(t)
+
+	config := lego.NewConfig(user)
+	config.CADirURL = server.URL()
+
+	client, err := lego.NewClient(config)
+	require.NoError(t, err)
+
+	require.True(t, client.GetExternalAccountRequired())
+
+	_, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	require.ErrorContains(t, err, "externalAccountRequired")
+
+	reg, err := client.Registration.RegisterWithExternalAccountBinding(registration.RegisterEABOptions{
+		TermsOfServiceAgreed: true,
+		Kid:                  "kid-1",
+		HmacEncoded:          "dGhpcy1pcy1hLXNlY3JldC1rZXktZm9yLXRoZS1lYWI",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, acme.StatusValid, reg.Body.Status)
+}
+
+type testUser struct {
+	key          crypto.PrivateKey
+	registration *registration.Resource
+}
+
+func (u *testUser) GetEmail() string                        { return "" }
+func (u *testUser) GetRegistration() *registration.Resource { return u.registration }
+func (u *testUser) GetPrivateKey() crypto.PrivateKey        { return u.key }
+
+func newUser(t *testing.T) *testUser {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &testUser{key: key}
+}
+
+func newLegoClient(t *testing.T, dirURL string) *lego.Client {
+	t.Helper()
+
+	user := newUser(t)
+
+	config := lego.NewConfig(user)
+	config.CADirURL = dirURL
+	config.Certificate.KeyType = certcrypto.EC256
+
+	client, err := lego.NewClient(config)
+	require.NoError(t, err)
+
+	user.registration, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	require.NoError(t, err)
+
+	return client
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer func() { _ = l.Close() }()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+type noopProvider struct{}
+
+func (*noopProvider) Present(_, _, _ string) error { return nil }
+func (*noopProvider) CleanUp(_, _, _ string) error { return nil }
+
+// txtServer is a DNS server and a DNS provider.
+type txtServer struct {
+	addr string
+
+	mu      sync.Mutex
+	records map[string][]string
+}
+
+func newTXTServer(t *testing.T) *txtServer {
+	t.Helper()
+
+	s := &txtServer{records: make(map[string][]string)}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s.addr = pc.LocalAddr().String()
+
+	server := &dns.Server{PacketConn: pc, Handler: s}
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	return s
+}
+
+func (s *txtServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	for _, q := range req.Question {
+		if q.Qtype != dns.TypeTXT {
+			continue
+		}
+
+		for _, value := range s.records[q.Name] {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+				Txt: []string{value},
+			})
+		}
+	}
+
+	_ = w.WriteMsg(m)
+}
+
+func (s *txtServer) Present(domain, _, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[info.EffectiveFQDN] = append(s.records[info.EffectiveFQDN], info.Value)
+
+	return nil
+}
+
+func (s *txtServer) CleanUp(domain, _, _ string) error {
+	info := dns01.GetChallengeInfo(domain, "")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, info.EffectiveFQDN)
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package tester
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/miekg/dns"
+)
+
+const validationTimeout = 10 * time.Second
+
+// idPeAcmeIdentifier is the OID of the acmeIdentifier extension (tls-alpn-01).
+// https://www.rfc-editor.org/rfc/rfc8737.html#section-6.1
+var idPeAcmeIdentifier = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 31}
+
+// validationTask contains the information needed to validate a challenge.
+type validationTask struct {
+	typ        string
+	identifier acme.Identifier
+	token      string
+	keyAuth    string
+}
+
+// validate validates a challenge, it returns nil when the validation succeeds.
+func (s *ACMEServer) validate(task validationTask) *acme.ProblemDetails {
+	switch task.typ {
+	case challengeHTTP01:
+		return s.validateHTTP01(task)
+	case challengeDNS01:
+		return s.validateDNS01(task)
+	case challengeTLSALPN01:
+		return s.validateTLSALPN01(task)
+	default:
+		return newProblem("malformed", "unsupported challenge type "+task.typ)
+	}
+}
+
+// validationAddr returns the address used to reach the challenge servers.
+func (s *ACMEServer) validationAddr(ident acme.Identifier, port int) string {
+	host := s.validationHost
+	if ident.Type == "ip" {
+		host = ident.Value
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-8.3
+func (s *ACMEServer) validateHTTP01(task validationTask) *acme.ProblemDetails {
+	addr := s.validationAddr(task.identifier, s.http01Port)
+
+	client := &http.Client{
+		Timeout: validationTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	host := net.JoinHostPort(task.identifier.Value, strconv.Itoa(s.http01Port))
+
+	resp, err := client.Get(fmt.Sprintf("http://%s/.well-known/acme-challenge/%s", host, task.token))
+	if err != nil {
+		return newProblem("connection", err.Error())
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return newProblem(FaultUnauthorized, fmt.Sprintf("invalid response from the http-01 server: %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return newProblem("connection", err.Error())
+	}
+
+	if got := string(bytes.TrimRight(body, " \t\r\n")); got != task.keyAuth {
+		return newProblem(FaultUnauthorized, fmt.Sprintf("the key authorization %q doesn't match %q", got, task.keyAuth))
+	}
+
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-8.4
+func (s *ACMEServer) validateDNS01(task validationTask) *acme.ProblemDetails {
+	fqdn := "_acme-challenge." + dns.Fqdn(task.identifier.Value)
+
+	records, err := s.lookupTXT(fqdn)
+	if err != nil {
+		return newProblem("dns", err.Error())
+	}
+
+	digest := sha256.Sum256([]byte(task.keyAuth))
+	expected := base64.RawURLEncoding.EncodeToString(digest[:])
+
+	if !slices.Contains(records, expected) {
+		return newProblem(FaultUnauthorized, fmt.Sprintf("no TXT record %q found for %s", expected, fqdn))
+	}
+
+	return nil
+}
+
+func (s *ACMEServer) lookupTXT(fqdn string) ([]string, error) {
+	if s.dnsServer == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), validationTimeout)
+		defer cancel()
+
+		return net.DefaultResolver.LookupTXT(ctx, fqdn)
+	}
+
+	m := new(dns.Msg)
+	m.SetQuestion(fqdn, dns.TypeTXT)
+
+	client := &dns.Client{Timeout: validationTimeout}
+
+	in, _, err := client.Exchange(m, s.dnsServer)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("DNS response code %s for %s", dns.RcodeToString[in.Rcode], fqdn)
+	}
+
+	var records []string
+
+	for _, rr := range in.Answer {
+		if txt, ok := rr.(*dns.TXT); ok {
+			records = append(records, strings.Join(txt.Txt, ""))
+		}
+	}
+
+	return records, nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc8737.html#section-3
+func (s *ACMEServer) validateTLSALPN01(task validationTask) *acme.ProblemDetails {
+	serverName := task.identifier.Value
+	if task.identifier.Type == "ip" {
+		// https://www.rfc-editor.org/rfc/rfc8738.html#section-6
+		var err error
+
+		serverName, err = dns.ReverseAddr(task.identifier.Value)
+		if err != nil {
+			return newProblem("malformed", err.Error())
+		}
+
+		serverName = strings.TrimSuffix(serverName, ".")
+	}
+
+	dialer := &net.Dialer{Timeout: validationTimeout}
+
+	conn, err := tls.DialWithDialer(dialer, "tcp", s.validationAddr(task.identifier, s.tlsALPN01Port), &tls.Config{
+		ServerName:         serverName,
+		NextProtos:         []string{"acme-tls/1"},
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return newProblem("tls", err.Error())
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	state := conn.ConnectionState()
+
+	if state.NegotiatedProtocol != "acme-tls/1" {
+		return newProblem(FaultUnauthorized, "the acme-tls/1 protocol has not been negotiated")
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		return newProblem(FaultUnauthorized, "no certificate")
+	}
+
+	leaf := state.PeerCertificates[0]
+
+	if !slices.Equal(certificateIdentifiers(leaf), []string{task.identifier.Value}) {
+		return newProblem(FaultUnauthorized, fmt.Sprintf("the certificate must only contain the identifier %s: %v", task.identifier.Value, certificateIdentifiers(leaf)))
+	}
+
+	digest := sha256.Sum256([]byte(task.keyAuth))
+
+	for _, ext := range leaf.Extensions {
+		if !ext.Id.Equal(idPeAcmeIdentifier) {
+			continue
+		}
+
+		if !ext.Critical {
+			return newProblem(FaultUnauthorized, "the acmeIdentifier extension must be critical")
+		}
+
+		var value []byte
+		if _, err = asn1.Unmarshal(ext.Value, &value); err != nil || !bytes.Equal(value, digest[:]) {
+			return newProblem(FaultUnauthorized, "the acmeIdentifier extension doesn't match the key authorization")
+		}
+
+		return nil
+	}
+
+	return newProblem(FaultUnauthorized, "the certificate doesn't contain the acmeIdentifier extension")
+}
@@ -0,0 +1,238 @@
+// Package conformance provides a test suite checking that a DNS provider respects the contract of challenge.Provider.
+//
+// The provider under test must use a fake (or recorded) API backed by Records:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, conformance.Suite{
+//			NewProvider: func(t *testing.T, records *conformance.Records) challenge.Provider {
+//				server := httptest.NewServer(fakeAPI(records))
+//				t.Cleanup(server.Close)
+//
+//				config := NewDefaultConfig()
+//				config.BaseURL = server.URL
+//
+//				provider, err := NewDNSProviderConfig(config)
+//				require.NoError(t, err)
+//
+//				return provider
+//			},
+//		})
+//	}
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultDomain = "example.com"
+
+// foreignValue is the value of a record not created by the provider, which must survive the cleanups.
+const foreignValue = "foreign"
+
+type sequential interface {
+	Sequential() time.Duration
+}
+
+// Suite describes a DNS provider checked by Run.
+type Suite struct {
+	// NewProvider creates the provider under test.
+	// The provider must use a fake (or recorded) API storing the TXT records in records.
+	NewProvider func(t *testing.T, records *Records) challenge.Provider
+
+	// Domain is the domain of the challenges (default: "example.com").
+	// The domain is the zone: a local DNS server answers the SOA queries of the zone.
+	Domain string
+
+	// SingleValue is true when the provider can store only one TXT value for a FQDN.
+	SingleValue bool
+
+	// Sequential is true when the provider is expected to be sequential.
+	Sequential bool
+}
+
+// Run runs the conformance suite:
+//   - Present creates the TXT record, and presenting it again doesn't fail nor duplicate the value.
+//   - CleanUp removes only the value of the challenge, and cleaning it up again doesn't change the records
+//     (an error is allowed because the provider may not find the record anymore).
+//   - Several TXT values can exist for the same FQDN (i.e. a domain and its wildcard), unless SingleValue is set.
+//   - Timeout returns a positive timeout and interval, and the interval is not greater than the timeout.
+//   - Sequential is implemented only by the sequential providers, and returns a positive interval.
+//
+// The subtests are not parallel: the DNS resolution is redirected to a local server during Run.
+func Run(t *testing.T, suite Suite) {
+	t.Helper()
+
+	require.NotNil(t, suite.NewProvider, "NewProvider is required")
+
+	if suite.Domain == "" {
+		suite.Domain = defaultDomain
+	}
+
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	SetupZone(t, suite.Domain)
+
+	t.Run("Present", suite.testPresent)
+	t.Run("Present twice", suite.testPresentTwice)
+	t.Run("CleanUp", suite.testCleanUp)
+	t.Run("CleanUp twice", suite.testCleanUpTwice)
+	t.Run("multiple values", suite.testMultipleValues)
+	t.Run("Timeout", suite.testTimeout)
+	t.Run("Sequential", suite.testSequential)
+}
+
+func (s Suite) setup(t *testing.T) (challenge.Provider, *Records) {
+	t.Helper()
+
+	records := NewRecords()
+
+	provider := s.NewProvider(t, records)
+	require.NotNil(t, provider)
+
+	return provider, records
+}
+
+func (s Suite) testPresent(t *testing.T) {
+	provider, records := s.setup(t)
+
+	info := dns01.GetChallengeInfo(s.Domain, "keyAuth1")
+
+	err := provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{info.Value}, records.Values(info.EffectiveFQDN))
+}
+
+func (s Suite) testPresentTwice(t *testing.T) {
+	provider, records := s.setup(t)
+
+	info := dns01.GetChallengeInfo(s.Domain, "keyAuth1")
+
+	err := provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	err = provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err, "Present must be idempotent")
+
+	assert.Equal(t, []string{info.Value}, records.Values(info.EffectiveFQDN), "Present must not duplicate the value")
+}
+
+func (s Suite) testCleanUp(t *testing.T) {
+	provider, records := s.setup(t)
+
+	info := dns01.GetChallengeInfo(s.Domain, "keyAuth1")
+
+	s.addForeign(records, info.EffectiveFQDN)
+
+	err := provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	err = provider.CleanUp(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	assert.Equal(t, s.foreign(), records.Values(info.EffectiveFQDN), "CleanUp must remove only the value of the challenge")
+}
+
+func (s Suite) testCleanUpTwice(t *testing.T) {
+	provider, records := s.setup(t)
+
+	info := dns01.GetChallengeInfo(s.Domain, "keyAuth1")
+
+	s.addForeign(records, info.EffectiveFQDN)
+
+	err := provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	err = provider.CleanUp(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	// The provider may not find the record anymore.
+	_ = provider.CleanUp(s.Domain, "token1", "keyAuth1")
+
+	assert.Equal(t, s.foreign(), records.Values(info.EffectiveFQDN), "CleanUp must be idempotent")
+}
+
+func (s Suite) testMultipleValues(t *testing.T) {
+	if s.SingleValue {
+		t.Skip("the provider stores only one TXT value for a FQDN")
+	}
+
+	provider, records := s.setup(t)
+
+	// The challenges of a domain and its wildcard.
+	info1 := dns01.GetChallengeInfo(s.Domain, "keyAuth1")
+	info2 := dns01.GetChallengeInfo(s.Domain, "keyAuth2")
+
+	s.addForeign(records, info1.EffectiveFQDN)
+
+	err := provider.Present(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	err = provider.Present(s.Domain, "token2", "keyAuth2")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, append(s.foreign(), info1.Value, info2.Value), records.Values(info1.EffectiveFQDN))
+
+	err = provider.CleanUp(s.Domain, "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, append(s.foreign(), info2.Value), records.Values(info1.EffectiveFQDN))
+
+	err = provider.CleanUp(s.Domain, "token2", "keyAuth2")
+	require.NoError(t, err)
+
+	assert.Equal(t, s.foreign(), records.Values(info1.EffectiveFQDN))
+}
+
+func (s Suite) testTimeout(t *testing.T) {
+	provider, _ := s.setup(t)
+
+	p, ok := provider.(challenge.ProviderTimeout)
+	if !ok {
+		t.Skip("the provider doesn't implement challenge.ProviderTimeout")
+	}
+
+	timeout, interval := p.Timeout()
+
+	assert.Positive(t, timeout, "timeout")
+	assert.Positive(t, interval, "interval")
+	assert.LessOrEqual(t, interval, timeout, "the interval must not be greater than the timeout")
+}
+
+func (s Suite) testSequential(t *testing.T) {
+	provider, _ := s.setup(t)
+
+	p, ok := provider.(sequential)
+	if !s.Sequential {
+		assert.False(t, ok, "the provider must not be sequential")
+		return
+	}
+
+	require.True(t, ok, "the provider must be sequential")
+
+	assert.Positive(t, p.Sequential(), "interval")
+}
+
+// addForeign adds a record not created by the provider, when the provider supports several values.
+func (s Suite) addForeign(records *Records, fqdn string) {
+	if s.SingleValue {
+		return
+	}
+
+	records.Add(fqdn, foreignValue)
+}
+
+// foreign returns the values of the records not created by the provider.
+func (s Suite) foreign() []string {
+	if s.SingleValue {
+		return nil
+	}
+
+	return []string{foreignValue}
+}
@@ -0,0 +1,114 @@
+package conformance
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryProvider is a provider storing the records directly in Records.
+type memoryProvider struct {
+	records *Records
+}
+
+func (p *memoryProvider) Present(domain, _, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return err
+	}
+
+	if zone != "example.com." {
+		return fmt.Errorf("unexpected zone: %s", zone)
+	}
+
+	if slices.Contains(p.records.Values(info.EffectiveFQDN), info.Value) {
+		return nil
+	}
+
+	p.records.Add(info.EffectiveFQDN, info.Value)
+
+	return nil
+}
+
+func (p *memoryProvider) CleanUp(domain, _, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	for _, record := range p.records.Find(info.EffectiveFQDN) {
+		if record.Value == info.Value {
+			p.records.Delete(record.ID)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("record not found: %s", info.EffectiveFQDN)
+}
+
+func (p *memoryProvider) Timeout() (timeout, interval time.Duration) {
+	return time.Minute, time.Second
+}
+
+type sequentialMemoryProvider struct {
+	*memoryProvider
+}
+
+func (p *sequentialMemoryProvider) Sequential() time.Duration {
+	return time.Second
+}
+
+func TestRun(t *testing.T) {
+	Run(t, Suite{
+		NewProvider: func(_ *testing.T, records *Records) challenge.Provider {
+			return &memoryProvider{records: records}
+		},
+	})
+}
+
+func TestRun_sequential(t *testing.T) {
+	Run(t, Suite{
+		NewProvider: func(_ *testing.T, records *Records) challenge.Provider {
+			return &sequentialMemoryProvider{memoryProvider: &memoryProvider{records: records}}
+		},
+		Sequential: true,
+	})
+}
+
+func TestRecords(t *testing.T) {
+	records := NewRecords()
+
+	a := records.Add("_acme-challenge.Example.com", "a")
+	assert.Equal(t, Record{ID: "1", FQDN: "_acme-challenge.example.com.", Value: "a"}, a)
+
+	// The values are duplicated, as with most DNS APIs.
+	a2 := records.Add("_acme-challenge.example.com.", "a")
+	assert.Equal(t, Record{ID: "2", FQDN: "_acme-challenge.example.com.", Value: "a"}, a2)
+
+	records.Add("_acme-challenge.example.com.", "b")
+	records.Add("_acme-challenge.example.org.", "c")
+
+	assert.Equal(t, []string{"a", "a", "b"}, records.Values("_acme-challenge.example.com."))
+
+	assert.True(t, records.Delete(a.ID))
+	assert.False(t, records.Delete(a.ID))
+
+	_, ok := records.Get(a.ID)
+	assert.False(t, ok)
+
+	assert.True(t, records.Delete(a2.ID))
+
+	assert.Equal(t, []string{"b"}, records.Values("_acme-challenge.example.com."))
+
+	records.Replace("_acme-challenge.example.com.", []string{"d", "e"})
+	assert.Equal(t, []string{"d", "e"}, records.Values("_acme-challenge.example.com."))
+
+	records.Replace("_acme-challenge.example.com.", nil)
+	assert.Empty(t, records.Values("_acme-challenge.example.com."))
+
+	assert.Equal(t, []string{"c"}, records.Values("_acme-challenge.example.org."))
+}
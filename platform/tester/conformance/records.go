@@ -0,0 +1,128 @@
+package conformance
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/miekg/dns"
+)
+
+// Record is a TXT record stored by Records.
+type Record struct {
+	ID    string
+	FQDN  string
+	Value string
+}
+
+// Records is an in-memory list of TXT records, used as the storage of a fake DNS API.
+// As with most DNS APIs, a value can be stored several times for a FQDN (one record by call of Add):
+// the suite can check that a provider doesn't duplicate the values.
+// The FQDNs are normalized (lowercase, with a trailing dot).
+type Records struct {
+	mu      sync.Mutex
+	lastID  int
+	records []Record
+}
+
+// NewRecords creates an empty set of records.
+func NewRecords() *Records {
+	return &Records{}
+}
+
+// Add adds a TXT record to a FQDN.
+// A new record is created even if the value already exists.
+func (r *Records) Add(fqdn, value string) Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fqdn = normalize(fqdn)
+
+	r.lastID++
+
+	record := Record{ID: strconv.Itoa(r.lastID), FQDN: fqdn, Value: value}
+	r.records = append(r.records, record)
+
+	return record
+}
+
+// Get returns the record with the given ID.
+func (r *Records) Get(id string) (Record, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	index := slices.IndexFunc(r.records, func(record Record) bool { return record.ID == id })
+	if index < 0 {
+		return Record{}, false
+	}
+
+	return r.records[index], true
+}
+
+// Delete deletes the record with the given ID.
+// It returns false if the record doesn't exist.
+func (r *Records) Delete(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := len(r.records)
+
+	r.records = slices.DeleteFunc(r.records, func(record Record) bool { return record.ID == id })
+
+	return len(r.records) < count
+}
+
+// Replace replaces all the TXT records of a FQDN (like an RRSet update).
+// An empty list of values deletes the records.
+func (r *Records) Replace(fqdn string, values []string) {
+	r.mu.Lock()
+	fqdn = normalize(fqdn)
+	r.records = slices.DeleteFunc(r.records, func(record Record) bool { return record.FQDN == fqdn })
+	r.mu.Unlock()
+
+	for _, value := range values {
+		r.Add(fqdn, value)
+	}
+}
+
+// List returns all the TXT records.
+func (r *Records) List() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.records)
+}
+
+// Find returns the TXT records of a FQDN.
+func (r *Records) Find(fqdn string) []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fqdn = normalize(fqdn)
+
+	var records []Record
+
+	for _, record := range r.records {
+		if record.FQDN == fqdn {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// Values returns the TXT values of a FQDN.
+func (r *Records) Values(fqdn string) []string {
+	var values []string
+
+	for _, record := range r.Find(fqdn) {
+		values = append(values, record.Value)
+	}
+
+	return values
+}
+
+func normalize(fqdn string) string {
+	return dns.Fqdn(strings.ToLower(fqdn))
+}
@@ -0,0 +1,58 @@
+package conformance
+
+import (
+	"net"
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+// SetupZone starts a local DNS server answering the SOA queries of a zone,
+// and uses it to find the zones until the end of the test.
+// It can be used by the unit tests of a provider calling dns01.FindZoneByFqdn.
+func SetupZone(t *testing.T, zone string) {
+	t.Helper()
+
+	zone = dns.Fqdn(zone)
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+
+		if req.Question[0].Qtype == dns.TypeSOA && dns.CanonicalName(req.Question[0].Name) == dns.CanonicalName(zone) {
+			m.Answer = append(m.Answer, &dns.SOA{
+				Hdr:    dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+				Ns:     "ns." + zone,
+				Mbox:   "hostmaster." + zone,
+				Serial: 1,
+			})
+		}
+
+		_ = w.WriteMsg(m)
+	})
+
+	started := make(chan struct{})
+
+	server := &dns.Server{PacketConn: pc, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	<-started
+
+	nameservers := dns01.RecursiveNameservers()
+
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+
+		_ = dns01.AddRecursiveNameservers(nameservers)(nil)
+		dns01.ClearFqdnCache()
+	})
+
+	dns01.ClearFqdnCache()
+	_ = dns01.AddRecursiveNameservers([]string{pc.LocalAddr().String()})(nil)
+}
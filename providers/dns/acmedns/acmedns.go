@@ -54,13 +54,19 @@ type DNSProvider struct {
 // NewDNSProvider creates an ACME-DNS provider using file based account storage.
 // Its configuration is loaded from the environment by reading EnvAPIBase and EnvStoragePath.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIBase)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIBase)
 	if err != nil {
 		return nil, fmt.Errorf("acme-dns: %w", err)
 	}
 
-	storagePath := env.GetOrFile(EnvStoragePath)
-	storageBaseURL := env.GetOrFile(EnvStorageBaseURL)
+	storagePath := src.GetOrFile(EnvStoragePath)
+	storageBaseURL := src.GetOrFile(EnvStorageBaseURL)
 
 	if storagePath == "" && storageBaseURL == "" {
 		return nil, fmt.Errorf("acme-dns: %s or %s environment variables not set", EnvStoragePath, EnvStorageBaseURL)
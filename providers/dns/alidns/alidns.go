@@ -52,11 +52,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 600),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		HTTPTimeout:        env.GetOrDefaultSecond(EnvHTTPTimeout, 10*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 600),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPTimeout:        src.GetOrDefaultSecond(EnvHTTPTimeout, 10*time.Second),
 	}
 }
 
@@ -71,23 +75,29 @@ type DNSProvider struct {
 // - Other than that, credentials must be passed in the environment variables:
 // ALICLOUD_ACCESS_KEY, ALICLOUD_SECRET_KEY, and optionally ALICLOUD_SECURITY_TOKEN.
 func NewDNSProvider() (*DNSProvider, error) {
-	config := NewDefaultConfig()
-	config.RegionID = env.GetOrFile(EnvRegionID)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
+	config.RegionID = src.GetOrFile(EnvRegionID)
 
-	values, err := env.Get(EnvRAMRole)
+	values, err := src.Get(EnvRAMRole)
 	if err == nil {
 		config.RAMRole = values[EnvRAMRole]
 		return NewDNSProviderConfig(config)
 	}
 
-	values, err = env.Get(EnvAccessKey, EnvSecretKey)
+	values, err = src.Get(EnvAccessKey, EnvSecretKey)
 	if err != nil {
 		return nil, fmt.Errorf("alicloud: %w", err)
 	}
 
 	config.APIKey = values[EnvAccessKey]
 	config.SecretKey = values[EnvSecretKey]
-	config.SecurityToken = env.GetOrFile(EnvSecurityToken)
+	config.SecurityToken = src.GetOrFile(EnvSecurityToken)
 
 	return NewDNSProviderConfig(config)
 }
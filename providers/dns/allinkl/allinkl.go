@@ -41,11 +41,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -64,12 +68,18 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for all-inkl.
 // Credentials must be passed in the environment variable: ALL_INKL_LOGIN, ALL_INKL_PASSWORD.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvLogin, EnvPassword)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvLogin, EnvPassword)
 	if err != nil {
 		return nil, fmt.Errorf("allinkl: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Login = values[EnvLogin]
 	config.Password = values[EnvPassword]
 
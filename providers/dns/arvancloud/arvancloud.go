@@ -42,12 +42,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, minTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, minTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -64,12 +68,18 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for ArvanCloud.
 // Credentials must be passed in the environment variable: ARVANCLOUD_API_KEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIKey)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIKey)
 	if err != nil {
 		return nil, fmt.Errorf("arvancloud: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.APIKey = values[EnvAPIKey]
 
 	return NewDNSProviderConfig(config)
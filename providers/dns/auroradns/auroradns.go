@@ -42,10 +42,14 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 300),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 	}
 }
 
@@ -61,13 +65,19 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // AURORA_API_KEY and AURORA_SECRET.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIKey, EnvSecret)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIKey, EnvSecret)
 	if err != nil {
 		return nil, fmt.Errorf("aurora: %w", err)
 	}
 
-	config := NewDefaultConfig()
-	config.BaseURL = env.GetOrFile(EnvEndpoint)
+	config := newDefaultConfig(src)
+	config.BaseURL = src.GetOrFile(EnvEndpoint)
 	config.APIKey = values[EnvAPIKey]
 	config.Secret = values[EnvSecret]
 
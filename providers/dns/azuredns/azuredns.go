@@ -86,11 +86,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		ZoneName:           env.GetOrFile(EnvZoneName),
-		TTL:                env.GetOrDefaultInt(EnvTTL, 60),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
+		ZoneName:           src.GetOrFile(EnvZoneName),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 60),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
 		Environment:        cloud.AzurePublic,
 	}
 }
@@ -102,9 +106,17 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance configured for azuredns.
 func NewDNSProvider() (*DNSProvider, error) {
-	config := NewDefaultConfig()
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+// The credentials of the "env" and "oidc" authentication methods are read from the source,
+// the other methods rely on the Azure SDK, which reads the environment.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
 
-	environmentName := env.GetOrFile(EnvEnvironment)
+	environmentName := src.GetOrFile(EnvEnvironment)
 	if environmentName != "" {
 		switch environmentName {
 		case "china":
@@ -120,20 +132,20 @@ func NewDNSProvider() (*DNSProvider, error) {
 		config.Environment = cloud.AzurePublic
 	}
 
-	config.SubscriptionID = env.GetOrFile(EnvSubscriptionID)
-	config.ResourceGroup = env.GetOrFile(EnvResourceGroup)
-	config.PrivateZone = env.GetOrDefaultBool(EnvPrivateZone, false)
+	config.SubscriptionID = src.GetOrFile(EnvSubscriptionID)
+	config.ResourceGroup = src.GetOrFile(EnvResourceGroup)
+	config.PrivateZone = src.GetOrDefaultBool(EnvPrivateZone, false)
 
-	config.ClientID = env.GetOrFile(EnvClientID)
-	config.ClientSecret = env.GetOrFile(EnvClientSecret)
-	config.TenantID = env.GetOrFile(EnvTenantID)
+	config.ClientID = src.GetOrFile(EnvClientID)
+	config.ClientSecret = src.GetOrFile(EnvClientSecret)
+	config.TenantID = src.GetOrFile(EnvTenantID)
 
-	config.OIDCToken = env.GetOrFile(EnvOIDCToken)
-	config.OIDCTokenFilePath = env.GetOrFile(EnvOIDCTokenFilePath)
+	config.OIDCToken = src.GetOrFile(EnvOIDCToken)
+	config.OIDCTokenFilePath = src.GetOrFile(EnvOIDCTokenFilePath)
 
-	config.ServiceDiscoveryFilter = env.GetOrFile(EnvServiceDiscoveryFilter)
+	config.ServiceDiscoveryFilter = src.GetOrFile(EnvServiceDiscoveryFilter)
 
-	oidcValues, _ := env.GetWithFallback(
+	oidcValues, _ := src.GetWithFallback(
 		[]string{EnvOIDCRequestURL, EnvGitHubOIDCRequestURL},
 		[]string{EnvOIDCRequestToken, EnvGitHubOIDCRequestToken},
 	)
@@ -141,8 +153,8 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.OIDCRequestURL = oidcValues[EnvOIDCRequestURL]
 	config.OIDCRequestToken = oidcValues[EnvOIDCRequestToken]
 
-	config.AuthMethod = env.GetOrFile(EnvAuthMethod)
-	config.AuthMSITimeout = env.GetOrDefaultSecond(EnvAuthMSITimeout, 2*time.Second)
+	config.AuthMethod = src.GetOrFile(EnvAuthMethod)
+	config.AuthMSITimeout = src.GetOrDefaultSecond(EnvAuthMSITimeout, 2*time.Second)
 
 	return NewDNSProviderConfig(config)
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,16 @@ func TestNewDNSProvider(t *testing.T) {
 	}
 }
 
+func TestNewDNSProviderFrom(t *testing.T) {
+	defer envTest.RestoreEnv()
+	envTest.ClearEnv()
+
+	t.Setenv(EnvEnvironment, "public")
+
+	_, err := NewDNSProviderFrom(env.Values(map[string]string{EnvEnvironment: "foo"}))
+	require.EqualError(t, err, "azuredns: unknown environment foo")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
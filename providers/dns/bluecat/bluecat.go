@@ -52,15 +52,19 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
-		Debug:      env.GetOrDefaultBool(EnvDebug, false),
-		SkipDeploy: env.GetOrDefaultBool(EnvSkipDeploy, false),
+		Debug:      src.GetOrDefaultBool(EnvDebug, false),
+		SkipDeploy: src.GetOrDefaultBool(EnvSkipDeploy, false),
 	}
 }
 
@@ -79,12 +83,18 @@ type DNSProvider struct {
 //   - BLUECAT_CONFIG_NAME (the Configuration name)
 //   - BLUECAT_DNS_VIEW (external DNS View Name)
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvServerURL, EnvUserName, EnvPassword, EnvConfigName, EnvDNSView)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvServerURL, EnvUserName, EnvPassword, EnvConfigName, EnvDNSView)
 	if err != nil {
 		return nil, fmt.Errorf("bluecat: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.BaseURL = values[EnvServerURL]
 	config.UserName = values[EnvUserName]
 	config.Password = values[EnvPassword]
@@ -44,6 +44,9 @@ const (
 )
 
 var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+var _ challenge.BatchProvider = (*DNSProvider)(nil)
+var _ dns01.RecordLister = (*DNSProvider)(nil)
+var _ challenge.Checker = (*DNSProvider)(nil)
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
@@ -61,12 +64,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOneWithFallback(EnvTTL, minTTL, strconv.Atoi, altEnvName(EnvTTL)),
-		PropagationTimeout: env.GetOneWithFallback(EnvPropagationTimeout, 2*time.Minute, env.ParseSecond, altEnvName(EnvPropagationTimeout)),
-		PollingInterval:    env.GetOneWithFallback(EnvPollingInterval, dns01.DefaultPollingInterval, env.ParseSecond, altEnvName(EnvPollingInterval)),
+		TTL:                env.GetOneWithFallbackFrom(src, EnvTTL, minTTL, strconv.Atoi, altEnvName(EnvTTL)),
+		PropagationTimeout: env.GetOneWithFallbackFrom(src, EnvPropagationTimeout, 2*time.Minute, env.ParseSecond, altEnvName(EnvPropagationTimeout)),
+		PollingInterval:    env.GetOneWithFallbackFrom(src, EnvPollingInterval, dns01.DefaultPollingInterval, env.ParseSecond, altEnvName(EnvPollingInterval)),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOneWithFallback(EnvHTTPTimeout, 30*time.Second, env.ParseSecond, altEnvName(EnvHTTPTimeout)),
+			Timeout: env.GetOneWithFallbackFrom(src, EnvHTTPTimeout, 30*time.Second, env.ParseSecond, altEnvName(EnvHTTPTimeout)),
 		},
 	}
 }
@@ -93,13 +100,19 @@ type DNSProvider struct {
 // You can split the Zone:Read and DNS:Edit permissions across multiple API tokens:
 // in this case pass both CLOUDFLARE_ZONE_API_TOKEN and CLOUDFLARE_DNS_API_TOKEN accordingly.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.GetWithFallback(
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.GetWithFallback(
 		[]string{EnvEmail, altEnvEmail},
 		[]string{EnvAPIKey, altEnvName(EnvAPIKey)},
 	)
 	if err != nil {
 		var errT error
-		values, errT = env.GetWithFallback(
+		values, errT = src.GetWithFallback(
 			[]string{EnvDNSAPIToken, altEnvName(EnvDNSAPIToken)},
 			[]string{EnvZoneAPIToken, altEnvName(EnvZoneAPIToken), EnvDNSAPIToken, altEnvName(EnvDNSAPIToken)},
 		)
@@ -109,7 +122,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 		}
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.AuthEmail = values[EnvEmail]
 	config.AuthKey = values[EnvAPIKey]
 	config.AuthToken = values[EnvDNSAPIToken]
@@ -146,6 +159,34 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
 
+// Check verifies the credentials and the access to the zone of the domain.
+func (d *DNSProvider) Check(domain string) error {
+	info := dns01.GetChallengeInfo(domain, "")
+
+	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("cloudflare: could not find zone for domain %q: %w", domain, err)
+	}
+
+	zoneID, err := d.client.ZoneIDByName(authZone)
+	if err != nil {
+		return fmt.Errorf("cloudflare: failed to find zone %s: %w", authZone, err)
+	}
+
+	params := cloudflare.ListDNSRecordsParams{
+		Type:       "TXT",
+		Name:       dns01.UnFqdn(info.EffectiveFQDN),
+		ResultInfo: cloudflare.ResultInfo{PerPage: 1},
+	}
+
+	_, _, err = d.client.DNSRecords(context.Background(), zoneID, params)
+	if err != nil {
+		return fmt.Errorf("cloudflare: failed to list the TXT records of the zone %s: %w", authZone, err)
+	}
+
+	return nil
+}
+
 // Present creates a TXT record to fulfill the dns-01 challenge.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
@@ -205,7 +246,7 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	err = d.client.DeleteDNSRecord(context.Background(), zoneID, recordID)
 	if err != nil {
-		log.Printf("cloudflare: failed to delete TXT record: %w", err)
+		log.Printf("cloudflare: failed to delete TXT record: %v", err)
 	}
 
 	// Delete record ID from map
@@ -216,6 +257,168 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+// PresentBatch creates the TXT records of several challenges, with one batch request by zone.
+func (d *DNSProvider) PresentBatch(records []challenge.BatchRecord) error {
+	zones, err := d.groupByZone(records)
+	if err != nil {
+		return fmt.Errorf("cloudflare: %w", err)
+	}
+
+	for _, zone := range zones {
+		batch := batchRequest{}
+
+		for _, record := range zone.records {
+			info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+			batch.Posts = append(batch.Posts, batchRecord{
+				Type:    "TXT",
+				Name:    dns01.UnFqdn(info.EffectiveFQDN),
+				Content: info.Value,
+				TTL:     d.config.TTL,
+			})
+		}
+
+		result, err := d.client.BatchDNSRecords(context.Background(), zone.id, batch)
+		if err != nil {
+			return fmt.Errorf("cloudflare: failed to create TXT records: %w", err)
+		}
+
+		if len(result.Posts) != len(zone.records) {
+			return fmt.Errorf("cloudflare: unexpected number of created records: %d, expected %d", len(result.Posts), len(zone.records))
+		}
+
+		d.recordIDsMu.Lock()
+		for i, record := range zone.records {
+			d.recordIDs[record.Token] = result.Posts[i].ID
+		}
+		d.recordIDsMu.Unlock()
+
+		for i, record := range zone.records {
+			log.Infof("cloudflare: new record for %s, ID %s", record.Domain, result.Posts[i].ID)
+		}
+	}
+
+	return nil
+}
+
+// CleanUpBatch removes the TXT records of several challenges, with one batch request by zone.
+func (d *DNSProvider) CleanUpBatch(records []challenge.BatchRecord) error {
+	zones, err := d.groupByZone(records)
+	if err != nil {
+		return fmt.Errorf("cloudflare: %w", err)
+	}
+
+	for _, zone := range zones {
+		batch := batchRequest{}
+
+		d.recordIDsMu.Lock()
+		for _, record := range zone.records {
+			recordID, ok := d.recordIDs[record.Token]
+			if !ok {
+				continue
+			}
+
+			batch.Deletes = append(batch.Deletes, batchRecord{ID: recordID})
+
+			delete(d.recordIDs, record.Token)
+		}
+		d.recordIDsMu.Unlock()
+
+		if len(batch.Deletes) == 0 {
+			continue
+		}
+
+		_, err = d.client.BatchDNSRecords(context.Background(), zone.id, batch)
+		if err != nil {
+			log.Printf("cloudflare: failed to delete TXT records: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ListChallengeRecords returns the `_acme-challenge` TXT records of a zone.
+func (d *DNSProvider) ListChallengeRecords(zone string) ([]dns01.ChallengeRecord, error) {
+	zoneID, err := d.client.ZoneIDByName(dns01.ToFqdn(zone))
+	if err != nil {
+		return nil, fmt.Errorf("cloudflare: failed to find zone %s: %w", zone, err)
+	}
+
+	records, _, err := d.client.DNSRecords(context.Background(), zoneID, cloudflare.ListDNSRecordsParams{Type: "TXT"})
+	if err != nil {
+		return nil, fmt.Errorf("cloudflare: failed to list TXT records: %w", err)
+	}
+
+	var challengeRecords []dns01.ChallengeRecord
+
+	for _, record := range records {
+		if !dns01.IsChallengeFQDN(record.Name) {
+			continue
+		}
+
+		challengeRecords = append(challengeRecords, dns01.ChallengeRecord{
+			ID:        record.ID,
+			FQDN:      dns01.ToFqdn(record.Name),
+			Value:     record.Content,
+			CreatedAt: record.CreatedOn,
+		})
+	}
+
+	return challengeRecords, nil
+}
+
+// DeleteChallengeRecord deletes a record returned by ListChallengeRecords.
+func (d *DNSProvider) DeleteChallengeRecord(zone string, record dns01.ChallengeRecord) error {
+	zoneID, err := d.client.ZoneIDByName(dns01.ToFqdn(zone))
+	if err != nil {
+		return fmt.Errorf("cloudflare: failed to find zone %s: %w", zone, err)
+	}
+
+	err = d.client.DeleteDNSRecord(context.Background(), zoneID, record.ID)
+	if err != nil {
+		return fmt.Errorf("cloudflare: failed to delete TXT record: %w", err)
+	}
+
+	return nil
+}
+
+// zoneRecords holds the records of a batch for one zone.
+type zoneRecords struct {
+	id      string
+	records []challenge.BatchRecord
+}
+
+func (d *DNSProvider) groupByZone(records []challenge.BatchRecord) ([]*zoneRecords, error) {
+	var zones []*zoneRecords
+
+	index := make(map[string]*zoneRecords)
+
+	for _, record := range records {
+		info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+		authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+		if err != nil {
+			return nil, fmt.Errorf("could not find zone for domain %q: %w", record.Domain, err)
+		}
+
+		zoneID, err := d.client.ZoneIDByName(authZone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find zone %s: %w", authZone, err)
+		}
+
+		zone, ok := index[zoneID]
+		if !ok {
+			zone = &zoneRecords{id: zoneID}
+			index[zoneID] = zone
+			zones = append(zones, zone)
+		}
+
+		zone.records = append(zone.records, record)
+	}
+
+	return zones, nil
+}
+
 func altEnvName(v string) string {
 	return strings.ReplaceAll(v, envNamespace, altEnvNamespace)
 }
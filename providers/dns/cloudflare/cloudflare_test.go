@@ -1,10 +1,20 @@
 package cloudflare
 
 import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -271,6 +281,188 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_PresentBatch(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	setupSOAServer(t, "example.com.")
+
+	var batches []batchRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /zones/abc/dns_records/batch", func(rw http.ResponseWriter, req *http.Request) {
+		batch := batchRequest{}
+
+		err := json.NewDecoder(req.Body).Decode(&batch)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		batches = append(batches, batch)
+
+		result := batchResult{}
+		for i, post := range batch.Posts {
+			result.Posts = append(result.Posts, cloudflare.DNSRecord{ID: "id" + strconv.Itoa(i), Name: post.Name, Content: post.Content})
+		}
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{"success": true, "result": result})
+	})
+
+	provider := setupTestProvider(t, mux)
+
+	records := []challenge.BatchRecord{
+		{Domain: "example.com", Token: "a", KeyAuth: "123d=="},
+		{Domain: "www.example.com", Token: "b", KeyAuth: "456d=="},
+	}
+
+	err := provider.PresentBatch(records)
+	require.NoError(t, err)
+
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Posts, 2)
+	assert.Equal(t, "_acme-challenge.example.com", batches[0].Posts[0].Name)
+	assert.Equal(t, "_acme-challenge.www.example.com", batches[0].Posts[1].Name)
+	assert.Equal(t, map[string]string{"a": "id0", "b": "id1"}, provider.recordIDs)
+
+	err = provider.CleanUpBatch(records)
+	require.NoError(t, err)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, []batchRecord{{ID: "id0"}, {ID: "id1"}}, batches[1].Deletes)
+	assert.Empty(t, provider.recordIDs)
+}
+
+func TestDNSProvider_ListChallengeRecords(t *testing.T) {
+	var deleted []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /zones/abc/dns_records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("type") != "TXT" {
+			http.Error(rw, "unexpected type", http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{
+			"success": true,
+			"result": []cloudflare.DNSRecord{
+				{ID: "a", Type: "TXT", Name: "_acme-challenge.example.com", Content: "foo", CreatedOn: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+				{ID: "b", Type: "TXT", Name: "example.com", Content: "v=spf1 -all"},
+				{ID: "c", Type: "TXT", Name: "_acme-challenge.www.example.com", Content: "bar"},
+			},
+			"result_info": map[string]any{"page": 1, "per_page": 100, "total_pages": 1, "count": 3, "total_count": 3},
+		})
+	})
+	mux.HandleFunc("DELETE /zones/abc/dns_records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		deleted = append(deleted, req.PathValue("id"))
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{"success": true, "result": map[string]any{"id": req.PathValue("id")}})
+	})
+
+	provider := setupTestProvider(t, mux)
+
+	records, err := provider.ListChallengeRecords("example.com")
+	require.NoError(t, err)
+
+	expected := []dns01.ChallengeRecord{
+		{ID: "a", FQDN: "_acme-challenge.example.com.", Value: "foo", CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{ID: "c", FQDN: "_acme-challenge.www.example.com.", Value: "bar"},
+	}
+
+	assert.Equal(t, expected, records)
+
+	err = provider.DeleteChallengeRecord("example.com", records[0])
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a"}, deleted)
+}
+
+func TestDNSProvider_Check(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	setupSOAServer(t, "example.com.")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /zones/abc/dns_records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("name") != "_acme-challenge.www.example.com" {
+			http.Error(rw, "unexpected name", http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{"success": true, "result": []cloudflare.DNSRecord{}})
+	})
+	mux.HandleFunc("GET /zones/def/dns_records", func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(rw).Encode(map[string]any{"success": false, "errors": []map[string]any{{"code": 10000, "message": "Authentication error"}}})
+	})
+
+	provider := setupTestProvider(t, mux)
+
+	err := provider.Check("www.example.com")
+	require.NoError(t, err)
+
+	provider.client.zones["example.com."] = "def"
+
+	err = provider.Check("www.example.com")
+	require.ErrorContains(t, err, "cloudflare: failed to list the TXT records of the zone example.com.")
+}
+
+func setupTestProvider(t *testing.T, handler http.Handler) *DNSProvider {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("secret", cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+
+	return &DNSProvider{
+		client: &metaClient{
+			clientEdit: client,
+			clientRead: client,
+			zones:      map[string]string{"example.com.": "abc"},
+			zonesMu:    &sync.RWMutex{},
+		},
+		config:    NewDefaultConfig(),
+		recordIDs: make(map[string]string),
+	}
+}
+
+// setupSOAServer starts a DNS server answering the SOA of the zone, and uses it as recursive nameserver.
+func setupSOAServer(t *testing.T, zone string) {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	handler := dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+
+		if req.Question[0].Qtype == dns.TypeSOA && req.Question[0].Name == zone {
+			m.Answer = append(m.Answer, &dns.SOA{
+				Hdr:    dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+				Ns:     "ns." + zone,
+				Mbox:   "hostmaster." + zone,
+				Serial: 1,
+			})
+		}
+
+		_ = w.WriteMsg(m)
+	})
+
+	started := make(chan struct{})
+
+	server := &dns.Server{PacketConn: pc, Handler: handler, NotifyStartedFunc: func() { close(started) }}
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	<-started
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	dns01.AddRecursiveNameservers([]string{pc.LocalAddr().String()})(nil)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
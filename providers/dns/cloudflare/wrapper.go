@@ -2,6 +2,9 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -71,6 +74,24 @@ func (m *metaClient) DeleteDNSRecord(ctx context.Context, zoneID, recordID strin
 	return m.clientEdit.DeleteDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), recordID)
 }
 
+// BatchDNSRecords creates and deletes several DNS records in one call.
+// https://developers.cloudflare.com/api/resources/dns/subresources/records/methods/batch/
+func (m *metaClient) BatchDNSRecords(ctx context.Context, zoneID string, batch batchRequest) (*batchResult, error) {
+	resp, err := m.clientEdit.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records/batch", zoneID), batch, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &batchResult{}
+
+	err = json.Unmarshal(resp.Result, result)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal batch result: %w", err)
+	}
+
+	return result, nil
+}
+
 func (m *metaClient) ZoneIDByName(fdqn string) (string, error) {
 	m.zonesMu.RLock()
 	id := m.zones[fdqn]
@@ -90,3 +111,21 @@ func (m *metaClient) ZoneIDByName(fdqn string) (string, error) {
 	m.zonesMu.Unlock()
 	return id, nil
 }
+
+type batchRequest struct {
+	Deletes []batchRecord `json:"deletes,omitempty"`
+	Posts   []batchRecord `json:"posts,omitempty"`
+}
+
+type batchRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content,omitempty"`
+	TTL     int    `json:"ttl,omitempty"`
+}
+
+type batchResult struct {
+	Deletes []cloudflare.DNSRecord `json:"deletes"`
+	Posts   []cloudflare.DNSRecord `json:"posts"`
+}
@@ -45,12 +45,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 60),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 180*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 60),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 180*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -65,22 +69,28 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // CLOUDNS_AUTH_ID and CLOUDNS_AUTH_PASSWORD.
 func NewDNSProvider() (*DNSProvider, error) {
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
 	var subAuthID string
-	authID := env.GetOrFile(EnvAuthID)
+	authID := src.GetOrFile(EnvAuthID)
 	if authID == "" {
-		subAuthID = env.GetOrFile(EnvSubAuthID)
+		subAuthID = src.GetOrFile(EnvSubAuthID)
 	}
 
 	if authID == "" && subAuthID == "" {
 		return nil, fmt.Errorf("ClouDNS: some credentials information are missing: %s or %s", EnvAuthID, EnvSubAuthID)
 	}
 
-	values, err := env.Get(EnvAuthPassword)
+	values, err := src.Get(EnvAuthPassword)
 	if err != nil {
 		return nil, fmt.Errorf("ClouDNS: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.AuthID = authID
 	config.SubAuthID = subAuthID
 	config.AuthPassword = values[EnvAuthPassword]
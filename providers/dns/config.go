@@ -0,0 +1,100 @@
+package dns
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/platform/config/env"
+)
+
+// NewDNSChallengeProviderByNameWithConfig creates a DNS provider from configuration values instead of the environment.
+// The values are keyed by the names of the environment variables of the provider (i.e. "CF_DNS_API_TOKEN"),
+// and the `_FILE` suffix is supported (i.e. "CF_DNS_API_TOKEN_FILE").
+//
+// The environment variables are not used,
+// so several providers of the same type can be created with different configurations (i.e. two Cloudflare accounts).
+// The names unknown to the descriptor of the provider are rejected.
+// Several provider names separated by "+" (i.e. "hetzner+cloudflare") share the same values.
+//
+// The providers configured only through a third-party client reading the environment (i.e. the AWS SDK of lightsail) are rejected.
+func NewDNSChallengeProviderByNameWithConfig(name string, values map[string]string) (challenge.Provider, error) {
+	err := checkConfigValues(name, values)
+	if err != nil {
+		return nil, err
+	}
+
+	src := env.Values(values)
+
+	newProvider := func(name string) (challenge.Provider, error) {
+		return newDNSProviderFrom(name, src)
+	}
+
+	if strings.Contains(name, fanOutSeparator) {
+		return newFanOutProviderByName(name, newProvider)
+	}
+
+	return newProvider(name)
+}
+
+// ReadConfigFile reads the configuration values of a DNS provider from a section of a TOML file.
+// The values can be used with NewDNSChallengeProviderByNameWithConfig.
+//
+//	[cloudflare-production]
+//	CF_DNS_API_TOKEN = "xxx"
+//	CLOUDFLARE_TTL = 300
+func ReadConfigFile(filename, section string) (map[string]string, error) {
+	var content map[string]any
+
+	_, err := toml.DecodeFile(filename, &content)
+	if err != nil {
+		return nil, fmt.Errorf("DNS configuration: %w", err)
+	}
+
+	raw, ok := content[section].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("DNS configuration: section %q not found in %s", section, filename)
+	}
+
+	values := make(map[string]string, len(raw))
+
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			values[key] = v
+		case int64, float64, bool:
+			values[key] = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("DNS configuration: section %q: unsupported value for %s: %T", section, key, value)
+		}
+	}
+
+	return values, nil
+}
+
+// checkConfigValues checks that the values are known by the descriptors of the providers.
+func checkConfigValues(name string, values map[string]string) error {
+	var known []string
+
+	for _, n := range strings.Split(name, fanOutSeparator) {
+		known = append(known, dnsProviderEnvVars(strings.TrimSpace(n))...)
+	}
+
+	var unknown []string
+
+	for key := range values {
+		if !slices.Contains(known, strings.TrimSuffix(key, "_FILE")) {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+
+		return fmt.Errorf("unknown configuration values for the DNS provider %q: %s", name, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package dns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDNSChallengeProviderByNameWithConfig(t *testing.T) {
+	t.Setenv("HETZNER_API_KEY", "from-env")
+	t.Setenv("HETZNER_PROPAGATION_TIMEOUT", "42")
+
+	providerA, err := NewDNSChallengeProviderByNameWithConfig("hetzner", map[string]string{
+		"HETZNER_API_KEY":             "secret-a",
+		"HETZNER_PROPAGATION_TIMEOUT": "300",
+	})
+	require.NoError(t, err)
+
+	providerB, err := NewDNSChallengeProviderByNameWithConfig("hetzner", map[string]string{
+		"HETZNER_API_KEY": "secret-b",
+	})
+	require.NoError(t, err)
+
+	timeout, _ := providerA.(challenge.ProviderTimeout).Timeout()
+	assert.Equal(t, 300*time.Second, timeout)
+
+	// The environment variables of the provider are ignored.
+	timeout, _ = providerB.(challenge.ProviderTimeout).Timeout()
+	assert.Equal(t, 120*time.Second, timeout)
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_missingCredentials(t *testing.T) {
+	t.Setenv("HETZNER_API_KEY", "from-env")
+
+	_, err := NewDNSChallengeProviderByNameWithConfig("hetzner", map[string]string{})
+	require.EqualError(t, err, "hetzner: some credentials information are missing: HETZNER_API_KEY")
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_file(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(file, []byte("secret\n"), 0o600))
+
+	provider, err := NewDNSChallengeProviderByNameWithConfig("hetzner", map[string]string{
+		"HETZNER_API_KEY_FILE": file,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, provider)
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_unknownValues(t *testing.T) {
+	_, err := NewDNSChallengeProviderByNameWithConfig("hetzner", map[string]string{
+		"HETZNER_API_KEY": "secret",
+		"HETZNER_FOO":     "foo",
+		"CF_API_KEY":      "bar",
+	})
+	require.EqualError(t, err, `unknown configuration values for the DNS provider "hetzner": CF_API_KEY, HETZNER_FOO`)
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_fanOut(t *testing.T) {
+	provider, err := NewDNSChallengeProviderByNameWithConfig("hetzner+digitalocean", map[string]string{
+		"HETZNER_API_KEY":      "secret-a",
+		"DO_AUTH_TOKEN":        "secret-b",
+		"DO_HTTP_TIMEOUT":      "10",
+		"HETZNER_HTTP_TIMEOUT": "10",
+	})
+	require.NoError(t, err)
+
+	assert.IsType(t, &FanOutProvider{}, provider)
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_unsupported(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		name     string
+		values   map[string]string
+		expected string
+	}{
+		{
+			desc:     "SDK configuration",
+			name:     "lightsail",
+			values:   map[string]string{"AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret"},
+			expected: `the DNS provider "lightsail" cannot be configured from values, only from the environment`,
+		},
+		{
+			desc:     "SDK only variable",
+			name:     "route53",
+			values:   map[string]string{"AWS_PROFILE": "foo"},
+			expected: "route53: AWS_PROFILE is read by the AWS SDK from the environment only",
+		},
+		{
+			desc:     "manual",
+			name:     "manual",
+			expected: `the DNS provider "manual" cannot be configured from values, only from the environment`,
+		},
+		{
+			desc:     "fan-out",
+			name:     "hetzner+oraclecloud",
+			values:   map[string]string{"HETZNER_API_KEY": "secret"},
+			expected: `fan-out: the DNS provider "oraclecloud" cannot be configured from values, only from the environment`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := NewDNSChallengeProviderByNameWithConfig(test.name, test.values)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_staticCredentials(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		name   string
+		values map[string]string
+	}{
+		{
+			desc:   "route53",
+			name:   "route53",
+			values: map[string]string{"AWS_ACCESS_KEY_ID": "id", "AWS_SECRET_ACCESS_KEY": "secret", "AWS_REGION": "us-east-1"},
+		},
+		{
+			desc:   "joker",
+			name:   "joker",
+			values: map[string]string{"JOKER_API_MODE": "SVC", "JOKER_USERNAME": "user", "JOKER_PASSWORD": "secret"},
+		},
+		{
+			desc:   "gcloud",
+			name:   "gcloud",
+			values: map[string]string{"GCE_PROJECT": "project", "GCE_SERVICE_ACCOUNT": `{"type":"service_account","client_email":"lego@example.com","private_key":"","token_uri":"https://oauth2.googleapis.com/token"}`},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, err := NewDNSChallengeProviderByNameWithConfig(test.name, test.values)
+			require.NoError(t, err)
+			assert.NotNil(t, provider)
+		})
+	}
+}
+
+func TestNewDNSChallengeProviderByNameWithConfig_exec(t *testing.T) {
+	t.Setenv("EXEC_MODE", "RAW")
+
+	_, err := NewDNSChallengeProviderByNameWithConfig("exec", map[string]string{"EXEC_PATH": "/bin/true", "EXEC_FOO": "foo"})
+	require.EqualError(t, err, `unknown configuration values for the DNS provider "exec": EXEC_FOO`)
+
+	_, err = NewDNSChallengeProviderByNameWithConfig("exec", map[string]string{"EXEC_MODE": "JSON"})
+	require.EqualError(t, err, "exec: some credentials information are missing: EXEC_PATH")
+}
+
+// writeConfigFile writes a configuration file.
+// The file is not a fixture because the TOML files of this directory are the descriptors of the providers.
+func writeConfigFile(t *testing.T) string {
+	t.Helper()
+
+	content := `
+[hetzner-a]
+HETZNER_API_KEY = "secret-a"
+HETZNER_PROPAGATION_TIMEOUT = 300
+HETZNER_POLLING_INTERVAL = 10
+
+[hetzner-b]
+HETZNER_API_KEY = "secret-b"
+
+[invalid]
+HETZNER_API_KEY = ["a", "b"]
+`
+
+	filename := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+
+	return filename
+}
+
+func TestReadConfigFile(t *testing.T) {
+	values, err := ReadConfigFile(writeConfigFile(t), "hetzner-a")
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"HETZNER_API_KEY":             "secret-a",
+		"HETZNER_PROPAGATION_TIMEOUT": "300",
+		"HETZNER_POLLING_INTERVAL":    "10",
+	}
+
+	assert.Equal(t, expected, values)
+}
+
+func TestReadConfigFile_error(t *testing.T) {
+	filename := writeConfigFile(t)
+
+	testCases := []struct {
+		desc     string
+		section  string
+		expected string
+	}{
+		{
+			desc:     "missing section",
+			section:  "hetzner-c",
+			expected: `DNS configuration: section "hetzner-c" not found in ` + filename,
+		},
+		{
+			desc:     "unsupported value",
+			section:  "invalid",
+			expected: `DNS configuration: section "invalid": unsupported value for HETZNER_API_KEY: []interface {}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadConfigFile(filename, test.section)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
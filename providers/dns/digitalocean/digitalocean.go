@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -30,6 +31,8 @@ const (
 )
 
 var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+var _ dns01.RecordLister = (*DNSProvider)(nil)
+var _ challenge.Checker = (*DNSProvider)(nil)
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
@@ -43,13 +46,17 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		BaseURL:            env.GetOrDefaultString(EnvAPIUrl, internal.DefaultBaseURL),
-		TTL:                env.GetOrDefaultInt(EnvTTL, 30),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
+		BaseURL:            src.GetOrDefaultString(EnvAPIUrl, internal.DefaultBaseURL),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 30),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -67,12 +74,18 @@ type DNSProvider struct {
 // Ocean. Credentials must be passed in the environment variable:
 // DO_AUTH_TOKEN.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAuthToken)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAuthToken)
 	if err != nil {
 		return nil, fmt.Errorf("digitalocean: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.AuthToken = values[EnvAuthToken]
 
 	return NewDNSProviderConfig(config)
@@ -111,6 +124,23 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
 
+// Check verifies the credentials and the access to the zone of the domain.
+func (d *DNSProvider) Check(domain string) error {
+	info := dns01.GetChallengeInfo(domain, "")
+
+	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("digitalocean: could not find zone for domain %q: %w", domain, err)
+	}
+
+	_, err = d.client.GetTxtRecords(context.Background(), authZone)
+	if err != nil {
+		return fmt.Errorf("digitalocean: %w", err)
+	}
+
+	return nil
+}
+
 // Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
@@ -120,9 +150,27 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("digitalocean: could not find zone for domain %q: %w", domain, err)
 	}
 
+	ctx := context.Background()
+
+	existingRecords, err := d.client.GetTxtRecords(ctx, authZone)
+	if err != nil {
+		return fmt.Errorf("digitalocean: %w", err)
+	}
+
+	// The API creates a new record even if the value already exists.
+	for _, existing := range existingRecords {
+		if recordFQDN(existing, authZone) == info.EffectiveFQDN && existing.Data == info.Value {
+			d.recordIDsMu.Lock()
+			d.recordIDs[token] = existing.ID
+			d.recordIDsMu.Unlock()
+
+			return nil
+		}
+	}
+
 	record := internal.Record{Type: "TXT", Name: info.EffectiveFQDN, Data: info.Value, TTL: d.config.TTL}
 
-	respData, err := d.client.AddTxtRecord(context.Background(), authZone, record)
+	respData, err := d.client.AddTxtRecord(ctx, authZone, record)
 	if err != nil {
 		return fmt.Errorf("digitalocean: %w", err)
 	}
@@ -163,3 +211,56 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	return nil
 }
+
+// ListChallengeRecords returns the `_acme-challenge` TXT records of a zone.
+// DigitalOcean doesn't provide the creation time of the records.
+func (d *DNSProvider) ListChallengeRecords(zone string) ([]dns01.ChallengeRecord, error) {
+	authZone := dns01.ToFqdn(zone)
+
+	records, err := d.client.GetTxtRecords(context.Background(), authZone)
+	if err != nil {
+		return nil, fmt.Errorf("digitalocean: %w", err)
+	}
+
+	var challengeRecords []dns01.ChallengeRecord
+
+	for _, record := range records {
+		fqdn := recordFQDN(record, authZone)
+
+		if !dns01.IsChallengeFQDN(fqdn) {
+			continue
+		}
+
+		challengeRecords = append(challengeRecords, dns01.ChallengeRecord{
+			ID:    strconv.Itoa(record.ID),
+			FQDN:  fqdn,
+			Value: record.Data,
+		})
+	}
+
+	return challengeRecords, nil
+}
+
+// DeleteChallengeRecord deletes a record returned by ListChallengeRecords.
+func (d *DNSProvider) DeleteChallengeRecord(zone string, record dns01.ChallengeRecord) error {
+	recordID, err := strconv.Atoi(record.ID)
+	if err != nil {
+		return fmt.Errorf("digitalocean: invalid record ID %q: %w", record.ID, err)
+	}
+
+	err = d.client.RemoveTxtRecord(context.Background(), dns01.ToFqdn(zone), recordID)
+	if err != nil {
+		return fmt.Errorf("digitalocean: %w", err)
+	}
+
+	return nil
+}
+
+// recordFQDN returns the FQDN of a record: the names of the records are relative to the zone.
+func recordFQDN(record internal.Record, authZone string) string {
+	if record.Name == "@" {
+		return authZone
+	}
+
+	return record.Name + "." + authZone
+}
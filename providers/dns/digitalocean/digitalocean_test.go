@@ -2,13 +2,20 @@ package digitalocean
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/platform/tester/conformance"
+	"github.com/go-acme/lego/v4/providers/dns/digitalocean/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -113,8 +120,11 @@ func TestNewDNSProviderConfig(t *testing.T) {
 func TestDNSProvider_Present(t *testing.T) {
 	provider, mux := setupTest(t)
 
-	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
-		assert.Equal(t, http.MethodPost, r.Method, "method")
+	mux.HandleFunc("GET /v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"domain_records":[],"links":{}}`))
+	})
+
+	mux.HandleFunc("POST /v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
 
 		assert.Equal(t, "application/json", r.Header.Get("Accept"), "Accept")
 		assert.Equal(t, "application/json", r.Header.Get("Content-Type"), "Content-Type")
@@ -173,3 +183,93 @@ func TestDNSProvider_CleanUp(t *testing.T) {
 	err := provider.CleanUp("example.com", "token", "")
 	require.NoError(t, err, "fail to remove TXT record")
 }
+
+func TestDNSProvider_ListChallengeRecords(t *testing.T) {
+	provider, mux := setupTest(t)
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "method")
+		assert.Equal(t, "TXT", r.URL.Query().Get("type"), "type")
+
+		_, _ = w.Write([]byte(`{
+  "domain_records": [
+    {"id": 1234567, "type": "TXT", "name": "_acme-challenge", "data": "foo", "ttl": 30},
+    {"id": 1234568, "type": "TXT", "name": "_acme-challenge.www", "data": "bar", "ttl": 30},
+    {"id": 1234569, "type": "TXT", "name": "@", "data": "v=spf1 -all", "ttl": 1800}
+  ],
+  "links": {}
+}`))
+	})
+
+	records, err := provider.ListChallengeRecords("example.com")
+	require.NoError(t, err)
+
+	expected := []dns01.ChallengeRecord{
+		{ID: "1234567", FQDN: "_acme-challenge.example.com.", Value: "foo"},
+		{ID: "1234568", FQDN: "_acme-challenge.www.example.com.", Value: "bar"},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformance.Suite{
+		NewProvider: func(t *testing.T, records *conformance.Records) challenge.Provider {
+			t.Helper()
+
+			provider, mux := setupTest(t)
+
+			mux.HandleFunc("GET /v2/domains/{zone}/records", func(w http.ResponseWriter, req *http.Request) {
+				zone := dns01.ToFqdn(req.PathValue("zone"))
+
+				data := internal.RecordsResponse{DomainRecords: []internal.Record{}}
+
+				for _, record := range records.List() {
+					name, ok := strings.CutSuffix(record.FQDN, "."+zone)
+					if !ok {
+						continue
+					}
+
+					id, _ := strconv.Atoi(record.ID)
+
+					data.DomainRecords = append(data.DomainRecords, internal.Record{ID: id, Type: "TXT", Name: name, Data: record.Value})
+				}
+
+				_ = json.NewEncoder(w).Encode(data)
+			})
+
+			mux.HandleFunc("POST /v2/domains/{zone}/records", func(w http.ResponseWriter, req *http.Request) {
+				var record internal.Record
+
+				err := json.NewDecoder(req.Body).Decode(&record)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				fqdn := record.Name
+				if !strings.HasSuffix(fqdn, ".") {
+					fqdn += "." + req.PathValue("zone")
+				}
+
+				stored := records.Add(fqdn, record.Data)
+
+				record.ID, _ = strconv.Atoi(stored.ID)
+
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(internal.TxtRecordResponse{DomainRecord: record})
+			})
+
+			mux.HandleFunc("DELETE /v2/domains/{zone}/records/{id}", func(w http.ResponseWriter, req *http.Request) {
+				if !records.Delete(req.PathValue("id")) {
+					http.Error(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`, http.StatusNotFound)
+					return
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			return provider
+		},
+	})
+}
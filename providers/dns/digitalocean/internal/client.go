@@ -64,6 +64,38 @@ func (c *Client) RemoveTxtRecord(ctx context.Context, zone string, recordID int)
 	return c.do(req, nil)
 }
 
+// GetTxtRecords returns all the TXT records of a zone.
+func (c *Client) GetTxtRecords(ctx context.Context, zone string) ([]Record, error) {
+	endpoint := c.BaseURL.JoinPath("v2", "domains", dns01.UnFqdn(zone), "records")
+
+	var records []Record
+
+	for page := 1; ; page++ {
+		query := endpoint.Query()
+		query.Set("type", "TXT")
+		query.Set("per_page", "200")
+		query.Set("page", strconv.Itoa(page))
+		endpoint.RawQuery = query.Encode()
+
+		req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		respData := &RecordsResponse{}
+		err = c.do(req, respData)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, respData.DomainRecords...)
+
+		if respData.Links == nil || respData.Links.Pages == nil || respData.Links.Pages.Next == "" {
+			return records, nil
+		}
+	}
+}
+
 func (c *Client) do(req *http.Request, result any) error {
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
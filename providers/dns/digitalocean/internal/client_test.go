@@ -112,6 +112,38 @@ func TestClient_AddTxtRecord(t *testing.T) {
 	assert.Equal(t, expected, newRecord)
 }
 
+func TestClient_GetTxtRecords(t *testing.T) {
+	client := setupTest(t, "/v2/domains/example.com/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		err := checkHeader(req, "Authorization", "Bearer secret")
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		if req.URL.Query().Get("type") != "TXT" {
+			http.Error(rw, fmt.Sprintf("unexpected type: %s", req.URL.Query().Get("type")), http.StatusBadRequest)
+			return
+		}
+
+		writeFixture(rw, "domains-records_GET.json")
+	})
+
+	records, err := client.GetTxtRecords(context.Background(), "example.com.")
+	require.NoError(t, err)
+
+	expected := []Record{
+		{ID: 1234567, Type: "TXT", Name: "_acme-challenge", Data: "w6uP8Tcg6K2QR905Rms8iXTlksL6OD1KOWBxTK7wxPI", TTL: 30},
+		{ID: 1234568, Type: "TXT", Name: "@", Data: "v=spf1 -all", TTL: 1800},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
 func TestClient_RemoveTxtRecord(t *testing.T) {
 	client := setupTest(t, "/v2/domains/example.com/records/1234567", func(rw http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodDelete {
@@ -37,12 +37,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 20*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, 2*time.Minute),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 20*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		SequenceInterval:   src.GetOrDefaultSecond(EnvSequenceInterval, 2*time.Minute),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -56,8 +60,14 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for dnsHome.de.
 // Credentials must be passed in the environment variable: DNSHOMEDE_CREDENTIALS.
 func NewDNSProvider() (*DNSProvider, error) {
-	config := NewDefaultConfig()
-	values, err := env.Get(EnvCredentials)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
+	values, err := src.Get(EnvCredentials)
 	if err != nil {
 		return nil, fmt.Errorf("dnshomede: %w", err)
 	}
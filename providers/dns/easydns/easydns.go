@@ -49,13 +49,17 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		SequenceInterval:   src.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -71,15 +75,21 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance.
 func NewDNSProvider() (*DNSProvider, error) {
-	config := NewDefaultConfig()
+	return NewDNSProviderFrom(env.Environment())
+}
 
-	endpoint, err := url.Parse(env.GetOrDefaultString(EnvEndpoint, internal.DefaultBaseURL))
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
+
+	endpoint, err := url.Parse(src.GetOrDefaultString(EnvEndpoint, internal.DefaultBaseURL))
 	if err != nil {
 		return nil, fmt.Errorf("easydns: %w", err)
 	}
 	config.Endpoint = endpoint
 
-	values, err := env.Get(EnvToken, EnvKey)
+	values, err := src.Get(EnvToken, EnvKey)
 	if err != nil {
 		return nil, fmt.Errorf("easydns: %w", err)
 	}
@@ -123,7 +133,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := d.findZone(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	authZone, zoneRecords, err := d.findZone(ctx, dns01.UnFqdn(info.EffectiveFQDN))
 	if err != nil {
 		return fmt.Errorf("easydns: %w", err)
 	}
@@ -137,6 +147,19 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("easydns: %w", err)
 	}
 
+	key := getMapKey(info.EffectiveFQDN, info.Value)
+
+	// The API creates a new record even if the value already exists.
+	for _, zoneRecord := range zoneRecords {
+		if zoneRecord.Type == "TXT" && zoneRecord.Host == subDomain && strings.Trim(zoneRecord.Rdata, `"`) == info.Value {
+			d.recordIDsMu.Lock()
+			d.recordIDs[key] = zoneRecord.ID
+			d.recordIDsMu.Unlock()
+
+			return nil
+		}
+	}
+
 	record := internal.ZoneRecord{
 		Domain:   authZone,
 		Host:     subDomain,
@@ -151,8 +174,6 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("easydns: error adding zone record: %w", err)
 	}
 
-	key := getMapKey(info.EffectiveFQDN, info.Value)
-
 	d.recordIDsMu.Lock()
 	d.recordIDs[key] = recordID
 	d.recordIDsMu.Unlock()
@@ -176,7 +197,7 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return nil
 	}
 
-	authZone, err := d.findZone(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	authZone, _, err := d.findZone(ctx, dns01.UnFqdn(info.EffectiveFQDN))
 	if err != nil {
 		return fmt.Errorf("easydns: %w", err)
 	}
@@ -214,7 +235,8 @@ func getMapKey(fqdn, value string) string {
 	return fqdn + "|" + value
 }
 
-func (d *DNSProvider) findZone(ctx context.Context, domain string) (string, error) {
+// findZone returns the zone of the domain, and its records.
+func (d *DNSProvider) findZone(ctx context.Context, domain string) (string, []internal.ZoneRecord, error) {
 	var errAll error
 
 	for {
@@ -223,9 +245,9 @@ func (d *DNSProvider) findZone(ctx context.Context, domain string) (string, erro
 			break
 		}
 
-		_, err := d.client.ListZones(ctx, domain)
+		records, err := d.client.ListZones(ctx, domain)
 		if err == nil {
-			return domain, nil
+			return domain, records, nil
 		}
 
 		errAll = errors.Join(errAll, err)
@@ -233,5 +255,5 @@ func (d *DNSProvider) findZone(ctx context.Context, domain string) (string, erro
 		domain = domain[i+1:]
 	}
 
-	return "", errAll
+	return "", nil, errAll
 }
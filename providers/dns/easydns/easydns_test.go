@@ -1,15 +1,20 @@
 package easydns
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/platform/tester/conformance"
+	"github.com/go-acme/lego/v4/providers/dns/easydns/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -385,6 +390,62 @@ func TestDNSProvider_Cleanup_WhenHttpError_ReturnsError(t *testing.T) {
 	require.EqualError(t, err, expectedError)
 }
 
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformance.Suite{
+		NewProvider: func(t *testing.T, records *conformance.Records) challenge.Provider {
+			t.Helper()
+
+			provider, mux := setupTest(t)
+
+			mux.HandleFunc("GET /zones/records/all/{domain}", func(w http.ResponseWriter, req *http.Request) {
+				if req.PathValue("domain") != "example.com" {
+					http.Error(w, `{"error":{"code":404,"message":"No such domain."}}`, http.StatusNotFound)
+					return
+				}
+
+				data := []internal.ZoneRecord{}
+
+				for _, record := range records.List() {
+					host, ok := strings.CutSuffix(record.FQDN, ".example.com.")
+					if !ok {
+						continue
+					}
+
+					data = append(data, internal.ZoneRecord{ID: record.ID, Domain: "example.com", Host: host, Type: "TXT", Rdata: record.Value})
+				}
+
+				_ = json.NewEncoder(w).Encode(map[string]any{"msg": "OK", "status": 200, "data": data})
+			})
+
+			mux.HandleFunc("PUT /zones/records/add/{domain}/TXT", func(w http.ResponseWriter, req *http.Request) {
+				var record internal.ZoneRecord
+
+				err := json.NewDecoder(req.Body).Decode(&record)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				record.ID = records.Add(record.Host+"."+req.PathValue("domain"), record.Rdata).ID
+
+				_ = json.NewEncoder(w).Encode(map[string]any{"msg": "OK", "status": 201, "data": record})
+			})
+
+			mux.HandleFunc("DELETE /zones/records/{domain}/{id}", func(w http.ResponseWriter, req *http.Request) {
+				if !records.Delete(req.PathValue("id")) {
+					http.Error(w, `{"error":{"code":404,"message":"No such record."}}`, http.StatusNotFound)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"msg":"OK","status":200}`))
+			})
+
+			return provider
+		},
+		Sequential: true,
+	})
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
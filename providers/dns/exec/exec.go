@@ -6,14 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"os/exec"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/internal/execprotocol"
 )
 
 // Environment variables names.
@@ -23,17 +24,37 @@ const (
 	EnvPath = envNamespace + "PATH"
 	EnvMode = envNamespace + "MODE"
 
+	EnvTTL                = envNamespace + "TTL"
+	EnvTimeout            = envNamespace + "TIMEOUT"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
 	EnvSequenceInterval   = envNamespace + "SEQUENCE_INTERVAL"
 )
 
-var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+// Modes.
+const (
+	modeRaw  = "RAW"
+	modeJSON = "JSON"
+)
+
+var (
+	_ challenge.ProviderTimeout = (*DNSProvider)(nil)
+	_ challenge.BatchProvider   = (*BatchDNSProvider)(nil)
+)
+
+// findZone finds the zone of a FQDN, sent to the program with the JSON protocol.
+var findZone = dns01.FindZoneByFqdn
 
 // Config Provider configuration.
 type Config struct {
-	Program            string
-	Mode               string
+	Program string
+	Mode    string
+
+	// TTL is the TTL of the records, sent to the program with the JSON protocol.
+	TTL int
+	// Timeout is the time allowed to each call of the program (0: no limit).
+	Timeout time.Duration
+
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	SequenceInterval   time.Duration
@@ -41,31 +62,100 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		Timeout:            src.GetOrDefaultSecond(EnvTimeout, 0),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		SequenceInterval:   src.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
 	}
 }
 
 // DNSProvider implements the challenge.Provider interface.
 type DNSProvider struct {
 	config *Config
+
+	// propagation contains the propagation hints of the program (JSON protocol).
+	propagation   *execprotocol.Propagation
+	propagationMu sync.Mutex
+}
+
+// BatchDNSProvider is a DNSProvider using the JSON protocol,
+// which presents and cleans up the records of an order in one call of the program.
+type BatchDNSProvider struct {
+	*DNSProvider
 }
 
 // NewDNSProvider returns a new DNS provider which runs the program in the
 // environment variable EXEC_PATH for adding and removing the DNS record.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvPath)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config, err := readConfig(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewBatchDNSProvider returns a new DNS provider which runs the program in the
+// environment variable EXEC_PATH for adding and removing the DNS records of an order in one call.
+// The mode (EXEC_MODE) must be JSON.
+func NewBatchDNSProvider() (*BatchDNSProvider, error) {
+	return NewBatchDNSProviderFrom(env.Environment())
+}
+
+// NewBatchDNSProviderFrom returns a BatchDNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewBatchDNSProviderFrom(src *env.Source) (*BatchDNSProvider, error) {
+	config, err := readConfig(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBatchDNSProviderConfig(config)
+}
+
+// NewChallengeProvider returns a BatchDNSProvider when the mode is JSON, a DNSProvider otherwise.
+func NewChallengeProvider() (challenge.ProviderTimeout, error) {
+	return NewChallengeProviderFrom(env.Environment())
+}
+
+// NewChallengeProviderFrom is NewChallengeProvider with the values of the source
+// instead of the environment variables.
+func NewChallengeProviderFrom(src *env.Source) (challenge.ProviderTimeout, error) {
+	config, err := readConfig(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Mode == modeJSON {
+		return NewBatchDNSProviderConfig(config)
+	}
+
+	return NewDNSProviderConfig(config)
+}
+
+func readConfig(src *env.Source) (*Config, error) {
+	values, err := src.Get(EnvPath)
 	if err != nil {
 		return nil, fmt.Errorf("exec: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Program = values[EnvPath]
-	config.Mode = os.Getenv(EnvMode)
+	config.Mode = src.GetOrFile(EnvMode)
 
-	return NewDNSProviderConfig(config)
+	return config, nil
 }
 
 // NewDNSProviderConfig returns a new DNS provider which runs the given configuration
@@ -78,6 +168,22 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	return &DNSProvider{config: config}, nil
 }
 
+// NewBatchDNSProviderConfig returns a new DNS provider which runs the given configuration
+// for adding and removing the DNS records of an order in one call.
+// The mode of the configuration must be JSON.
+func NewBatchDNSProviderConfig(config *Config) (*BatchDNSProvider, error) {
+	provider, err := NewDNSProviderConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Mode != modeJSON {
+		return nil, fmt.Errorf("exec: the batches require the %s mode", modeJSON)
+	}
+
+	return &BatchDNSProvider{DNSProvider: provider}, nil
+}
+
 // Present creates a TXT record to fulfill the dns-01 challenge.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	err := d.run(context.Background(), "present", domain, token, keyAuth)
@@ -98,10 +204,48 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+// PresentBatch creates the TXT records of several challenges in one call of the program.
+func (d *BatchDNSProvider) PresentBatch(records []challenge.BatchRecord) error {
+	err := d.runJSON(context.Background(), execprotocol.ActionPresent, records)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUpBatch removes the TXT records of several challenges in one call of the program.
+func (d *BatchDNSProvider) CleanUpBatch(records []challenge.BatchRecord) error {
+	err := d.runJSON(context.Background(), execprotocol.ActionCleanUp, records)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
 // Adjusting here to cope with spikes in propagation times.
+// The propagation hints returned by the program (JSON protocol) take precedence over the configuration.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
-	return d.config.PropagationTimeout, d.config.PollingInterval
+	timeout, interval = d.config.PropagationTimeout, d.config.PollingInterval
+
+	d.propagationMu.Lock()
+	defer d.propagationMu.Unlock()
+
+	if d.propagation == nil {
+		return timeout, interval
+	}
+
+	if d.propagation.Timeout > 0 {
+		timeout = time.Duration(d.propagation.Timeout) * time.Second
+	}
+
+	if d.propagation.Interval > 0 {
+		interval = time.Duration(d.propagation.Interval) * time.Second
+	}
+
+	return timeout, interval
 }
 
 // Sequential All DNS challenges for this provider will be resolved sequentially.
@@ -111,8 +255,18 @@ func (d *DNSProvider) Sequential() time.Duration {
 }
 
 func (d *DNSProvider) run(ctx context.Context, command, domain, token, keyAuth string) error {
+	if d.config.Mode == modeJSON {
+		return d.runJSON(ctx, command, []challenge.BatchRecord{{Domain: domain, Token: token, KeyAuth: keyAuth}})
+	}
+
+	if d.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.config.Timeout)
+		defer cancel()
+	}
+
 	var args []string
-	if d.config.Mode == "RAW" {
+	if d.config.Mode == modeRaw {
 		args = []string{command, "--", domain, token, keyAuth}
 	} else {
 		info := dns01.GetChallengeInfo(domain, keyAuth)
@@ -145,3 +299,51 @@ func (d *DNSProvider) run(ctx context.Context, command, domain, token, keyAuth s
 
 	return nil
 }
+
+func (d *DNSProvider) runJSON(ctx context.Context, action string, records []challenge.BatchRecord) error {
+	request := execprotocol.Request{
+		Challenge: string(challenge.DNS01),
+		Action:    action,
+	}
+
+	for _, record := range records {
+		info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+		zone, err := findZone(info.EffectiveFQDN)
+		if err != nil {
+			log.Warnf("exec: could not find zone for domain %q: %v", record.Domain, err)
+		}
+
+		request.Records = append(request.Records, execprotocol.Record{
+			Domain:  record.Domain,
+			Token:   record.Token,
+			KeyAuth: record.KeyAuth,
+			FQDN:    info.EffectiveFQDN,
+			Zone:    zone,
+			Value:   info.Value,
+			TTL:     d.config.TTL,
+		})
+	}
+
+	if action == execprotocol.ActionPresent {
+		// The hints of a previous present call must not outlive it.
+		d.setPropagation(nil)
+	}
+
+	response, err := execprotocol.Run(ctx, d.config.Program, d.config.Timeout, request)
+	if err != nil {
+		return err
+	}
+
+	if action == execprotocol.ActionPresent {
+		d.setPropagation(response.Propagation)
+	}
+
+	return nil
+}
+
+func (d *DNSProvider) setPropagation(propagation *execprotocol.Propagation) {
+	d.propagationMu.Lock()
+	d.propagation = propagation
+	d.propagationMu.Unlock()
+}
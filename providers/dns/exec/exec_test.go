@@ -1,12 +1,18 @@
 package exec
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/providers/internal/execprotocol"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -157,3 +163,242 @@ func TestDNSProvider_CleanUp(t *testing.T) {
 		})
 	}
 }
+
+func TestNewChallengeProvider_mode(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		mode     string
+		expected any
+	}{
+		{
+			desc:     "Standard mode",
+			expected: &DNSProvider{},
+		},
+		{
+			desc:     "Raw mode",
+			mode:     "RAW",
+			expected: &DNSProvider{},
+		},
+		{
+			desc:     "JSON mode",
+			mode:     "JSON",
+			expected: &BatchDNSProvider{},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Setenv(EnvPath, "echo")
+			t.Setenv(EnvMode, test.mode)
+
+			provider, err := NewChallengeProvider()
+			require.NoError(t, err)
+
+			assert.IsType(t, test.expected, provider)
+		})
+	}
+}
+
+func TestNewBatchDNSProvider(t *testing.T) {
+	t.Setenv(EnvPath, "echo")
+	t.Setenv(EnvMode, "JSON")
+
+	provider, err := NewBatchDNSProvider()
+	require.NoError(t, err)
+	require.NotNil(t, provider)
+
+	t.Setenv(EnvMode, "RAW")
+
+	_, err = NewBatchDNSProvider()
+	require.EqualError(t, err, "exec: the batches require the JSON mode")
+}
+
+func TestNewBatchDNSProviderConfig(t *testing.T) {
+	_, err := NewBatchDNSProviderConfig(&Config{Program: "echo", Mode: "RAW"})
+	require.EqualError(t, err, "exec: the batches require the JSON mode")
+}
+
+func TestDNSProvider_json(t *testing.T) {
+	setupFindZone(t)
+
+	program, requestFile := writeScript(t, `echo '{"version":1,"propagation":{"timeout":30,"interval":5}}'`)
+
+	config := NewDefaultConfig()
+	config.Program = program
+	config.Mode = "JSON"
+	config.Timeout = 10 * time.Second
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := execprotocol.Request{
+		Version:   execprotocol.Version,
+		Challenge: "dns-01",
+		Action:    "present",
+		Timeout:   10,
+		Records: []execprotocol.Record{{
+			Domain:  "example.com",
+			Token:   "token",
+			KeyAuth: "keyAuth",
+			FQDN:    "_acme-challenge.example.com.",
+			Zone:    "example.com.",
+			Value:   "pW9ZKG0xz_PCriK-nCMOjADy9eJcgGWIzkkj2fN4uZM",
+			TTL:     dns01.DefaultTTL,
+		}},
+	}
+
+	assert.Equal(t, expected, readRequest(t, requestFile))
+
+	timeout, interval := provider.Timeout()
+	assert.Equal(t, 30*time.Second, timeout)
+	assert.Equal(t, 5*time.Second, interval)
+}
+
+func TestDNSProvider_json_error(t *testing.T) {
+	setupFindZone(t)
+
+	program, _ := writeScript(t, `echo '{"version":1,"error":{"message":"invalid credentials"}}'; exit 1`)
+
+	provider, err := NewDNSProviderConfig(&Config{Program: program, Mode: "JSON"})
+	require.NoError(t, err)
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "exec: invalid credentials")
+}
+
+func TestBatchDNSProvider(t *testing.T) {
+	setupFindZone(t)
+
+	program, requestFile := writeScript(t, "")
+
+	config := NewDefaultConfig()
+	config.Program = program
+	config.Mode = "JSON"
+
+	provider, err := NewBatchDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	records := []challenge.BatchRecord{
+		{Domain: "example.com", Token: "token1", KeyAuth: "keyAuth1"},
+		{Domain: "*.example.com", Token: "token2", KeyAuth: "keyAuth2"},
+	}
+
+	for _, action := range []string{"present", "cleanup"} {
+		if action == "present" {
+			err = provider.PresentBatch(records)
+		} else {
+			err = provider.CleanUpBatch(records)
+		}
+		require.NoError(t, err)
+
+		request := readRequest(t, requestFile)
+
+		assert.Equal(t, action, request.Action)
+		require.Len(t, request.Records, 2)
+
+		for i, record := range request.Records {
+			info := dns01.GetChallengeInfo(records[i].Domain, records[i].KeyAuth)
+
+			assert.Equal(t, records[i].Domain, record.Domain)
+			assert.Equal(t, info.EffectiveFQDN, record.FQDN)
+			assert.Equal(t, info.Value, record.Value)
+			assert.Equal(t, "example.com.", record.Zone)
+		}
+	}
+
+	// Without propagation hints, the configuration is used.
+	timeout, interval := provider.Timeout()
+	assert.Equal(t, config.PropagationTimeout, timeout)
+	assert.Equal(t, config.PollingInterval, interval)
+}
+
+func TestDNSProvider_json_propagation(t *testing.T) {
+	setupFindZone(t)
+
+	// The first present call returns hints, the next ones don't, and the cleanup calls always do.
+	program, _ := writeScript(t, `dir=$(dirname "$0")
+if grep -q '"action":"cleanup"' "$dir/request.json"; then
+	echo '{"version":1,"propagation":{"timeout":90,"interval":10}}'
+elif [ -f "$dir/hinted" ]; then
+	echo '{"version":1}'
+else
+	touch "$dir/hinted"
+	echo '{"version":1,"propagation":{"timeout":30,"interval":5}}'
+fi`)
+
+	config := NewDefaultConfig()
+	config.Program = program
+	config.Mode = "JSON"
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	timeout, interval := provider.Timeout()
+	assert.Equal(t, 30*time.Second, timeout)
+	assert.Equal(t, 5*time.Second, interval)
+
+	// The hints of the cleanup responses are ignored.
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	timeout, interval = provider.Timeout()
+	assert.Equal(t, 30*time.Second, timeout)
+	assert.Equal(t, 5*time.Second, interval)
+
+	// A present call without hints falls back to the configuration.
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	timeout, interval = provider.Timeout()
+	assert.Equal(t, config.PropagationTimeout, timeout)
+	assert.Equal(t, config.PollingInterval, interval)
+}
+
+func setupFindZone(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	backup := findZone
+	t.Cleanup(func() { findZone = backup })
+
+	findZone = func(string) (string, error) { return "example.com.", nil }
+}
+
+// writeScript writes an executable shell script in a temporary directory.
+// The request is stored in the file "request.json" of the same directory.
+func writeScript(t *testing.T, body string) (program, requestFile string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	requestFile = filepath.Join(dir, "request.json")
+	program = filepath.Join(dir, "program.sh")
+
+	script := "#!/bin/sh\ncat > " + requestFile + "\n" + body + "\n"
+
+	err := os.WriteFile(program, []byte(script), 0o700)
+	require.NoError(t, err)
+
+	return program, requestFile
+}
+
+func readRequest(t *testing.T, filename string) execprotocol.Request {
+	t.Helper()
+
+	raw, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	var request execprotocol.Request
+
+	err = json.Unmarshal(raw, &request)
+	require.NoError(t, err)
+
+	return request
+}
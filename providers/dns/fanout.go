@@ -0,0 +1,163 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+)
+
+// fanOutSeparator separates the provider names of a fan-out provider (i.e. "route53+cloudflare").
+const fanOutSeparator = "+"
+
+type sequential interface {
+	Sequential() time.Duration
+}
+
+// FanOutProvider is a DNS provider that presents and cleans up the TXT records through several providers.
+// This is useful when a zone is served by several DNS providers (migration, dual-primary DNS, etc.).
+//
+// The TXT record is presented by all the providers or by none:
+// if a provider fails, the record is removed from the providers where it was already presented.
+type FanOutProvider struct {
+	providers []challenge.Provider
+}
+
+// sequentialFanOutProvider is a FanOutProvider with at least one sequential provider.
+type sequentialFanOutProvider struct {
+	*FanOutProvider
+}
+
+// Sequential returns the longest interval of the sequential providers.
+func (f *sequentialFanOutProvider) Sequential() time.Duration {
+	var interval time.Duration
+
+	for _, provider := range f.providers {
+		if p, ok := provider.(sequential); ok {
+			interval = max(interval, p.Sequential())
+		}
+	}
+
+	return interval
+}
+
+// NewFanOutProvider creates a DNS provider that presents and cleans up the TXT records through all the providers.
+// The returned provider is sequential if at least one of the providers is sequential.
+func NewFanOutProvider(providers ...challenge.Provider) (challenge.ProviderTimeout, error) {
+	if len(providers) < 2 {
+		return nil, errors.New("fan-out: at least 2 providers are required")
+	}
+
+	for i, provider := range providers {
+		if provider == nil {
+			return nil, fmt.Errorf("fan-out: the provider %d is nil", i)
+		}
+	}
+
+	f := &FanOutProvider{providers: providers}
+
+	for _, provider := range providers {
+		if _, ok := provider.(sequential); ok {
+			return &sequentialFanOutProvider{FanOutProvider: f}, nil
+		}
+	}
+
+	return f, nil
+}
+
+// Present creates the TXT record through all the providers.
+// If a provider fails, the TXT record is removed from the previous providers.
+func (f *FanOutProvider) Present(domain, token, keyAuth string) error {
+	for i, provider := range f.providers {
+		err := provider.Present(domain, token, keyAuth)
+		if err == nil {
+			continue
+		}
+
+		errs := []error{fmt.Errorf("fan-out: provider %d: %w", i, err)}
+
+		// Rollback.
+		for j := range i {
+			if errC := f.providers[j].CleanUp(domain, token, keyAuth); errC != nil {
+				errs = append(errs, fmt.Errorf("fan-out: rollback: provider %d: %w", j, errC))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record through all the providers.
+func (f *FanOutProvider) CleanUp(domain, token, keyAuth string) error {
+	var errs []error
+
+	for i, provider := range f.providers {
+		if err := provider.CleanUp(domain, token, keyAuth); err != nil {
+			errs = append(errs, fmt.Errorf("fan-out: provider %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Check runs the checks of all the providers.
+func (f *FanOutProvider) Check(domain string) error {
+	var errs []error
+
+	for i, provider := range f.providers {
+		checker, ok := provider.(challenge.Checker)
+		if !ok {
+			continue
+		}
+
+		if err := checker.Check(domain); err != nil {
+			errs = append(errs, fmt.Errorf("fan-out: provider %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Timeout returns the longest timeout and the shortest interval of the providers.
+func (f *FanOutProvider) Timeout() (timeout, interval time.Duration) {
+	for i, provider := range f.providers {
+		t, in := dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+		if p, ok := provider.(challenge.ProviderTimeout); ok {
+			t, in = p.Timeout()
+		}
+
+		if i == 0 {
+			timeout, interval = t, in
+			continue
+		}
+
+		timeout, interval = max(timeout, t), min(interval, in)
+	}
+
+	return timeout, interval
+}
+
+// newFanOutProviderByName creates a FanOutProvider from provider names separated by "+" (i.e. "route53+cloudflare").
+func newFanOutProviderByName(name string, newProvider func(name string) (challenge.Provider, error)) (challenge.Provider, error) {
+	var providers []challenge.Provider
+
+	for _, n := range strings.Split(name, fanOutSeparator) {
+		if strings.TrimSpace(n) == "" {
+			return nil, fmt.Errorf("fan-out: invalid provider name: %q", name)
+		}
+
+		provider, err := newProvider(strings.TrimSpace(n))
+		if err != nil {
+			return nil, fmt.Errorf("fan-out: %w", err)
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return NewFanOutProvider(providers...)
+}
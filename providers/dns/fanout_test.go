@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/providers/dns/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	presentErr error
+	cleanUpErr error
+
+	records map[string]bool
+}
+
+func newFakeProvider() *fakeProvider {
+	return &fakeProvider{records: make(map[string]bool)}
+}
+
+func (p *fakeProvider) Present(domain, _, _ string) error {
+	if p.presentErr != nil {
+		return p.presentErr
+	}
+
+	p.records[domain] = true
+
+	return nil
+}
+
+func (p *fakeProvider) CleanUp(domain, _, _ string) error {
+	if p.cleanUpErr != nil {
+		return p.cleanUpErr
+	}
+
+	delete(p.records, domain)
+
+	return nil
+}
+
+type fakeTimeoutProvider struct {
+	*fakeProvider
+
+	timeout, interval time.Duration
+}
+
+func (p *fakeTimeoutProvider) Timeout() (timeout, interval time.Duration) {
+	return p.timeout, p.interval
+}
+
+type fakeSequentialProvider struct {
+	*fakeProvider
+
+	interval time.Duration
+}
+
+func (p *fakeSequentialProvider) Sequential() time.Duration {
+	return p.interval
+}
+
+func TestFanOutProvider(t *testing.T) {
+	a, b := newFakeProvider(), newFakeProvider()
+
+	provider, err := NewFanOutProvider(a, b)
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Present("example.com", "token", "keyAuth"))
+
+	assert.True(t, a.records["example.com"])
+	assert.True(t, b.records["example.com"])
+
+	require.NoError(t, provider.CleanUp("example.com", "token", "keyAuth"))
+
+	assert.Empty(t, a.records)
+	assert.Empty(t, b.records)
+}
+
+func TestFanOutProvider_Present_rollback(t *testing.T) {
+	a, b, c := newFakeProvider(), newFakeProvider(), newFakeProvider()
+	c.presentErr = errors.New("present error")
+
+	provider, err := NewFanOutProvider(a, b, c)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "fan-out: provider 2: present error")
+
+	assert.Empty(t, a.records)
+	assert.Empty(t, b.records)
+	assert.Empty(t, c.records)
+}
+
+func TestFanOutProvider_Present_rollbackError(t *testing.T) {
+	a, b := newFakeProvider(), newFakeProvider()
+	a.cleanUpErr = errors.New("clean up error")
+	b.presentErr = errors.New("present error")
+
+	provider, err := NewFanOutProvider(a, b)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "fan-out: provider 1: present error\nfan-out: rollback: provider 0: clean up error")
+}
+
+func TestFanOutProvider_CleanUp(t *testing.T) {
+	a, b, c := newFakeProvider(), newFakeProvider(), newFakeProvider()
+
+	provider, err := NewFanOutProvider(a, b, c)
+	require.NoError(t, err)
+
+	require.NoError(t, provider.Present("example.com", "token", "keyAuth"))
+
+	b.cleanUpErr = errors.New("clean up error")
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "fan-out: provider 1: clean up error")
+
+	// The other providers are cleaned up.
+	assert.Empty(t, a.records)
+	assert.Empty(t, c.records)
+}
+
+type fakeCheckerProvider struct {
+	*fakeProvider
+
+	checkErr error
+}
+
+func (p *fakeCheckerProvider) Check(_ string) error {
+	return p.checkErr
+}
+
+func TestFanOutProvider_Check(t *testing.T) {
+	a := &fakeCheckerProvider{fakeProvider: newFakeProvider()}
+	b := &fakeCheckerProvider{fakeProvider: newFakeProvider(), checkErr: errors.New("invalid credentials")}
+
+	provider, err := NewFanOutProvider(a, newFakeProvider(), b)
+	require.NoError(t, err)
+
+	checker, ok := provider.(challenge.Checker)
+	require.True(t, ok)
+
+	err = checker.Check("example.com")
+	require.EqualError(t, err, "fan-out: provider 2: invalid credentials")
+}
+
+func TestFanOutProvider_Timeout(t *testing.T) {
+	provider, err := NewFanOutProvider(
+		&fakeTimeoutProvider{fakeProvider: newFakeProvider(), timeout: 5 * time.Minute, interval: 10 * time.Second},
+		newFakeProvider(),
+	)
+	require.NoError(t, err)
+
+	timeout, interval := provider.Timeout()
+
+	assert.Equal(t, 5*time.Minute, timeout)
+	assert.Equal(t, dns01.DefaultPollingInterval, interval)
+
+	_, ok := provider.(sequential)
+	assert.False(t, ok)
+}
+
+func TestFanOutProvider_Sequential(t *testing.T) {
+	provider, err := NewFanOutProvider(
+		&fakeSequentialProvider{fakeProvider: newFakeProvider(), interval: time.Minute},
+		newFakeProvider(),
+	)
+	require.NoError(t, err)
+
+	s, ok := provider.(sequential)
+	require.True(t, ok)
+
+	assert.Equal(t, time.Minute, s.Sequential())
+}
+
+func TestNewFanOutProvider_errors(t *testing.T) {
+	_, err := NewFanOutProvider(newFakeProvider())
+	require.EqualError(t, err, "fan-out: at least 2 providers are required")
+
+	_, err = NewFanOutProvider(newFakeProvider(), nil)
+	require.EqualError(t, err, "fan-out: the provider 1 is nil")
+}
+
+func TestNewDNSChallengeProviderByName_fanOut(t *testing.T) {
+	defer envTest.RestoreEnv()
+	envTest.Apply(map[string]string{
+		"EXEC_PATH": "abc",
+	})
+
+	provider, err := NewDNSChallengeProviderByName("exec+manual")
+	require.NoError(t, err)
+
+	// Both providers are sequential.
+	require.IsType(t, &sequentialFanOutProvider{}, provider)
+
+	fanOut := provider.(*sequentialFanOutProvider)
+
+	require.Len(t, fanOut.providers, 2)
+	assert.IsType(t, &exec.DNSProvider{}, fanOut.providers[0])
+	assert.IsType(t, &dns01.DNSProviderManual{}, fanOut.providers[1])
+
+	_, err = NewDNSChallengeProviderByName("exec+foobar")
+	require.EqualError(t, err, "fan-out: unrecognized DNS provider: foobar")
+
+	_, err = NewDNSChallengeProviderByName("exec+")
+	require.EqualError(t, err, `fan-out: invalid provider name: "exec+"`)
+}
@@ -56,13 +56,17 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		Debug:              env.GetOrDefaultBool(EnvDebug, false),
-		ZoneID:             env.GetOrDefaultString(EnvZoneID, ""),
-		AllowPrivateZone:   env.GetOrDefaultBool(EnvAllowPrivateZone, false),
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 180*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
+		Debug:              src.GetOrDefaultBool(EnvDebug, false),
+		ZoneID:             src.GetOrDefaultString(EnvZoneID, ""),
+		AllowPrivateZone:   src.GetOrDefaultBool(EnvAllowPrivateZone, false),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 180*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
 	}
 }
 
@@ -78,19 +82,30 @@ type DNSProvider struct {
 // A Service Account can be passed in the environment variable: GCE_SERVICE_ACCOUNT
 // or by specifying the keyfile location: GCE_SERVICE_ACCOUNT_FILE.
 func NewDNSProvider() (*DNSProvider, error) {
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+// Without a service account, the Application Default Credentials are used.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
 	// Use a service account file if specified via environment variable.
-	if saKey := env.GetOrFile(EnvServiceAccount); saKey != "" {
-		return NewDNSProviderServiceAccountKey([]byte(saKey))
+	if saKey := src.GetOrFile(EnvServiceAccount); saKey != "" {
+		return newDNSProviderServiceAccountKey(src, []byte(saKey))
 	}
 
 	// Use default credentials.
-	project := env.GetOrDefaultString(EnvProject, autodetectProjectID(context.Background()))
-	return NewDNSProviderCredentials(project)
+	project := src.GetOrDefaultString(EnvProject, autodetectProjectID(context.Background()))
+	return newDNSProviderCredentials(src, project)
 }
 
 // NewDNSProviderCredentials uses the supplied credentials
 // to return a DNSProvider instance configured for Google Cloud DNS.
 func NewDNSProviderCredentials(project string) (*DNSProvider, error) {
+	return newDNSProviderCredentials(env.Environment(), project)
+}
+
+func newDNSProviderCredentials(src *env.Source, project string) (*DNSProvider, error) {
 	if project == "" {
 		return nil, errors.New("googlecloud: project name missing")
 	}
@@ -100,7 +115,7 @@ func NewDNSProviderCredentials(project string) (*DNSProvider, error) {
 		return nil, fmt.Errorf("googlecloud: unable to get Google Cloud client: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Project = project
 	config.HTTPClient = client
 
@@ -110,13 +125,17 @@ func NewDNSProviderCredentials(project string) (*DNSProvider, error) {
 // NewDNSProviderServiceAccountKey uses the supplied service account JSON
 // to return a DNSProvider instance configured for Google Cloud DNS.
 func NewDNSProviderServiceAccountKey(saKey []byte) (*DNSProvider, error) {
+	return newDNSProviderServiceAccountKey(env.Environment(), saKey)
+}
+
+func newDNSProviderServiceAccountKey(src *env.Source, saKey []byte) (*DNSProvider, error) {
 	if len(saKey) == 0 {
 		return nil, errors.New("googlecloud: Service Account is missing")
 	}
 
 	// If GCE_PROJECT is non-empty it overrides the project in the service
 	// account file.
-	project := env.GetOrDefaultString(EnvProject, "")
+	project := src.GetOrDefaultString(EnvProject, "")
 	if project == "" {
 		// read project id from service account file
 		var datJSON struct {
@@ -135,7 +154,7 @@ func NewDNSProviderServiceAccountKey(saKey []byte) (*DNSProvider, error) {
 	}
 	client := conf.Client(context.Background())
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Project = project
 	config.HTTPClient = client
 
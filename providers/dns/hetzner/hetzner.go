@@ -29,6 +29,8 @@ const (
 const minTTL = 60
 
 var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+var _ dns01.RecordLister = (*DNSProvider)(nil)
+var _ challenge.Checker = (*DNSProvider)(nil)
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
@@ -41,12 +43,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, minTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, minTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -60,12 +66,18 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for hetzner.
 // Credentials must be passed in the environment variable: HETZNER_API_KEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIKey)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIKey)
 	if err != nil {
 		return nil, fmt.Errorf("hetzner: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.APIKey = values[EnvAPIKey]
 
 	return NewDNSProviderConfig(config)
@@ -100,6 +112,23 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
 
+// Check verifies the credentials and the access to the zone of the domain.
+func (d *DNSProvider) Check(domain string) error {
+	info := dns01.GetChallengeInfo(domain, "")
+
+	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("hetzner: could not find zone for domain %q: %w", domain, err)
+	}
+
+	_, err = d.client.GetZoneID(context.Background(), dns01.UnFqdn(authZone))
+	if err != nil {
+		return fmt.Errorf("hetzner: %w", err)
+	}
+
+	return nil
+}
+
 // Present creates a TXT record to fulfill the dns-01 challenge.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
@@ -172,3 +201,64 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	return nil
 }
+
+// ListChallengeRecords returns the `_acme-challenge` TXT records of a zone.
+func (d *DNSProvider) ListChallengeRecords(zone string) ([]dns01.ChallengeRecord, error) {
+	ctx := context.Background()
+
+	zoneID, err := d.client.GetZoneID(ctx, dns01.UnFqdn(zone))
+	if err != nil {
+		return nil, fmt.Errorf("hetzner: %w", err)
+	}
+
+	records, err := d.client.GetTxtRecords(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("hetzner: %w", err)
+	}
+
+	var challengeRecords []dns01.ChallengeRecord
+
+	for _, record := range records {
+		// The names of the records are relative to the zone.
+		fqdn := dns01.ToFqdn(zone)
+		if record.Name != "@" {
+			fqdn = record.Name + "." + fqdn
+		}
+
+		if !dns01.IsChallengeFQDN(fqdn) {
+			continue
+		}
+
+		challengeRecords = append(challengeRecords, dns01.ChallengeRecord{
+			ID:        record.ID,
+			FQDN:      fqdn,
+			Value:     record.Value,
+			CreatedAt: parseCreated(record.Created),
+		})
+	}
+
+	return challengeRecords, nil
+}
+
+// DeleteChallengeRecord deletes a record returned by ListChallengeRecords.
+func (d *DNSProvider) DeleteChallengeRecord(_ string, record dns01.ChallengeRecord) error {
+	err := d.client.DeleteRecord(context.Background(), record.ID)
+	if err != nil {
+		return fmt.Errorf("hetzner: failed to delete TXT record: id=%s, name=%s: %w", record.ID, record.FQDN, err)
+	}
+
+	return nil
+}
+
+// parseCreated parses the creation time of a record.
+// The API documents RFC 3339 dates, but returns the Go time format.
+func parseCreated(value string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05.999999999 -0700 MST"} {
+		created, err := time.Parse(layout, value)
+		if err == nil {
+			return created
+		}
+	}
+
+	return time.Time{}
+}
@@ -2,8 +2,10 @@ package hetzner
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +100,39 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func Test_parseCreated(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected time.Time
+	}{
+		{
+			desc:     "RFC 3339",
+			value:    "2020-05-08T10:49:19Z",
+			expected: time.Date(2020, 5, 8, 10, 49, 19, 0, time.UTC),
+		},
+		{
+			desc:     "Go format",
+			value:    "2023-01-12 10:46:16.349 +0000 UTC",
+			expected: time.Date(2023, 1, 12, 10, 46, 16, 349000000, time.UTC),
+		},
+		{
+			desc:  "empty",
+			value: "",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			created := parseCreated(test.value)
+
+			assert.True(t, test.expected.Equal(created), "expected %s, got %s", test.expected, created)
+		})
+	}
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
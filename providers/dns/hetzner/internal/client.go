@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
@@ -39,13 +40,13 @@ func NewClient(apiKey string) *Client {
 
 // GetTxtRecord gets a TXT record.
 func (c *Client) GetTxtRecord(ctx context.Context, name, value, zoneID string) (*DNSRecord, error) {
-	records, err := c.getRecords(ctx, zoneID)
+	records, err := c.GetTxtRecords(ctx, zoneID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, record := range records.Records {
-		if record.Type == "TXT" && record.Name == name && record.Value == value {
+	for _, record := range records {
+		if record.Name == name && record.Value == value {
 			return &record, nil
 		}
 	}
@@ -53,12 +54,36 @@ func (c *Client) GetTxtRecord(ctx context.Context, name, value, zoneID string) (
 	return nil, fmt.Errorf("could not find record: zone ID: %s; Record: %s", zoneID, name)
 }
 
+// GetTxtRecords gets all the TXT records of a zone, going through all the pages.
+func (c *Client) GetTxtRecords(ctx context.Context, zoneID string) ([]DNSRecord, error) {
+	var txtRecords []DNSRecord
+
+	for page := 1; ; page++ {
+		records, err := c.getRecords(ctx, zoneID, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records.Records {
+			if record.Type == "TXT" {
+				txtRecords = append(txtRecords, record)
+			}
+		}
+
+		if page >= records.Meta.Pagination.LastPage {
+			return txtRecords, nil
+		}
+	}
+}
+
 // https://dns.hetzner.com/api-docs#operation/GetRecords
-func (c *Client) getRecords(ctx context.Context, zoneID string) (*DNSRecords, error) {
+func (c *Client) getRecords(ctx context.Context, zoneID string, page int) (*DNSRecords, error) {
 	endpoint := c.baseURL.JoinPath("api", "v1", "records")
 
 	query := endpoint.Query()
 	query.Set("zone_id", zoneID)
+	query.Set("page", strconv.Itoa(page))
+	query.Set("per_page", "100")
 	endpoint.RawQuery = query.Encode()
 
 	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil)
@@ -2,12 +2,14 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -72,6 +74,84 @@ func TestClient_GetTxtRecord(t *testing.T) {
 	fmt.Println(record)
 }
 
+func TestClient_GetTxtRecords(t *testing.T) {
+	const zoneID = "zoneA"
+	const apiKey = "myKeyA"
+
+	client, mux := setupTest(t, apiKey)
+
+	mux.HandleFunc("/api/v1/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		zID := req.URL.Query().Get("zone_id")
+		if zID != zoneID {
+			http.Error(rw, fmt.Sprintf("invalid zone ID: %s", zID), http.StatusBadRequest)
+			return
+		}
+
+		file, err := os.Open("./fixtures/get_txt_record.json")
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = file.Close() }()
+
+		_, _ = io.Copy(rw, file)
+	})
+
+	records, err := client.GetTxtRecords(context.Background(), zoneID)
+	require.NoError(t, err)
+
+	expected := []DNSRecord{{
+		ID:      "1b",
+		Name:    "test1",
+		Type:    "TXT",
+		Value:   "txttxttxt",
+		TTL:     600,
+		ZoneID:  "zoneA",
+		Created: "2020-05-08T10:49:19Z",
+	}}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestClient_GetTxtRecords_pagination(t *testing.T) {
+	client, mux := setupTest(t, "myKeyA")
+
+	mux.HandleFunc("GET /api/v1/records", func(rw http.ResponseWriter, req *http.Request) {
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+
+		records := DNSRecords{
+			Records: []DNSRecord{
+				{ID: "A" + strconv.Itoa(page), Name: "test", Type: "A", Value: "10.10.10.10"},
+				{ID: "TXT" + strconv.Itoa(page), Name: "test", Type: "TXT", Value: "txt" + strconv.Itoa(page)},
+			},
+			Meta: Meta{Pagination: Pagination{Page: page, PerPage: 2, LastPage: 3, TotalEntries: 6}},
+		}
+
+		_ = json.NewEncoder(rw).Encode(records)
+	})
+
+	records, err := client.GetTxtRecords(context.Background(), "zoneA")
+	require.NoError(t, err)
+
+	expected := []DNSRecord{
+		{ID: "TXT1", Name: "test", Type: "TXT", Value: "txt1"},
+		{ID: "TXT2", Name: "test", Type: "TXT", Value: "txt2"},
+		{ID: "TXT3", Name: "test", Type: "TXT", Value: "txt3"},
+	}
+
+	assert.Equal(t, expected, records)
+
+	record, err := client.GetTxtRecord(context.Background(), "test", "txt3", "zoneA")
+	require.NoError(t, err)
+
+	assert.Equal(t, "TXT3", record.ID)
+}
+
 func TestClient_CreateRecord(t *testing.T) {
 	const zoneID = "zoneA"
 	const apiKey = "myKeyB"
@@ -9,11 +9,13 @@ type DNSRecord struct {
 	Priority int    `json:"priority,omitempty"`
 	TTL      int    `json:"ttl,omitempty"`
 	ZoneID   string `json:"zone_id,omitempty"`
+	Created  string `json:"created,omitempty"`
 }
 
 // DNSRecords a set of DNS record.
 type DNSRecords struct {
 	Records []DNSRecord `json:"records"`
+	Meta    Meta        `json:"meta,omitempty"`
 }
 
 // Zone a DNS zone.
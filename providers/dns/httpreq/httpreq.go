@@ -57,11 +57,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -73,7 +77,13 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvEndpoint)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("httpreq: %w", err)
 	}
@@ -83,10 +93,10 @@ func NewDNSProvider() (*DNSProvider, error) {
 		return nil, fmt.Errorf("httpreq: %w", err)
 	}
 
-	config := NewDefaultConfig()
-	config.Mode = env.GetOrFile(EnvMode)
-	config.Username = env.GetOrFile(EnvUsername)
-	config.Password = env.GetOrFile(EnvPassword)
+	config := newDefaultConfig(src)
+	config.Mode = src.GetOrFile(EnvMode)
+	config.Username = src.GetOrFile(EnvUsername)
+	config.Password = src.GetOrFile(EnvPassword)
 	config.Endpoint = endpoint
 	return NewDNSProviderConfig(config)
 }
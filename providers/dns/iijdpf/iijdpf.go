@@ -43,11 +43,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		Endpoint:           env.GetOrDefaultString(EnvAPIEndpoint, dpfapi.DefaultEndpoint),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 660*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
-		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
+		Endpoint:           src.GetOrDefaultString(EnvAPIEndpoint, dpfapi.DefaultEndpoint),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 660*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 5*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 300),
 	}
 }
 
@@ -59,12 +63,18 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance configured for IIJ DNS.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIToken, EnvServiceCode)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIToken, EnvServiceCode)
 	if err != nil {
 		return nil, fmt.Errorf("iijdpf: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Token = values[EnvAPIToken]
 	config.ServiceCode = values[EnvServiceCode]
 
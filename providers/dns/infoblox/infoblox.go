@@ -65,16 +65,20 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		DNSView:     env.GetOrDefaultString(EnvDNSView, "External"),
-		WapiVersion: env.GetOrDefaultString(EnvWApiVersion, "2.11"),
-		Port:        env.GetOrDefaultString(EnvPort, "443"),
-		SSLVerify:   env.GetOrDefaultBool(EnvSSLVerify, true),
-
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		HTTPTimeout:        env.GetOrDefaultInt(EnvHTTPTimeout, 30),
+		DNSView:     src.GetOrDefaultString(EnvDNSView, "External"),
+		WapiVersion: src.GetOrDefaultString(EnvWApiVersion, "2.11"),
+		Port:        src.GetOrDefaultString(EnvPort, "443"),
+		SSLVerify:   src.GetOrDefaultBool(EnvSSLVerify, true),
+
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPTimeout:        src.GetOrDefaultInt(EnvHTTPTimeout, 30),
 	}
 }
 
@@ -95,12 +99,18 @@ type DNSProvider struct {
 // INFOBLOX_DNS_VIEW, INFOBLOX_WAPI_VERSION
 // INFOBLOX_SSL_VERIFY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvHost, EnvUsername, EnvPassword)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvHost, EnvUsername, EnvPassword)
 	if err != nil {
 		return nil, fmt.Errorf("infoblox: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Host = values[EnvHost]
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
@@ -43,12 +43,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL: env.GetOrDefaultInt(EnvTTL, 300),
+		TTL: src.GetOrDefaultInt(EnvTTL, 300),
 		// INWX has rather unstable propagation delays, thus using a larger default value
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 6*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		Sandbox:            env.GetOrDefaultBool(EnvSandbox, false),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 6*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		Sandbox:            src.GetOrDefaultBool(EnvSandbox, false),
 	}
 }
 
@@ -63,15 +67,21 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // INWX_USERNAME, INWX_PASSWORD, and INWX_SHARED_SECRET.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvUsername, EnvPassword)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvUsername, EnvPassword)
 	if err != nil {
 		return nil, fmt.Errorf("inwx: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
-	config.SharedSecret = env.GetOrFile(EnvSharedSecret)
+	config.SharedSecret = src.GetOrFile(EnvSharedSecret)
 
 	return NewDNSProviderConfig(config)
 }
@@ -23,18 +23,18 @@ type dmapiProvider struct {
 
 // newDmapiProvider returns a DNSProvider instance configured for Joker.
 // Credentials must be passed in the environment variable: JOKER_USERNAME, JOKER_PASSWORD or JOKER_API_KEY.
-func newDmapiProvider() (*dmapiProvider, error) {
-	values, err := env.Get(EnvAPIKey)
+func newDmapiProvider(src *env.Source) (*dmapiProvider, error) {
+	values, err := src.Get(EnvAPIKey)
 	if err != nil {
 		var errU error
-		values, errU = env.Get(EnvUsername, EnvPassword)
+		values, errU = src.Get(EnvUsername, EnvPassword)
 		if errU != nil {
 			//nolint:errorlint // false-positive
 			return nil, fmt.Errorf("joker: %v or %v", errU, err)
 		}
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.APIKey = values[EnvAPIKey]
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
@@ -3,6 +3,7 @@ package joker
 import (
 	"testing"
 
+	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -62,7 +63,7 @@ func Test_newDmapiProvider(t *testing.T) {
 
 			envTest.Apply(test.envVars)
 
-			p, err := newDmapiProvider()
+			p, err := newDmapiProvider(env.Environment())
 
 			if test.expected != "" {
 				require.EqualError(t, err, test.expected)
@@ -44,13 +44,17 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 60),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 8*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 80*time.Second),
-		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, 90*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 60),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 8*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 80*time.Second),
+		SequenceInterval:   src.GetOrDefaultSecond(EnvSequenceInterval, 90*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -67,12 +71,18 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for Lima-City DNS.
 // LIMACITY_API_KEY must be passed in the environment variables.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIKey)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIKey)
 	if err != nil {
 		return nil, fmt.Errorf("limacity: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.APIKey = values[EnvAPIKey]
 
 	return NewDNSProviderConfig(config)
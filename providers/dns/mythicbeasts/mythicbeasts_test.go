@@ -4,7 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/mythicbeasts/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -74,6 +78,34 @@ func TestNewDNSProvider(t *testing.T) {
 	}
 }
 
+func TestNewDNSProviderFrom(t *testing.T) {
+	defer envTest.RestoreEnv()
+
+	envTest.ClearEnv()
+
+	t.Setenv(EnvTTL, "600")
+	t.Setenv(EnvAPIEndpoint, "https://env.example.com")
+
+	p, err := NewDNSProviderFrom(env.Values(map[string]string{
+		EnvUserName: "user",
+		EnvPassword: "secret",
+	}))
+	require.NoError(t, err)
+
+	// The environment variables are ignored.
+	assert.Equal(t, dns01.DefaultTTL, p.config.TTL)
+	assert.Equal(t, internal.APIBaseURL, p.config.APIEndpoint.String())
+
+	p, err = NewDNSProviderFrom(env.Values(map[string]string{
+		EnvUserName: "user",
+		EnvPassword: "secret",
+		EnvTTL:      "300",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, 300, p.config.TTL)
+}
+
 func TestNewDNSProviderConfig(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -53,12 +53,16 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, minTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 5*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, minTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 5*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 10*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 10*time.Second),
 		},
 	}
 }
@@ -77,19 +81,25 @@ type DNSProvider struct {
 // NICMANAGER_API_OTP
 // NICMANAGER_API_MODE.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvPassword)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvPassword)
 	if err != nil {
 		return nil, fmt.Errorf("nicmanager: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Password = values[EnvPassword]
 
-	config.Mode = env.GetOrDefaultString(EnvMode, internal.ModeAnycast)
-	config.Username = env.GetOrFile(EnvUsername)
-	config.Login = env.GetOrFile(EnvLogin)
-	config.Email = env.GetOrFile(EnvEmail)
-	config.OTPSecret = env.GetOrFile(EnvOTP)
+	config.Mode = src.GetOrDefaultString(EnvMode, internal.ModeAnycast)
+	config.Username = src.GetOrFile(EnvUsername)
+	config.Login = src.GetOrFile(EnvLogin)
+	config.Email = src.GetOrFile(EnvEmail)
+	config.OTPSecret = src.GetOrFile(EnvOTP)
 
 	if config.TTL < minTTL {
 		return nil, fmt.Errorf("TTL must be higher than %d: %d", minTTL, config.TTL)
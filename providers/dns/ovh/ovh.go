@@ -89,12 +89,16 @@ func (c *Config) hasAppKeyAuth() bool {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, ovh.DefaultTimeout),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, ovh.DefaultTimeout),
 		},
 	}
 }
@@ -111,19 +115,25 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // OVH_ENDPOINT (must be either "ovh-eu" or "ovh-ca"), OVH_APPLICATION_KEY, OVH_APPLICATION_SECRET, OVH_CONSUMER_KEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	config := NewDefaultConfig()
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
 
 	// https://github.com/ovh/go-ovh/blob/6817886d12a8c5650794b28da635af9fcdfd1162/ovh/configuration.go#L105
-	config.APIEndpoint = env.GetOrDefaultString(EnvEndpoint, "ovh-eu")
+	config.APIEndpoint = src.GetOrDefaultString(EnvEndpoint, "ovh-eu")
 
-	config.ApplicationKey = env.GetOrFile(EnvApplicationKey)
-	config.ApplicationSecret = env.GetOrFile(EnvApplicationSecret)
-	config.ConsumerKey = env.GetOrFile(EnvConsumerKey)
+	config.ApplicationKey = src.GetOrFile(EnvApplicationKey)
+	config.ApplicationSecret = src.GetOrFile(EnvApplicationSecret)
+	config.ConsumerKey = src.GetOrFile(EnvConsumerKey)
 
-	config.AccessToken = env.GetOrFile(EnvAccessToken)
+	config.AccessToken = src.GetOrFile(EnvAccessToken)
 
-	clientID := env.GetOrFile(EnvClientID)
-	clientSecret := env.GetOrFile(EnvClientSecret)
+	clientID := src.GetOrFile(EnvClientID)
+	clientSecret := src.GetOrFile(EnvClientSecret)
 
 	if clientID != "" || clientSecret != "" {
 		config.OAuth2Config = &OAuth2Config{
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge"
@@ -47,14 +48,18 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		ServerName:         env.GetOrDefaultString(EnvServerName, "localhost"),
-		APIVersion:         env.GetOrDefaultInt(EnvAPIVersion, 0),
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
+		ServerName:         src.GetOrDefaultString(EnvServerName, "localhost"),
+		APIVersion:         src.GetOrDefaultInt(EnvAPIVersion, 0),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 120*time.Second),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 2*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+			Timeout: src.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
 	}
 }
@@ -69,7 +74,13 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variable:
 // PDNS_API_URL and PDNS_API_KEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAPIKey, EnvAPIURL)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAPIKey, EnvAPIURL)
 	if err != nil {
 		return nil, fmt.Errorf("pdns: %w", err)
 	}
@@ -79,7 +90,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 		return nil, fmt.Errorf("pdns: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Host = hostURL
 	config.APIKey = values[EnvAPIKey]
 
@@ -140,6 +151,8 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		name = dns01.UnFqdn(info.EffectiveFQDN)
 	}
 
+	content := "\"" + info.Value + "\""
+
 	// Look for existing records.
 	existingRRSet := findTxtRecord(zone, info.EffectiveFQDN)
 
@@ -149,8 +162,13 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		records = existingRRSet.Records
 	}
 
+	if slices.ContainsFunc(records, func(r internal.Record) bool { return r.Content == content }) {
+		// The record already exists.
+		return nil
+	}
+
 	rec := internal.Record{
-		Content:  "\"" + info.Value + "\"",
+		Content:  content,
 		Disabled: false,
 
 		// pre-v1 API
@@ -202,16 +220,34 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("pdns: no existing record found for %s", info.EffectiveFQDN)
 	}
 
-	rrSets := internal.RRSets{
-		RRSets: []internal.RRSet{
-			{
-				Name:       set.Name,
-				Type:       set.Type,
-				ChangeType: "DELETE",
-			},
-		},
+	content := "\"" + info.Value + "\""
+
+	// Keep the other values (i.e. the challenge of the wildcard domain).
+	records := slices.DeleteFunc(slices.Clone(set.Records), func(r internal.Record) bool { return r.Content == content })
+	if len(records) == len(set.Records) {
+		// The record doesn't exist anymore.
+		return nil
+	}
+
+	rrSet := internal.RRSet{
+		Name:       set.Name,
+		Type:       set.Type,
+		ChangeType: "DELETE",
 	}
 
+	if len(records) > 0 {
+		rrSet = internal.RRSet{
+			Name:       set.Name,
+			Type:       set.Type,
+			Kind:       "Master",
+			ChangeType: "REPLACE",
+			TTL:        d.config.TTL,
+			Records:    records,
+		}
+	}
+
+	rrSets := internal.RRSets{RRSets: []internal.RRSet{rrSet}}
+
 	err = d.client.UpdateRecords(ctx, zone, rrSets)
 	if err != nil {
 		return fmt.Errorf("pdns: %w", err)
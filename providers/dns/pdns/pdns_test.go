@@ -1,10 +1,19 @@
 package pdns
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/platform/tester/conformance"
+	"github.com/go-acme/lego/v4/providers/dns/pdns/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -130,6 +139,154 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// setupRecordsTest creates a provider using a fake API:
+// the zone "example.com." contains the TXT values, and the RRSets sent by the provider are stored in updates.
+func setupRecordsTest(t *testing.T, values ...string) (*DNSProvider, *[]internal.RRSet) {
+	t.Helper()
+
+	conformance.SetupZone(t, "example.com")
+
+	var updates []internal.RRSet
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/servers/localhost/zones/{zone}", func(w http.ResponseWriter, _ *http.Request) {
+		zone := internal.HostedZone{ID: "example.com.", Name: "example.com.", Kind: "Master"}
+
+		if len(values) > 0 {
+			set := internal.RRSet{Name: "_acme-challenge.example.com.", Type: "TXT", TTL: 120}
+
+			for _, value := range values {
+				set.Records = append(set.Records, internal.Record{Content: `"` + value + `"`})
+			}
+
+			zone.RRSets = append(zone.RRSets, set)
+		}
+
+		_ = json.NewEncoder(w).Encode(zone)
+	})
+
+	mux.HandleFunc("PATCH /api/v1/servers/localhost/zones/{zone}", func(w http.ResponseWriter, req *http.Request) {
+		var sets internal.RRSets
+
+		err := json.NewDecoder(req.Body).Decode(&sets)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		updates = append(updates, sets.RRSets...)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("PUT /api/v1/servers/localhost/zones/{zone}/notify", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"Notification queued"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.Host = mustParse(server.URL)
+	config.APIVersion = 1
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	return provider, &updates
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+
+	testCases := []struct {
+		desc     string
+		values   []string
+		expected []internal.RRSet
+	}{
+		{
+			desc:   "new value",
+			values: []string{"other"},
+			expected: []internal.RRSet{{
+				Name:       "_acme-challenge.example.com.",
+				Type:       "TXT",
+				Kind:       "Master",
+				ChangeType: "REPLACE",
+				TTL:        120,
+				Records: []internal.Record{
+					{Content: `"other"`},
+					{Content: `"` + info.Value + `"`, Name: "_acme-challenge.example.com.", Type: "TXT", TTL: 120},
+				},
+			}},
+		},
+		{
+			desc:   "existing value",
+			values: []string{"other", info.Value},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, updates := setupRecordsTest(t, test.values...)
+
+			err := provider.Present("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, *updates)
+		})
+	}
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+
+	testCases := []struct {
+		desc     string
+		values   []string
+		expected []internal.RRSet
+	}{
+		{
+			desc:   "other values",
+			values: []string{"other", info.Value},
+			expected: []internal.RRSet{{
+				Name:       "_acme-challenge.example.com.",
+				Type:       "TXT",
+				Kind:       "Master",
+				ChangeType: "REPLACE",
+				TTL:        120,
+				Records:    []internal.Record{{Content: `"other"`}},
+			}},
+		},
+		{
+			desc:   "last value",
+			values: []string{info.Value},
+			expected: []internal.RRSet{{
+				Name:       "_acme-challenge.example.com.",
+				Type:       "TXT",
+				ChangeType: "DELETE",
+			}},
+		},
+		{
+			desc:   "missing value",
+			values: []string{"other"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, updates := setupRecordsTest(t, test.values...)
+
+			err := provider.CleanUp("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, *updates)
+		})
+	}
+}
+
 func TestLivePresentAndCleanup(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -146,6 +303,88 @@ func TestLivePresentAndCleanup(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestConformance(t *testing.T) {
+	conformance.Run(t, conformance.Suite{
+		NewProvider: func(t *testing.T, records *conformance.Records) challenge.Provider {
+			t.Helper()
+
+			server := httptest.NewServer(newFakeAPI(records))
+			t.Cleanup(server.Close)
+
+			config := NewDefaultConfig()
+			config.APIKey = "secret"
+			config.Host = mustParse(server.URL)
+			config.APIVersion = 1
+			config.HTTPClient = server.Client()
+
+			provider, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			return provider
+		},
+	})
+}
+
+// newFakeAPI creates a fake PowerDNS API (v1) storing the TXT records of the zones in records.
+func newFakeAPI(records *conformance.Records) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/servers/localhost/zones/{zone}", func(w http.ResponseWriter, req *http.Request) {
+		zone := req.PathValue("zone")
+
+		sets := map[string]*internal.RRSet{}
+
+		var rrSets []internal.RRSet
+
+		for _, record := range records.List() {
+			if !strings.HasSuffix(record.FQDN, "."+zone) {
+				continue
+			}
+
+			set, ok := sets[record.FQDN]
+			if !ok {
+				rrSets = append(rrSets, internal.RRSet{Name: record.FQDN, Type: "TXT", TTL: 120})
+				set = &rrSets[len(rrSets)-1]
+				sets[record.FQDN] = set
+			}
+
+			set.Records = append(set.Records, internal.Record{Content: `"` + record.Value + `"`})
+		}
+
+		_ = json.NewEncoder(w).Encode(internal.HostedZone{ID: zone, Name: zone, Kind: "Master", RRSets: rrSets})
+	})
+
+	mux.HandleFunc("PATCH /api/v1/servers/localhost/zones/{zone}", func(w http.ResponseWriter, req *http.Request) {
+		var sets internal.RRSets
+
+		err := json.NewDecoder(req.Body).Decode(&sets)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, set := range sets.RRSets {
+			var values []string
+
+			if set.ChangeType == "REPLACE" {
+				for _, record := range set.Records {
+					values = append(values, strings.Trim(record.Content, `"`))
+				}
+			}
+
+			records.Replace(set.Name, values)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("PUT /api/v1/servers/localhost/zones/{zone}/notify", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"Notification queued"}`))
+	})
+
+	return mux
+}
+
 func mustParse(rawURL string) *url.URL {
 	u, err := url.Parse(rawURL)
 	if err != nil {
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+type SIG0Key struct {
+	// Key is the public key: the name and the algorithm of the key come from it.
+	Key        *dns.KEY
+	PrivateKey crypto.Signer
+}
+
+// ReadSIG0File reads a SIG(0) key generated with `dnssec-keygen -T KEY`.
+// The filename is the `K<name>+<algorithm>+<id>.private` file,
+// the public key is read from the `.key` file with the same base name.
+func ReadSIG0File(filename string) (*SIG0Key, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(filename, ".private"), ".key")
+
+	key, err := readPublicKey(base + ".key")
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(base + ".private")
+	if err != nil {
+		return nil, fmt.Errorf("open private key file: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	privateKey, err := key.ReadPrivateKey(file, file.Name())
+	if err != nil {
+		return nil, fmt.Errorf("read private key: %w", err)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key: %T", privateKey)
+	}
+
+	return &SIG0Key{Key: key, PrivateKey: signer}, nil
+}
+
+func readPublicKey(filename string) (*dns.KEY, error) {
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("open public key file: %w", err)
+	}
+
+	rr, err := dns.NewRR(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("read public key: %w", err)
+	}
+
+	switch k := rr.(type) {
+	case *dns.KEY:
+		return k, nil
+
+	case *dns.DNSKEY:
+		return &dns.KEY{DNSKEY: *k}, nil
+
+	case nil:
+		return nil, errors.New("read public key: no key found")
+
+	default:
+		return nil, fmt.Errorf("read public key: unexpected record type: %s", dns.TypeToString[rr.Header().Rrtype])
+	}
+}
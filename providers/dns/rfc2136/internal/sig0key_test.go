@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSIG0File(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		filename string
+	}{
+		{
+			desc:     "private key file",
+			filename: "Kexample.com.+013+08972.private",
+		},
+		{
+			desc:     "public key file",
+			filename: "Kexample.com.+013+08972.key",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := ReadSIG0File(filepath.Join("fixtures", test.filename))
+			require.NoError(t, err)
+
+			assert.Equal(t, "example.com.", key.Key.Hdr.Name)
+			assert.Equal(t, dns.ECDSAP256SHA256, key.Key.Algorithm)
+			assert.Equal(t, uint16(8972), key.Key.KeyTag())
+			assert.NotNil(t, key.PrivateKey)
+		})
+	}
+}
+
+func TestReadSIG0File_error(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		// Because error messages are different on Windows.
+		t.Skip("only for UNIX systems")
+	}
+
+	testCases := []struct {
+		desc     string
+		filename string
+		expected string
+	}{
+		{
+			desc:     "missing file",
+			filename: "Kmissing.+013+00000.private",
+			expected: "open public key file: open fixtures/Kmissing.+013+00000.key: no such file or directory",
+		},
+		{
+			desc:     "not a public key",
+			filename: "Kinvalid.+013+00000.private",
+			expected: "read public key: unexpected record type: TXT",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadSIG0File(filepath.Join("fixtures", test.filename))
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -25,6 +25,8 @@ const (
 	EnvTSIGSecret    = envNamespace + "TSIG_SECRET"
 	EnvTSIGAlgorithm = envNamespace + "TSIG_ALGORITHM"
 
+	EnvSIG0File = envNamespace + "SIG0_FILE"
+
 	EnvNameserver = envNamespace + "NAMESERVER"
 	EnvDNSTimeout = envNamespace + "DNS_TIMEOUT"
 
@@ -35,6 +37,11 @@ const (
 )
 
 var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+var _ challenge.BatchProvider = (*DNSProvider)(nil)
+var _ challenge.Checker = (*DNSProvider)(nil)
+
+// defaultPort is the DNS port used when the nameserver has none.
+const defaultPort = "53"
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
@@ -46,6 +53,9 @@ type Config struct {
 	TSIGKey       string
 	TSIGSecret    string
 
+	// SIG0File is the `K*.private` file of a SIG(0) key (the `.key` file must be in the same directory).
+	SIG0File string
+
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
@@ -55,43 +65,57 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TSIGAlgorithm:      env.GetOrDefaultString(EnvTSIGAlgorithm, dns.HmacSHA1),
-		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, env.GetOrDefaultSecond("RFC2136_TIMEOUT", dns01.DefaultPropagationTimeout)),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
-		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
-		DNSTimeout:         env.GetOrDefaultSecond(EnvDNSTimeout, 10*time.Second),
+		TSIGAlgorithm:      src.GetOrDefaultString(EnvTSIGAlgorithm, dns.HmacSHA1),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, src.GetOrDefaultSecond("RFC2136_TIMEOUT", dns01.DefaultPropagationTimeout)),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		SequenceInterval:   src.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
+		DNSTimeout:         src.GetOrDefaultSecond(EnvDNSTimeout, 10*time.Second),
 	}
 }
 
 // DNSProvider implements the challenge.Provider interface.
 type DNSProvider struct {
 	config *Config
+
+	sig0Key *internal.SIG0Key
+
+	// primaryPort is the port of the primary nameserver found from the SOA record.
+	primaryPort string
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for rfc2136
 // dynamic update. Configured with environment variables:
 // RFC2136_NAMESERVER: Network address in the form "host" or "host:port".
-// RFC2136_TSIG_ALGORITHM: Defaults to hmac-md5.sig-alg.reg.int. (HMAC-MD5).
+// If unset, the updates are sent to the primary nameserver of the zone (SOA MNAME).
+// RFC2136_TSIG_ALGORITHM: Defaults to hmac-sha1. (HMAC-SHA1).
 // See https://github.com/miekg/dns/blob/master/tsig.go for supported values.
 // RFC2136_TSIG_KEY: Name of the secret key as defined in DNS server configuration.
 // RFC2136_TSIG_SECRET: Secret key payload.
 // RFC2136_PROPAGATION_TIMEOUT: DNS propagation timeout in time.ParseDuration format. (60s)
 // To disable TSIG authentication, leave the RFC2136_TSIG* variables unset.
+// RFC2136_SIG0_FILE: SIG(0) key file (`K*.private`), exclusive with TSIG.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvNameserver)
-	if err != nil {
-		return nil, fmt.Errorf("rfc2136: %w", err)
-	}
+	return NewDNSProviderFrom(env.Environment())
+}
 
-	config := NewDefaultConfig()
-	config.Nameserver = values[EnvNameserver]
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	config := newDefaultConfig(src)
+	config.Nameserver = src.GetOrDefaultString(EnvNameserver, "")
 
-	config.TSIGFile = env.GetOrDefaultString(EnvTSIGFile, "")
+	config.TSIGFile = src.GetOrDefaultString(EnvTSIGFile, "")
 
-	config.TSIGKey = env.GetOrFile(EnvTSIGKey)
-	config.TSIGSecret = env.GetOrFile(EnvTSIGSecret)
+	config.TSIGKey = src.GetOrFile(EnvTSIGKey)
+	config.TSIGSecret = src.GetOrFile(EnvTSIGSecret)
+
+	config.SIG0File = src.GetOrDefaultString(EnvSIG0File, "")
 
 	return NewDNSProviderConfig(config)
 }
@@ -102,10 +126,6 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("rfc2136: the configuration of the DNS provider is nil")
 	}
 
-	if config.Nameserver == "" {
-		return nil, errors.New("rfc2136: nameserver missing")
-	}
-
 	if config.TSIGFile != "" {
 		key, err := internal.ReadTSIGFile(config.TSIGFile)
 		if err != nil {
@@ -118,11 +138,13 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	// Append the default DNS port if none is specified.
-	if _, _, err := net.SplitHostPort(config.Nameserver); err != nil {
-		if strings.Contains(err.Error(), "missing port") {
-			config.Nameserver = net.JoinHostPort(config.Nameserver, "53")
-		} else {
-			return nil, fmt.Errorf("rfc2136: %w", err)
+	if config.Nameserver != "" {
+		if _, _, err := net.SplitHostPort(config.Nameserver); err != nil {
+			if strings.Contains(err.Error(), "missing port") {
+				config.Nameserver = net.JoinHostPort(config.Nameserver, defaultPort)
+			} else {
+				return nil, fmt.Errorf("rfc2136: %w", err)
+			}
 		}
 	}
 
@@ -138,7 +160,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		config.TSIGAlgorithm = dns.HmacSHA1
 	} else {
 		// To be compatible with https://github.com/miekg/dns/blob/master/tsig.go
-		config.TSIGAlgorithm = dns.Fqdn(config.TSIGAlgorithm)
+		config.TSIGAlgorithm = strings.ToLower(dns.Fqdn(config.TSIGAlgorithm))
 	}
 
 	switch config.TSIGAlgorithm {
@@ -148,7 +170,22 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, fmt.Errorf("rfc2136: unsupported TSIG algorithm: %s", config.TSIGAlgorithm)
 	}
 
-	return &DNSProvider{config: config}, nil
+	provider := &DNSProvider{config: config, primaryPort: defaultPort}
+
+	if config.SIG0File != "" {
+		if config.TSIGKey != "" {
+			return nil, errors.New("rfc2136: TSIG and SIG(0) cannot be used together")
+		}
+
+		key, err := internal.ReadSIG0File(config.SIG0File)
+		if err != nil {
+			return nil, fmt.Errorf("rfc2136: read SIG(0) file %s: %w", config.SIG0File, err)
+		}
+
+		provider.sig0Key = key
+	}
+
+	return provider, nil
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
@@ -163,6 +200,18 @@ func (d *DNSProvider) Sequential() time.Duration {
 	return d.config.SequenceInterval
 }
 
+// Check verifies that the nameserver answers for the zone of the domain.
+func (d *DNSProvider) Check(domain string) error {
+	info := dns01.GetChallengeInfo(domain, "")
+
+	_, _, err := d.findZone(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("rfc2136: %w", err)
+	}
+
+	return nil
+}
+
 // Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
@@ -185,19 +234,97 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+// PresentBatch creates the TXT records of several challenges, with one update message by zone.
+func (d *DNSProvider) PresentBatch(records []challenge.BatchRecord) error {
+	err := d.changeRecords("INSERT", records)
+	if err != nil {
+		return fmt.Errorf("rfc2136: failed to insert: %w", err)
+	}
+	return nil
+}
+
+// CleanUpBatch removes the TXT records of several challenges, with one update message by zone.
+func (d *DNSProvider) CleanUpBatch(records []challenge.BatchRecord) error {
+	err := d.changeRecords("REMOVE", records)
+	if err != nil {
+		return fmt.Errorf("rfc2136: failed to remove: %w", err)
+	}
+	return nil
+}
+
+func (d *DNSProvider) changeRecords(action string, records []challenge.BatchRecord) error {
+	var zones []string
+
+	rrsByZone := make(map[string][]dns.RR)
+	nameservers := make(map[string]string)
+
+	for _, record := range records {
+		info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+		// Find the zone for the given fqdn
+		zone, nameserver, err := d.findZone(info.EffectiveFQDN)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := rrsByZone[zone]; !ok {
+			zones = append(zones, zone)
+			nameservers[zone] = nameserver
+		}
+
+		rrsByZone[zone] = append(rrsByZone[zone], newTXT(info.EffectiveFQDN, info.Value, d.config.TTL))
+	}
+
+	for _, zone := range zones {
+		err := d.sendUpdate(action, nameservers[zone], zone, rrsByZone[zone])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (d *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 	// Find the zone for the given fqdn
-	zone, err := dns01.FindZoneByFqdnCustom(fqdn, []string{d.config.Nameserver})
+	zone, nameserver, err := d.findZone(fqdn)
 	if err != nil {
 		return err
 	}
 
-	// Create RR
-	rr := new(dns.TXT)
-	rr.Hdr = dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(ttl)}
-	rr.Txt = []string{value}
-	rrs := []dns.RR{rr}
+	return d.sendUpdate(action, nameserver, zone, []dns.RR{newTXT(fqdn, value, ttl)})
+}
+
+// findZone finds the zone of the fqdn, and the nameserver receiving the updates of the zone.
+// Without a configured nameserver, the updates are sent to the primary nameserver of the zone (SOA MNAME).
+func (d *DNSProvider) findZone(fqdn string) (zone, nameserver string, err error) {
+	if d.config.Nameserver != "" {
+		zone, err = dns01.FindZoneByFqdnCustom(fqdn, []string{d.config.Nameserver})
+		if err != nil {
+			return "", "", err
+		}
+
+		return zone, d.config.Nameserver, nil
+	}
+
+	zone, err = dns01.FindZoneByFqdn(fqdn)
+	if err != nil {
+		return "", "", err
+	}
+
+	primary, err := dns01.FindPrimaryNsByFqdn(fqdn)
+	if err != nil {
+		return "", "", err
+	}
 
+	if primary == "" {
+		return "", "", fmt.Errorf("no primary nameserver found for the zone %s", zone)
+	}
+
+	return zone, net.JoinHostPort(strings.TrimSuffix(primary, "."), d.primaryPort), nil
+}
+
+func (d *DNSProvider) sendUpdate(action, nameserver, zone string, rrs []dns.RR) error {
 	// Create dynamic update packet
 	m := new(dns.Msg)
 	m.SetUpdate(zone)
@@ -224,7 +351,15 @@ func (d *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 	}
 
 	// Send the query
-	reply, _, err := c.Exchange(m, d.config.Nameserver)
+	var reply *dns.Msg
+	var err error
+
+	if d.sig0Key != nil {
+		reply, err = d.exchangeSIG0(c, m, nameserver)
+	} else {
+		reply, _, err = c.Exchange(m, nameserver)
+	}
+
 	if err != nil {
 		return fmt.Errorf("DNS update failed: %w", err)
 	}
@@ -234,3 +369,49 @@ func (d *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 
 	return nil
 }
+
+// exchangeSIG0 signs the message with the SIG(0) key, and sends it.
+// The signed message cannot be packed again: it is written as is on the connection.
+func (d *DNSProvider) exchangeSIG0(c *dns.Client, m *dns.Msg, nameserver string) (*dns.Msg, error) {
+	now := time.Now().Unix()
+
+	sig := &dns.SIG{RRSIG: dns.RRSIG{
+		Algorithm:  d.sig0Key.Key.Algorithm,
+		SignerName: d.sig0Key.Key.Hdr.Name,
+		KeyTag:     d.sig0Key.Key.KeyTag(),
+		Inception:  uint32(now - 300),
+		Expiration: uint32(now + 300),
+	}}
+
+	signed, err := sig.Sign(d.sig0Key.PrivateKey, m)
+	if err != nil {
+		return nil, fmt.Errorf("SIG(0) signature: %w", err)
+	}
+
+	conn, err := c.Dial(nameserver)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	err = conn.SetDeadline(time.Now().Add(d.config.DNSTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = conn.Write(signed)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.ReadMsg()
+}
+
+func newTXT(fqdn, value string, ttl int) *dns.TXT {
+	rr := new(dns.TXT)
+	rr.Hdr = dns.RR_Header{Name: fqdn, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(ttl)}
+	rr.Txt = []string{value}
+
+	return rr
+}
@@ -9,8 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/rfc2136/internal"
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,7 @@ const (
 	fakeTTL        = 120
 	fakeTsigKey    = "example.com."
 	fakeTsigSecret = "IwBTJx9wrDp4Y1RyC3H0gA=="
+	fakeSIG0File   = "./internal/fixtures/Kexample.com.+013+08972.private"
 )
 
 const envDomain = envNamespace + "DOMAIN"
@@ -34,6 +37,7 @@ var envTest = tester.NewEnvTest(
 	EnvTSIGKey,
 	EnvTSIGSecret,
 	EnvTSIGAlgorithm,
+	EnvSIG0File,
 	EnvNameserver,
 	EnvDNSTimeout,
 ).WithDomain(envDomain)
@@ -51,11 +55,10 @@ func TestNewDNSProvider(t *testing.T) {
 			},
 		},
 		{
-			desc: "missing nameserver",
+			desc: "without nameserver",
 			envVars: map[string]string{
 				EnvNameserver: "",
 			},
-			expected: "rfc2136: some credentials information are missing: RFC2136_NAMESERVER",
 		},
 		{
 			desc: "invalid algorithm",
@@ -82,6 +85,19 @@ func TestNewDNSProvider(t *testing.T) {
 			},
 			expected: "rfc2136: read TSIG file ./internal/fixtures/invalid_key.conf: invalid key line: key {",
 		},
+		{
+			desc: "valid SIG(0) file",
+			envVars: map[string]string{
+				EnvSIG0File: fakeSIG0File,
+			},
+		},
+		{
+			desc: "invalid SIG(0) file",
+			envVars: map[string]string{
+				EnvSIG0File: "./internal/fixtures/Kinvalid.+013+00000.private",
+			},
+			expected: "rfc2136: read SIG(0) file ./internal/fixtures/Kinvalid.+013+00000.private: read public key: unexpected record type: TXT",
+		},
 	}
 
 	for _, test := range testCases {
@@ -113,14 +129,14 @@ func TestNewDNSProviderConfig(t *testing.T) {
 		tsigAlgorithm string
 		tsigKey       string
 		tsigSecret    string
+		sig0File      string
 	}{
 		{
 			desc:       "success",
 			nameserver: "example.com",
 		},
 		{
-			desc:     "missing nameserver",
-			expected: "rfc2136: nameserver missing",
+			desc: "without nameserver",
 		},
 		{
 			desc:          "invalid algorithm",
@@ -128,6 +144,11 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			tsigAlgorithm: "foo",
 			expected:      "rfc2136: unsupported TSIG algorithm: foo.",
 		},
+		{
+			desc:          "uppercase algorithm",
+			nameserver:    "example.com",
+			tsigAlgorithm: "HMAC-SHA256",
+		},
 		{
 			desc:       "valid TSIG file",
 			nameserver: "example.com",
@@ -139,6 +160,19 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			tsigFile:   "./internal/fixtures/invalid_key.conf",
 			expected:   "rfc2136: read TSIG file ./internal/fixtures/invalid_key.conf: invalid key line: key {",
 		},
+		{
+			desc:       "valid SIG(0) file",
+			nameserver: "example.com",
+			sig0File:   fakeSIG0File,
+		},
+		{
+			desc:       "TSIG and SIG(0)",
+			nameserver: "example.com",
+			tsigKey:    fakeTsigKey,
+			tsigSecret: fakeTsigSecret,
+			sig0File:   fakeSIG0File,
+			expected:   "rfc2136: TSIG and SIG(0) cannot be used together",
+		},
 	}
 
 	for _, test := range testCases {
@@ -149,6 +183,7 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			config.TSIGAlgorithm = test.tsigAlgorithm
 			config.TSIGKey = test.tsigKey
 			config.TSIGSecret = test.tsigSecret
+			config.SIG0File = test.sig0File
 
 			p, err := NewDNSProviderConfig(config)
 
@@ -204,6 +239,27 @@ func TestServerSuccess(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDNSProvider_Check(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerReturnSuccess)
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Check(fakeDomain)
+	require.NoError(t, err)
+}
+
 func TestServerError(t *testing.T) {
 	dns01.ClearFqdnCache()
 	dns.HandleFunc(fakeZone, serverHandlerReturnErr)
@@ -247,6 +303,111 @@ func TestTsigClient(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSIG0Client(t *testing.T) {
+	dns01.ClearFqdnCache()
+
+	key, err := internal.ReadSIG0File(fakeSIG0File)
+	require.NoError(t, err)
+
+	reqChan := make(chan *dns.Msg, 10)
+
+	dns.HandleFunc(fakeZone, serverHandlerSIG0(key.Key, reqChan))
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.SIG0File = fakeSIG0File
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.NoError(t, err)
+
+	rcvMsg := <-reqChan
+
+	sig, ok := rcvMsg.Extra[len(rcvMsg.Extra)-1].(*dns.SIG)
+	require.True(t, ok)
+
+	assert.Equal(t, key.Key.Hdr.Name, sig.SignerName)
+	assert.Equal(t, dns.ECDSAP256SHA256, sig.Algorithm)
+}
+
+func TestSIG0Client_invalidSignature(t *testing.T) {
+	dns01.ClearFqdnCache()
+
+	// The server knows another key with the same name.
+	other := &dns.KEY{DNSKEY: dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: fakeTsigKey, Rrtype: dns.TypeKEY, Class: dns.ClassINET},
+		Flags:     512,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}}
+
+	_, err := other.Generate(256)
+	require.NoError(t, err)
+
+	dns.HandleFunc(fakeZone, serverHandlerSIG0(other, make(chan *dns.Msg, 10)))
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.SIG0File = fakeSIG0File
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.EqualError(t, err, "rfc2136: failed to insert: DNS update failed: server replied: NOTAUTH")
+}
+
+func TestPrimaryNameserverFromSOA(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	reqChan := make(chan *dns.Msg, 10)
+
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerPassBackRequestSOA(reqChan, "127.0.0.1."))
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	// The local server is the recursive nameserver and the primary nameserver of the zone.
+	nameservers := dns01.RecursiveNameservers()
+	defer func() {
+		_ = dns01.AddRecursiveNameservers(nameservers)(nil)
+		dns01.ClearFqdnCache()
+	}()
+
+	_ = dns01.AddRecursiveNameservers([]string{addr})(nil)
+
+	_, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	provider, err := NewDNSProviderConfig(NewDefaultConfig())
+	require.NoError(t, err)
+
+	provider.primaryPort = port
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.NoError(t, err)
+
+	rcvMsg := <-reqChan
+
+	assert.Equal(t, dns.OpcodeUpdate, rcvMsg.Opcode)
+	assert.Equal(t, fakeZone, rcvMsg.Question[0].Name)
+}
+
 func TestValidUpdatePacket(t *testing.T) {
 	reqChan := make(chan *dns.Msg, 10)
 
@@ -294,6 +455,54 @@ func TestValidUpdatePacket(t *testing.T) {
 	}
 }
 
+func TestValidUpdatePacket_batch(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	reqChan := make(chan *dns.Msg, 10)
+
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerPassBackRequest(reqChan))
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	records := []challenge.BatchRecord{
+		{Domain: fakeDomain, KeyAuth: "1234d=="},
+		{Domain: fakeDomain, KeyAuth: "5678d=="},
+		{Domain: "www.example.com", KeyAuth: "1234d=="},
+	}
+
+	var rrs []dns.RR
+	for _, record := range records {
+		info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+		txtRR, _ := dns.NewRR(fmt.Sprintf("%s %d IN TXT %s", info.EffectiveFQDN, fakeTTL, info.Value))
+		rrs = append(rrs, txtRR)
+	}
+
+	m := new(dns.Msg)
+	m.SetUpdate(fakeZone)
+	m.RemoveRRset(rrs)
+	m.Insert(rrs)
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.PresentBatch(records)
+	require.NoError(t, err)
+
+	rcvMsg := <-reqChan
+	rcvMsg.Id = m.Id
+
+	assert.Equal(t, m.String(), rcvMsg.String())
+	assert.Empty(t, reqChan)
+}
+
 func runLocalDNSTestServer(tsig bool) (*dns.Server, string, error) {
 	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
 	if err != nil {
@@ -364,12 +573,16 @@ func serverHandlerReturnErr(w dns.ResponseWriter, req *dns.Msg) {
 }
 
 func serverHandlerPassBackRequest(reqChan chan *dns.Msg) func(w dns.ResponseWriter, req *dns.Msg) {
+	return serverHandlerPassBackRequestSOA(reqChan, "ns1."+fakeZone)
+}
+
+func serverHandlerPassBackRequestSOA(reqChan chan *dns.Msg, primary string) func(w dns.ResponseWriter, req *dns.Msg) {
 	return func(w dns.ResponseWriter, req *dns.Msg) {
 		m := new(dns.Msg)
 		m.SetReply(req)
 		if req.Opcode == dns.OpcodeQuery && req.Question[0].Qtype == dns.TypeSOA && req.Question[0].Qclass == dns.ClassINET {
 			// Return SOA to appease findZoneByFqdn()
-			soaRR, _ := dns.NewRR(fmt.Sprintf("%s %d IN SOA ns1.%s admin.%s 2016022801 28800 7200 2419200 1200", fakeZone, fakeTTL, fakeZone, fakeZone))
+			soaRR, _ := dns.NewRR(fmt.Sprintf("%s %d IN SOA %s admin.%s 2016022801 28800 7200 2419200 1200", fakeZone, fakeTTL, primary, fakeZone))
 			m.Answer = []dns.RR{soaRR}
 		}
 
@@ -387,3 +600,47 @@ func serverHandlerPassBackRequest(reqChan chan *dns.Msg) func(w dns.ResponseWrit
 		}
 	}
 }
+
+// serverHandlerSIG0 verifies the SIG(0) signature of the updates with the key.
+func serverHandlerSIG0(key *dns.KEY, reqChan chan *dns.Msg) func(w dns.ResponseWriter, req *dns.Msg) {
+	return func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+
+		if req.Opcode == dns.OpcodeQuery && req.Question[0].Qtype == dns.TypeSOA && req.Question[0].Qclass == dns.ClassINET {
+			// Return SOA to appease findZoneByFqdn()
+			soaRR, _ := dns.NewRR(fmt.Sprintf("%s %d IN SOA ns1.%s admin.%s 2016022801 28800 7200 2419200 1200", fakeZone, fakeTTL, fakeZone, fakeZone))
+			m.Answer = []dns.RR{soaRR}
+
+			_ = w.WriteMsg(m)
+			return
+		}
+
+		if !verifySIG0(key, req) {
+			m.SetRcode(req, dns.RcodeNotAuth)
+		}
+
+		_ = w.WriteMsg(m)
+
+		reqChan <- req
+	}
+}
+
+func verifySIG0(key *dns.KEY, req *dns.Msg) bool {
+	if len(req.Extra) == 0 {
+		return false
+	}
+
+	sig, ok := req.Extra[len(req.Extra)-1].(*dns.SIG)
+	if !ok {
+		return false
+	}
+
+	// The message is packed again: it has no compression, so it is the message received by the server.
+	buf, err := req.Pack()
+	if err != nil {
+		return false
+	}
+
+	return sig.Verify(key, buf) == nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ const (
 
 	EnvAccessKeyID     = envNamespace + "ACCESS_KEY_ID"
 	EnvSecretAccessKey = envNamespace + "SECRET_ACCESS_KEY"
+	EnvSessionToken    = envNamespace + "SESSION_TOKEN"
 	EnvRegion          = envNamespace + "REGION"
 	EnvHostedZoneID    = envNamespace + "HOSTED_ZONE_ID"
 	EnvMaxRetries      = envNamespace + "MAX_RETRIES"
@@ -44,11 +46,15 @@ const (
 )
 
 var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+var _ challenge.BatchProvider = (*DNSProvider)(nil)
+var _ dns01.RecordLister = (*DNSProvider)(nil)
+var _ challenge.Checker = (*DNSProvider)(nil)
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
 	// Static credential chain.
-	// These are not set via environment for the time being and are only used if they are explicitly provided.
+	// These are only used if they are explicitly provided (i.e. with NewDNSProviderFrom),
+	// otherwise the AWS SDK looks for the credentials.
 	AccessKeyID     string
 	SecretAccessKey string
 	SessionToken    string
@@ -70,17 +76,21 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		HostedZoneID:  env.GetOrFile(EnvHostedZoneID),
-		MaxRetries:    env.GetOrDefaultInt(EnvMaxRetries, 5),
-		AssumeRoleArn: env.GetOrDefaultString(EnvAssumeRoleArn, ""),
-		ExternalID:    env.GetOrDefaultString(EnvExternalID, ""),
+		HostedZoneID:  src.GetOrFile(EnvHostedZoneID),
+		MaxRetries:    src.GetOrDefaultInt(EnvMaxRetries, 5),
+		AssumeRoleArn: src.GetOrDefaultString(EnvAssumeRoleArn, ""),
+		ExternalID:    src.GetOrDefaultString(EnvExternalID, ""),
 
-		WaitForRecordSetsChanged: env.GetOrDefaultBool(EnvWaitForRecordSetsChanged, true),
+		WaitForRecordSetsChanged: src.GetOrDefaultBool(EnvWaitForRecordSetsChanged, true),
 
-		TTL:                env.GetOrDefaultInt(EnvTTL, 10),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 4*time.Second),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 10),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 4*time.Second),
 	}
 }
 
@@ -105,6 +115,27 @@ func NewDNSProvider() (*DNSProvider, error) {
 	return NewDNSProviderConfig(NewDefaultConfig())
 }
 
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN, and AWS_REGION are used as static credentials,
+// the AWS SDK still looks for the missing ones (i.e. in the environment or the shared credentials file).
+// The variables read only by the AWS SDK (i.e. AWS_PROFILE) are rejected.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	for _, name := range []string{"AWS_PROFILE", "AWS_SDK_LOAD_CONFIG", "AWS_SHARED_CREDENTIALS_FILE"} {
+		if src.GetOrFile(name) != "" {
+			return nil, fmt.Errorf("route53: %s is read by the AWS SDK from the environment only", name)
+		}
+	}
+
+	config := newDefaultConfig(src)
+	config.AccessKeyID = src.GetOrFile(EnvAccessKeyID)
+	config.SecretAccessKey = src.GetOrFile(EnvSecretAccessKey)
+	config.SessionToken = src.GetOrFile(EnvSessionToken)
+	config.Region = src.GetOrFile(EnvRegion)
+
+	return NewDNSProviderConfig(config)
+}
+
 // NewDNSProviderConfig takes a given config and returns a custom configured DNSProvider instance.
 func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	if config == nil {
@@ -133,6 +164,24 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
 
+// Check verifies the credentials and the access to the hosted zone of the domain.
+func (d *DNSProvider) Check(domain string) error {
+	ctx := context.Background()
+	info := dns01.GetChallengeInfo(domain, "")
+
+	hostedZoneID, err := d.getHostedZoneID(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("route53: failed to determine hosted zone ID: %w", err)
+	}
+
+	_, err = d.getExistingRecordSets(ctx, hostedZoneID, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("route53: failed to list the record sets of the hosted zone %s: %w", hostedZoneID, err)
+	}
+
+	return nil
+}
+
 // Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	ctx := context.Background()
@@ -168,7 +217,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		ResourceRecords: records,
 	}
 
-	err = d.changeRecord(ctx, awstypes.ChangeActionUpsert, hostedZoneID, recordSet)
+	err = d.changeRecords(ctx, hostedZoneID, awstypes.Change{Action: awstypes.ChangeActionUpsert, ResourceRecordSet: recordSet})
 	if err != nil {
 		return fmt.Errorf("route53: %w", err)
 	}
@@ -191,50 +240,265 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("route53: %w", err)
 	}
 
+	change := d.cleanUpChange(info.EffectiveFQDN, existingRecords, info.Value)
+	if change == nil {
+		return nil
+	}
+
+	err = d.changeRecords(ctx, hostedZoneID, *change)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+
+	return nil
+}
+
+// PresentBatch creates the TXT records of several challenges, with one change batch by hosted zone.
+func (d *DNSProvider) PresentBatch(records []challenge.BatchRecord) error {
+	ctx := context.Background()
+
+	zones, err := d.groupByZone(ctx, records)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+
+	for _, zone := range zones {
+		existing, err := d.getExistingTXTRecords(ctx, zone.id)
+		if err != nil {
+			return fmt.Errorf("route53: %w", err)
+		}
+
+		var changes []awstypes.Change
+
+		for _, fqdn := range zone.fqdns {
+			existingRecords := existing[fqdn]
+
+			for _, value := range zone.values[fqdn] {
+				realValue := `"` + value + `"`
+
+				if !slices.ContainsFunc(existingRecords, func(record awstypes.ResourceRecord) bool {
+					return ptr.Deref(record.Value) == realValue
+				}) {
+					existingRecords = append(existingRecords, awstypes.ResourceRecord{Value: aws.String(realValue)})
+				}
+			}
+
+			changes = append(changes, awstypes.Change{
+				Action: awstypes.ChangeActionUpsert,
+				ResourceRecordSet: &awstypes.ResourceRecordSet{
+					Name:            aws.String(fqdn),
+					Type:            "TXT",
+					TTL:             aws.Int64(int64(d.config.TTL)),
+					ResourceRecords: existingRecords,
+				},
+			})
+		}
+
+		err = d.changeRecords(ctx, zone.id, changes...)
+		if err != nil {
+			return fmt.Errorf("route53: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CleanUpBatch removes the TXT records of several challenges, with one change batch by hosted zone.
+func (d *DNSProvider) CleanUpBatch(records []challenge.BatchRecord) error {
+	ctx := context.Background()
+
+	zones, err := d.groupByZone(ctx, records)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+
+	for _, zone := range zones {
+		existing, err := d.getExistingTXTRecords(ctx, zone.id)
+		if err != nil {
+			return fmt.Errorf("route53: %w", err)
+		}
+
+		var changes []awstypes.Change
+
+		for _, fqdn := range zone.fqdns {
+			change := d.cleanUpChange(fqdn, existing[fqdn], zone.values[fqdn]...)
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		err = d.changeRecords(ctx, zone.id, changes...)
+		if err != nil {
+			return fmt.Errorf("route53: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListChallengeRecords returns the `_acme-challenge` TXT records of a zone.
+// Route 53 doesn't provide the creation time of the records.
+func (d *DNSProvider) ListChallengeRecords(zone string) ([]dns01.ChallengeRecord, error) {
+	ctx := context.Background()
+
+	hostedZoneID, err := d.getHostedZoneID(ctx, dns01.ToFqdn(zone))
+	if err != nil {
+		return nil, fmt.Errorf("route53: failed to determine hosted zone ID: %w", err)
+	}
+
+	recordSets, err := d.listTXTRecordSets(ctx, hostedZoneID)
+	if err != nil {
+		return nil, fmt.Errorf("route53: %w", err)
+	}
+
+	var records []dns01.ChallengeRecord
+
+	for _, recordSet := range recordSets {
+		name := ptr.Deref(recordSet.Name)
+
+		if !dns01.IsChallengeFQDN(name) {
+			continue
+		}
+
+		for _, record := range recordSet.ResourceRecords {
+			records = append(records, dns01.ChallengeRecord{
+				FQDN:  name,
+				Value: strings.Trim(ptr.Deref(record.Value), `"`),
+			})
+		}
+	}
+
+	return records, nil
+}
+
+// DeleteChallengeRecord deletes a record returned by ListChallengeRecords.
+func (d *DNSProvider) DeleteChallengeRecord(zone string, record dns01.ChallengeRecord) error {
+	ctx := context.Background()
+
+	hostedZoneID, err := d.getHostedZoneID(ctx, dns01.ToFqdn(zone))
+	if err != nil {
+		return fmt.Errorf("route53: failed to determine hosted zone ID: %w", err)
+	}
+
+	existingRecords, err := d.getExistingRecordSets(ctx, hostedZoneID, record.FQDN)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+
+	change := d.cleanUpChange(record.FQDN, existingRecords, record.Value)
+	if change == nil {
+		return nil
+	}
+
+	err = d.changeRecords(ctx, hostedZoneID, *change)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+
+	return nil
+}
+
+// cleanUpChange returns the change removing the values from the existing TXT records of the FQDN,
+// or nil if there is no existing record.
+func (d *DNSProvider) cleanUpChange(fqdn string, existingRecords []awstypes.ResourceRecord, values ...string) *awstypes.Change {
 	if len(existingRecords) == 0 {
 		return nil
 	}
 
 	var nonLegoRecords []awstypes.ResourceRecord
 	for _, record := range existingRecords {
-		if ptr.Deref(record.Value) != `"`+info.Value+`"` {
+		if !slices.ContainsFunc(values, func(value string) bool {
+			return ptr.Deref(record.Value) == `"`+value+`"`
+		}) {
 			nonLegoRecords = append(nonLegoRecords, record)
 		}
 	}
 
-	action := awstypes.ChangeActionUpsert
-
-	recordSet := &awstypes.ResourceRecordSet{
-		Name:            aws.String(info.EffectiveFQDN),
-		Type:            "TXT",
-		TTL:             aws.Int64(int64(d.config.TTL)),
-		ResourceRecords: nonLegoRecords,
+	change := &awstypes.Change{
+		Action: awstypes.ChangeActionUpsert,
+		ResourceRecordSet: &awstypes.ResourceRecordSet{
+			Name:            aws.String(fqdn),
+			Type:            "TXT",
+			TTL:             aws.Int64(int64(d.config.TTL)),
+			ResourceRecords: nonLegoRecords,
+		},
 	}
 
 	// If the records are only records created by lego.
 	if len(nonLegoRecords) == 0 {
-		action = awstypes.ChangeActionDelete
-
-		recordSet.ResourceRecords = existingRecords
+		change.Action = awstypes.ChangeActionDelete
+		change.ResourceRecordSet.ResourceRecords = existingRecords
 	}
 
-	err = d.changeRecord(ctx, action, hostedZoneID, recordSet)
-	if err != nil {
-		return fmt.Errorf("route53: %w", err)
+	return change
+}
+
+// hostedZoneRecords holds the TXT values of a batch, grouped by FQDN, for one hosted zone.
+type hostedZoneRecords struct {
+	id     string
+	fqdns  []string
+	values map[string][]string
+}
+
+func (d *DNSProvider) groupByZone(ctx context.Context, records []challenge.BatchRecord) ([]*hostedZoneRecords, error) {
+	var zones []*hostedZoneRecords
+
+	index := make(map[string]*hostedZoneRecords)
+
+	// The hosted zone IDs by auth zone, to look up each hosted zone only once.
+	hostedZoneIDs := make(map[string]string)
+
+	for _, record := range records {
+		info := dns01.GetChallengeInfo(record.Domain, record.KeyAuth)
+
+		hostedZoneID := d.config.HostedZoneID
+
+		if hostedZoneID == "" {
+			authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+			if err != nil {
+				return nil, fmt.Errorf("failed to determine hosted zone ID: could not find zone for FQDN %q: %w", info.EffectiveFQDN, err)
+			}
+
+			var ok bool
+
+			hostedZoneID, ok = hostedZoneIDs[authZone]
+			if !ok {
+				hostedZoneID, err = d.lookupHostedZoneID(ctx, authZone, info.EffectiveFQDN)
+				if err != nil {
+					return nil, fmt.Errorf("failed to determine hosted zone ID: %w", err)
+				}
+
+				hostedZoneIDs[authZone] = hostedZoneID
+			}
+		}
+
+		zone, ok := index[hostedZoneID]
+		if !ok {
+			zone = &hostedZoneRecords{id: hostedZoneID, values: make(map[string][]string)}
+			index[hostedZoneID] = zone
+			zones = append(zones, zone)
+		}
+
+		if _, ok := zone.values[info.EffectiveFQDN]; !ok {
+			zone.fqdns = append(zone.fqdns, info.EffectiveFQDN)
+		}
+
+		zone.values[info.EffectiveFQDN] = append(zone.values[info.EffectiveFQDN], info.Value)
 	}
 
-	return nil
+	return zones, nil
 }
 
-func (d *DNSProvider) changeRecord(ctx context.Context, action awstypes.ChangeAction, hostedZoneID string, recordSet *awstypes.ResourceRecordSet) error {
+func (d *DNSProvider) changeRecords(ctx context.Context, hostedZoneID string, changes ...awstypes.Change) error {
 	recordSetInput := &route53.ChangeResourceRecordSetsInput{
 		HostedZoneId: aws.String(hostedZoneID),
 		ChangeBatch: &awstypes.ChangeBatch{
 			Comment: aws.String("Managed by Lego"),
-			Changes: []awstypes.Change{{
-				Action:            action,
-				ResourceRecordSet: recordSet,
-			}},
+			Changes: changes,
 		},
 	}
 
@@ -290,6 +554,47 @@ func (d *DNSProvider) getExistingRecordSets(ctx context.Context, hostedZoneID, f
 	return records, nil
 }
 
+// getExistingTXTRecords returns the TXT records of the hosted zone, by record set name.
+func (d *DNSProvider) getExistingTXTRecords(ctx context.Context, hostedZoneID string) (map[string][]awstypes.ResourceRecord, error) {
+	recordSets, err := d.listTXTRecordSets(ctx, hostedZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[string][]awstypes.ResourceRecord)
+
+	for _, recordSet := range recordSets {
+		name := ptr.Deref(recordSet.Name)
+		records[name] = append(records[name], recordSet.ResourceRecords...)
+	}
+
+	return records, nil
+}
+
+// listTXTRecordSets returns the TXT record sets of the hosted zone, going through all the pages.
+func (d *DNSProvider) listTXTRecordSets(ctx context.Context, hostedZoneID string) ([]awstypes.ResourceRecordSet, error) {
+	paginator := route53.NewListResourceRecordSetsPaginator(d.client, &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	})
+
+	var recordSets []awstypes.ResourceRecordSet
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, recordSet := range page.ResourceRecordSets {
+			if recordSet.Type == awstypes.RRTypeTxt {
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+	}
+
+	return recordSets, nil
+}
+
 func (d *DNSProvider) getHostedZoneID(ctx context.Context, fqdn string) (string, error) {
 	if d.config.HostedZoneID != "" {
 		return d.config.HostedZoneID, nil
@@ -300,6 +605,11 @@ func (d *DNSProvider) getHostedZoneID(ctx context.Context, fqdn string) (string,
 		return "", fmt.Errorf("could not find zone for FQDN %q: %w", fqdn, err)
 	}
 
+	return d.lookupHostedZoneID(ctx, authZone, fqdn)
+}
+
+// lookupHostedZoneID returns the ID of the public hosted zone of the auth zone.
+func (d *DNSProvider) lookupHostedZoneID(ctx context.Context, authZone, fqdn string) (string, error) {
 	// .DNSName should not have a trailing dot
 	reqParams := &route53.ListHostedZonesByNameInput{
 		DNSName: aws.String(dns01.UnFqdn(authZone)),
@@ -2,7 +2,11 @@ package route53
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +14,8 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -177,6 +183,185 @@ func TestDNSProvider_Present(t *testing.T) {
 	require.NoError(t, err, "Expected Present to return no error")
 }
 
+func TestDNSProvider_PresentBatch(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	var (
+		changes []string
+		lists   int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		lists++
+
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ListResourceRecordSetsEmptyResponse))
+	})
+	mux.HandleFunc("POST /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		changes = append(changes, string(body))
+
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ChangeResourceRecordSetsResponse))
+	})
+
+	mux.HandleFunc("GET /2013-04-01/change/123456", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(GetChangeResponse))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := makeTestProvider(t, server.URL)
+	provider.config.HostedZoneID = "ABCDEFG"
+
+	records := []challenge.BatchRecord{
+		{Domain: "example.com", KeyAuth: "123456d=="},
+		{Domain: "example.com", KeyAuth: "654321d=="},
+		{Domain: "www.example.com", KeyAuth: "123456d=="},
+	}
+
+	err := provider.PresentBatch(records)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, lists)
+
+	require.Len(t, changes, 1)
+	assert.Equal(t, 2, strings.Count(changes[0], "<Change>"))
+	assert.Contains(t, changes[0], "<Name>_acme-challenge.example.com.</Name>")
+	assert.Contains(t, changes[0], "<Name>_acme-challenge.www.example.com.</Name>")
+	assert.Equal(t, 3, strings.Count(changes[0], "<ResourceRecord>"))
+}
+
+func TestDNSProvider_CleanUpBatch(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	var (
+		changes []string
+		lists   int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		lists++
+
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ListResourceRecordSetsResponse))
+	})
+	mux.HandleFunc("POST /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		changes = append(changes, string(body))
+
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ChangeResourceRecordSetsResponse))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := makeTestProvider(t, server.URL)
+	provider.config.HostedZoneID = "ABCDEFG"
+	provider.config.WaitForRecordSetsChanged = false
+
+	records := []challenge.BatchRecord{
+		{Domain: "example.com", KeyAuth: "123456d=="},
+		{Domain: "www.example.com", KeyAuth: "123456d=="},
+	}
+
+	err := provider.CleanUpBatch(records)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, lists)
+
+	// Only the TXT record set of _acme-challenge.example.com. exists, and none of its values belong to the batch.
+	require.Len(t, changes, 1)
+	assert.Equal(t, 1, strings.Count(changes[0], "<Change>"))
+	assert.Contains(t, changes[0], "<Action>UPSERT</Action>")
+	assert.Contains(t, changes[0], "<Name>_acme-challenge.example.com.</Name>")
+	assert.Equal(t, 2, strings.Count(changes[0], "<ResourceRecord>"))
+}
+
+func TestDNSProvider_ListChallengeRecords(t *testing.T) {
+	var changes []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ListResourceRecordSetsResponse))
+	})
+	mux.HandleFunc("POST /2013-04-01/hostedzone/ABCDEFG/rrset", func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		changes = append(changes, string(body))
+
+		rw.Header().Set("Content-Type", "application/xml")
+		_, _ = rw.Write([]byte(ChangeResourceRecordSetsResponse))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	provider := makeTestProvider(t, server.URL)
+	provider.config.HostedZoneID = "ABCDEFG"
+	provider.config.WaitForRecordSetsChanged = false
+
+	records, err := provider.ListChallengeRecords("example.com")
+	require.NoError(t, err)
+
+	expected := []dns01.ChallengeRecord{
+		{FQDN: "_acme-challenge.example.com.", Value: "foo"},
+		{FQDN: "_acme-challenge.example.com.", Value: "bar"},
+	}
+
+	assert.Equal(t, expected, records)
+
+	err = provider.DeleteChallengeRecord("example.com", records[0])
+	require.NoError(t, err)
+
+	require.Len(t, changes, 1)
+	assert.Contains(t, changes[0], "<Action>UPSERT</Action>")
+	assert.Contains(t, changes[0], "<Value>&#34;bar&#34;</Value>")
+	assert.NotContains(t, changes[0], "foo")
+}
+
+func TestDNSProvider_Check(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	mockResponses := MockResponseMap{
+		"/2013-04-01/hostedzone/ABCDEFG/rrset": {StatusCode: 200, Body: ListResourceRecordSetsEmptyResponse},
+		"/2013-04-01/hostedzone/UNKNOWN/rrset": {StatusCode: 404, Body: NoSuchHostedZoneResponse},
+	}
+
+	serverURL := setupTest(t, mockResponses)
+
+	provider := makeTestProvider(t, serverURL)
+	provider.config.HostedZoneID = "ABCDEFG"
+
+	err := provider.Check("example.com")
+	require.NoError(t, err)
+
+	provider.config.HostedZoneID = "UNKNOWN"
+
+	err = provider.Check("example.com")
+	require.ErrorContains(t, err, "route53: failed to list the record sets of the hosted zone UNKNOWN")
+}
+
 func Test_createAWSConfig(t *testing.T) {
 	testCases := []struct {
 		desc             string
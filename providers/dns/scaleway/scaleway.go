@@ -57,11 +57,15 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
 		AccessKey:          dumpAccessKey,
-		TTL:                env.GetOneWithFallback(EnvTTL, minTTL, strconv.Atoi, altEnvName(EnvTTL)),
-		PropagationTimeout: env.GetOneWithFallback(EnvPropagationTimeout, defaultPropagationTimeout, env.ParseSecond, altEnvName(EnvPropagationTimeout)),
-		PollingInterval:    env.GetOneWithFallback(EnvPollingInterval, defaultPollingInterval, env.ParseSecond, altEnvName(EnvPollingInterval)),
+		TTL:                env.GetOneWithFallbackFrom(src, EnvTTL, minTTL, strconv.Atoi, altEnvName(EnvTTL)),
+		PropagationTimeout: env.GetOneWithFallbackFrom(src, EnvPropagationTimeout, defaultPropagationTimeout, env.ParseSecond, altEnvName(EnvPropagationTimeout)),
+		PollingInterval:    env.GetOneWithFallbackFrom(src, EnvPollingInterval, defaultPollingInterval, env.ParseSecond, altEnvName(EnvPollingInterval)),
 	}
 }
 
@@ -75,15 +79,21 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // SCALEWAY_API_TOKEN, SCALEWAY_PROJECT_ID.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.GetWithFallback([]string{EnvSecretKey, EnvAPIToken})
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.GetWithFallback([]string{EnvSecretKey, EnvAPIToken})
 	if err != nil {
 		return nil, fmt.Errorf("scaleway: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.Token = values[EnvSecretKey]
-	config.AccessKey = env.GetOrDefaultString(EnvAccessKey, dumpAccessKey)
-	config.ProjectID = env.GetOrFile(EnvProjectID)
+	config.AccessKey = src.GetOrDefaultString(EnvAccessKey, dumpAccessKey)
+	config.ProjectID = src.GetOrFile(EnvProjectID)
 
 	return NewDNSProviderConfig(config)
 }
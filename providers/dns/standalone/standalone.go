@@ -0,0 +1,398 @@
+// Package standalone implements a DNS provider for solving the DNS-01 challenge with an embedded authoritative DNS server.
+package standalone
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/miekg/dns"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "STANDALONE_"
+
+	EnvZone        = envNamespace + "ZONE"
+	EnvAddress     = envNamespace + "ADDRESS"
+	EnvNameservers = envNamespace + "NAMESERVERS"
+	EnvMbox        = envNamespace + "MBOX"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+)
+
+const defaultAddress = ":53"
+
+var _ challenge.ProviderTimeout = (*DNSProvider)(nil)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	// Zone is the zone served by the DNS server (i.e. the zone delegated to the DNS server).
+	Zone string
+	// Address is the listening address of the DNS server (UDP and TCP).
+	Address string
+	// Nameservers are the names of the NS records of the zone.
+	// The first nameserver is used as the primary nameserver of the SOA record.
+	Nameservers []string
+	// Mbox is the mailbox of the person responsible for the zone (SOA record),
+	// either as an email address or as a domain name (e.g. "hostmaster.example.com").
+	Mbox string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
+	return &Config{
+		Address:            src.GetOrDefaultString(EnvAddress, defaultAddress),
+		TTL:                src.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+// The DNS server is started by the first Present call,
+// and stopped when the last TXT record is removed by CleanUp.
+type DNSProvider struct {
+	config *Config
+
+	zone        string
+	nameservers []string
+	mbox        string
+
+	mu      sync.Mutex
+	records map[string][]string
+	serial  uint32
+	servers []*dns.Server
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for the embedded DNS server.
+// STANDALONE_ZONE is the zone delegated to the DNS server.
+func NewDNSProvider() (*DNSProvider, error) {
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvZone)
+	if err != nil {
+		return nil, fmt.Errorf("standalone: %w", err)
+	}
+
+	config := newDefaultConfig(src)
+	config.Zone = values[EnvZone]
+	config.Mbox = src.GetOrDefaultString(EnvMbox, "")
+
+	if nameservers := src.GetOrDefaultString(EnvNameservers, ""); nameservers != "" {
+		config.Nameservers = strings.Split(nameservers, ",")
+	}
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for the embedded DNS server.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("standalone: the configuration of the DNS provider is nil")
+	}
+
+	if config.Zone == "" {
+		return nil, errors.New("standalone: missing zone")
+	}
+
+	zone := strings.ToLower(dns01.ToFqdn(config.Zone))
+	if _, ok := dns.IsDomainName(zone); !ok {
+		return nil, fmt.Errorf("standalone: invalid zone: %s", config.Zone)
+	}
+
+	var nameservers []string
+
+	for _, ns := range config.Nameservers {
+		ns = strings.TrimSpace(ns)
+		if ns == "" {
+			continue
+		}
+
+		nameservers = append(nameservers, strings.ToLower(dns01.ToFqdn(ns)))
+	}
+
+	if len(nameservers) == 0 {
+		nameservers = []string{dns01.ToFqdn("ns." + dns01.UnFqdn(zone))}
+	}
+
+	// The "@" of an email address is replaced by a dot (RFC 1035 Section 8).
+	mbox := strings.Replace(config.Mbox, "@", ".", 1)
+	if mbox == "" {
+		mbox = "hostmaster." + zone
+	}
+
+	if config.Address == "" {
+		config.Address = defaultAddress
+	}
+
+	return &DNSProvider{
+		config:      config,
+		zone:        zone,
+		nameservers: nameservers,
+		mbox:        dns01.ToFqdn(mbox),
+		records:     make(map[string][]string),
+		serial:      uint32(time.Now().Unix()),
+	}, nil
+}
+
+// Present creates a TXT record to fulfill the dns-01 challenge.
+// The DNS server is started if needed.
+func (d *DNSProvider) Present(domain, _, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	fqdn := strings.ToLower(info.EffectiveFQDN)
+	if !dns.IsSubDomain(d.zone, fqdn) {
+		return fmt.Errorf("standalone: the FQDN %s is not in the zone %s: a CNAME to the zone is required", fqdn, d.zone)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.servers) == 0 {
+		err := d.start()
+		if err != nil {
+			return fmt.Errorf("standalone: %w", err)
+		}
+	}
+
+	d.records[fqdn] = append(d.records[fqdn], info.Value)
+	d.serial++
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+// The DNS server is stopped when no TXT records remain.
+func (d *DNSProvider) CleanUp(domain, _, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	fqdn := strings.ToLower(info.EffectiveFQDN)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if i := slices.Index(d.records[fqdn], info.Value); i >= 0 {
+		d.records[fqdn] = slices.Delete(d.records[fqdn], i, i+1)
+		d.serial++
+	}
+
+	if len(d.records[fqdn]) == 0 {
+		delete(d.records, fqdn)
+	}
+
+	if len(d.records) > 0 {
+		return nil
+	}
+
+	err := d.stop()
+	if err != nil {
+		return fmt.Errorf("standalone: %w", err)
+	}
+
+	return nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// start starts the UDP and TCP DNS servers on the same port.
+func (d *DNSProvider) start() error {
+	pc, err := net.ListenPacket("udp", d.config.Address)
+	if err != nil {
+		return fmt.Errorf("could not start the DNS server (UDP): %w", err)
+	}
+
+	// The actual address is used to get the same port when the port is 0.
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		_ = pc.Close()
+		return fmt.Errorf("could not start the DNS server (TCP): %w", err)
+	}
+
+	handler := dns.HandlerFunc(d.serveDNS)
+
+	d.servers = []*dns.Server{
+		{PacketConn: pc, Handler: handler},
+		{Listener: l, Handler: handler},
+	}
+
+	for _, srv := range d.servers {
+		started := make(chan struct{})
+		srv.NotifyStartedFunc = func() { close(started) }
+
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- srv.ActivateAndServe()
+		}()
+
+		select {
+		case <-started:
+		case err = <-errCh:
+			_ = d.stop()
+			return fmt.Errorf("could not start the DNS server: %w", err)
+		}
+	}
+
+	log.Infof("[%s] Started the DNS server on %s", d.zone, pc.LocalAddr())
+
+	return nil
+}
+
+// stop stops the DNS servers.
+func (d *DNSProvider) stop() error {
+	var errs []error
+
+	for _, srv := range d.servers {
+		err := srv.Shutdown()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	d.servers = nil
+
+	return errors.Join(errs...)
+}
+
+// serveDNS answers the queries for the zone.
+func (d *DNSProvider) serveDNS(w dns.ResponseWriter, req *dns.Msg) {
+	m := d.answer(req)
+
+	if opt := req.IsEdns0(); opt != nil {
+		m.SetEdns0(max(opt.UDPSize(), dns.MinMsgSize), false)
+	}
+
+	if _, ok := w.LocalAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(max(opt.UDPSize(), dns.MinMsgSize))
+		}
+
+		m.Truncate(size)
+	}
+
+	err := w.WriteMsg(m)
+	if err != nil {
+		log.Warnf("[%s] Could not write the DNS response: %v", d.zone, err)
+	}
+}
+
+// answer builds the response to a query.
+func (d *DNSProvider) answer(req *dns.Msg) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetReply(req)
+
+	if req.Opcode != dns.OpcodeQuery {
+		return m.SetRcode(req, dns.RcodeNotImplemented)
+	}
+
+	if len(req.Question) != 1 {
+		return m.SetRcode(req, dns.RcodeFormatError)
+	}
+
+	q := req.Question[0]
+	name := strings.ToLower(q.Name)
+
+	if q.Qclass != dns.ClassINET || !dns.IsSubDomain(d.zone, name) {
+		return m.SetRcode(req, dns.RcodeRefused)
+	}
+
+	m.Authoritative = true
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch {
+	case name == d.zone && q.Qtype == dns.TypeSOA:
+		m.Answer = append(m.Answer, d.soa())
+
+	case name == d.zone && q.Qtype == dns.TypeNS:
+		m.Answer = append(m.Answer, d.ns()...)
+
+	case q.Qtype == dns.TypeTXT && len(d.records[name]) > 0:
+		for _, value := range d.records[name] {
+			m.Answer = append(m.Answer, &dns.TXT{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: uint32(d.config.TTL)},
+				Txt: []string{value},
+			})
+		}
+
+	case d.exists(name):
+		// NODATA
+		m.Ns = append(m.Ns, d.soa())
+
+	default:
+		m.Rcode = dns.RcodeNameError
+		m.Ns = append(m.Ns, d.soa())
+	}
+
+	return m
+}
+
+// exists checks if a name exists in the zone, including the empty non-terminals (RFC 8020).
+func (d *DNSProvider) exists(name string) bool {
+	if name == d.zone {
+		return true
+	}
+
+	for fqdn := range d.records {
+		if dns.IsSubDomain(name, fqdn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *DNSProvider) soa() dns.RR {
+	return &dns.SOA{
+		Hdr:     dns.RR_Header{Name: d.zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: uint32(d.config.TTL)},
+		Ns:      d.nameservers[0],
+		Mbox:    d.mbox,
+		Serial:  d.serial,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  uint32(d.config.TTL),
+	}
+}
+
+func (d *DNSProvider) ns() []dns.RR {
+	var rrs []dns.RR
+
+	for _, ns := range d.nameservers {
+		rrs = append(rrs, &dns.NS{
+			Hdr: dns.RR_Header{Name: d.zone, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: uint32(d.config.TTL)},
+			Ns:  ns,
+		})
+	}
+
+	return rrs
+}
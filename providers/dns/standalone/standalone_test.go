@@ -0,0 +1,273 @@
+package standalone
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const envDomain = envNamespace + "DOMAIN"
+
+var envTest = tester.NewEnvTest(
+	EnvZone,
+	EnvAddress,
+	EnvNameservers,
+	EnvMbox,
+).WithDomain(envDomain)
+
+func TestNewDNSProvider(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		envVars  map[string]string
+		expected string
+	}{
+		{
+			desc: "success",
+			envVars: map[string]string{
+				EnvZone: "acme.example.com",
+			},
+		},
+		{
+			desc: "with nameservers",
+			envVars: map[string]string{
+				EnvZone:        "acme.example.com",
+				EnvNameservers: "ns1.example.com,ns2.example.com",
+			},
+		},
+		{
+			desc: "missing zone",
+			envVars: map[string]string{
+				EnvZone: "",
+			},
+			expected: "standalone: some credentials information are missing: STANDALONE_ZONE",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			defer envTest.RestoreEnv()
+			envTest.ClearEnv()
+
+			envTest.Apply(test.envVars)
+
+			p, err := NewDNSProvider()
+
+			if test.expected == "" {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+				require.NotNil(t, p.config)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestNewDNSProviderConfig(t *testing.T) {
+	testCases := []struct {
+		desc                string
+		zone                string
+		nameservers         []string
+		mbox                string
+		expected            string
+		expectedNameservers []string
+		expectedMbox        string
+	}{
+		{
+			desc:                "success",
+			zone:                "acme.example.com",
+			expectedNameservers: []string{"ns.acme.example.com."},
+			expectedMbox:        "hostmaster.acme.example.com.",
+		},
+		{
+			desc:                "nameservers and mbox",
+			zone:                "ACME.example.com.",
+			nameservers:         []string{"NS1.example.com", " ns2.example.com."},
+			mbox:                "admin@example.com",
+			expectedNameservers: []string{"ns1.example.com.", "ns2.example.com."},
+			expectedMbox:        "admin.example.com.",
+		},
+		{
+			desc:     "missing zone",
+			expected: "standalone: missing zone",
+		},
+		{
+			desc:     "invalid zone",
+			zone:     "example..com",
+			expected: "standalone: invalid zone: example..com",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Zone = test.zone
+			config.Nameservers = test.nameservers
+			config.Mbox = test.mbox
+
+			p, err := NewDNSProviderConfig(config)
+
+			if test.expected == "" {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+				require.NotNil(t, p.config)
+
+				assert.Equal(t, test.expectedNameservers, p.nameservers)
+				assert.Equal(t, test.expectedMbox, p.mbox)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestDNSProvider(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	config := NewDefaultConfig()
+	config.Zone = "acme.example.com"
+	config.Address = "127.0.0.1:0"
+	config.Nameservers = []string{"ns1.example.com", "ns2.example.com"}
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	require.NoError(t, p.Present("www.acme.example.com", "token", "keyAuth1"))
+	require.NoError(t, p.Present("www.acme.example.com", "token", "keyAuth2"))
+
+	addr := p.servers[0].PacketConn.LocalAddr().String()
+
+	value1 := dns01.GetChallengeInfo("www.acme.example.com", "keyAuth1").Value
+	value2 := dns01.GetChallengeInfo("www.acme.example.com", "keyAuth2").Value
+
+	for _, network := range []string{"udp", "tcp"} {
+		t.Run(network, func(t *testing.T) {
+			client := &dns.Client{Net: network}
+
+			testCases := []struct {
+				desc     string
+				name     string
+				qtype    uint16
+				rcode    int
+				expected []string
+			}{
+				{
+					desc:     "TXT",
+					name:     "_acme-challenge.WWW.acme.example.com.",
+					qtype:    dns.TypeTXT,
+					rcode:    dns.RcodeSuccess,
+					expected: []string{value1, value2},
+				},
+				{
+					desc:     "SOA",
+					name:     "acme.example.com.",
+					qtype:    dns.TypeSOA,
+					rcode:    dns.RcodeSuccess,
+					expected: []string{"ns1.example.com."},
+				},
+				{
+					desc:     "NS",
+					name:     "acme.example.com.",
+					qtype:    dns.TypeNS,
+					rcode:    dns.RcodeSuccess,
+					expected: []string{"ns1.example.com.", "ns2.example.com."},
+				},
+				{
+					desc:  "NODATA",
+					name:  "_acme-challenge.www.acme.example.com.",
+					qtype: dns.TypeA,
+					rcode: dns.RcodeSuccess,
+				},
+				{
+					desc:  "empty non-terminal",
+					name:  "www.acme.example.com.",
+					qtype: dns.TypeTXT,
+					rcode: dns.RcodeSuccess,
+				},
+				{
+					desc:  "NXDOMAIN",
+					name:  "_acme-challenge.foo.acme.example.com.",
+					qtype: dns.TypeTXT,
+					rcode: dns.RcodeNameError,
+				},
+				{
+					desc:  "outside of the zone",
+					name:  "_acme-challenge.example.com.",
+					qtype: dns.TypeTXT,
+					rcode: dns.RcodeRefused,
+				},
+			}
+
+			for _, test := range testCases {
+				t.Run(test.desc, func(t *testing.T) {
+					m := new(dns.Msg).SetQuestion(test.name, test.qtype)
+
+					r, _, err := client.Exchange(m, addr)
+					require.NoError(t, err)
+
+					assert.Equal(t, test.rcode, r.Rcode)
+
+					if test.rcode == dns.RcodeRefused {
+						assert.False(t, r.Authoritative)
+						return
+					}
+
+					assert.True(t, r.Authoritative)
+
+					var values []string
+
+					for _, rr := range r.Answer {
+						switch v := rr.(type) {
+						case *dns.TXT:
+							values = append(values, v.Txt...)
+						case *dns.SOA:
+							values = append(values, v.Ns)
+						case *dns.NS:
+							values = append(values, v.Ns)
+						}
+					}
+
+					assert.Equal(t, test.expected, values)
+
+					if len(test.expected) == 0 {
+						require.Len(t, r.Ns, 1)
+						assert.IsType(t, &dns.SOA{}, r.Ns[0])
+					}
+				})
+			}
+		})
+	}
+
+	require.NoError(t, p.CleanUp("www.acme.example.com", "token", "keyAuth1"))
+
+	// The server is still running.
+	require.Len(t, p.servers, 2)
+
+	require.NoError(t, p.CleanUp("www.acme.example.com", "token", "keyAuth2"))
+
+	assert.Empty(t, p.servers)
+	assert.Empty(t, p.records)
+
+	_, _, err = new(dns.Client).Exchange(new(dns.Msg).SetQuestion("acme.example.com.", dns.TypeSOA), addr)
+	require.Error(t, err)
+}
+
+func TestDNSProvider_Present_outsideZone(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	config := NewDefaultConfig()
+	config.Zone = "acme.example.com"
+	config.Address = "127.0.0.1:0"
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = p.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "standalone: the FQDN _acme-challenge.example.com. is not in the zone acme.example.com.: a CNAME to the zone is required")
+
+	assert.Empty(t, p.servers)
+}
@@ -39,10 +39,14 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 10),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 12*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, time.Minute),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 10),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 12*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, time.Minute),
 	}
 }
 
@@ -56,15 +60,21 @@ type DNSProvider struct {
 // Credentials must be passed in the environment variables:
 // VEGADNS_URL, SECRET_VEGADNS_KEY, SECRET_VEGADNS_SECRET.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvURL)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvURL)
 	if err != nil {
 		return nil, fmt.Errorf("vegadns: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.BaseURL = values[EnvURL]
-	config.APIKey = env.GetOrFile(EnvKey)
-	config.APISecret = env.GetOrFile(EnvSecret)
+	config.APIKey = src.GetOrFile(EnvKey)
+	config.APISecret = src.GetOrFile(EnvSecret)
 
 	return NewDNSProviderConfig(config)
 }
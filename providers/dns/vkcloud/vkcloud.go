@@ -58,10 +58,14 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		TTL:                env.GetOrDefaultInt(EnvTTL, 60),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		TTL:                src.GetOrDefaultInt(EnvTTL, 60),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 	}
 }
 
@@ -73,18 +77,24 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance configured for VK Cloud.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvProjectID, EnvUsername, EnvPassword)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvProjectID, EnvUsername, EnvPassword)
 	if err != nil {
 		return nil, fmt.Errorf("vkcloud: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.ProjectID = values[EnvProjectID]
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
-	config.IdentityEndpoint = env.GetOrDefaultString(EnvIdentityEndpoint, defaultIdentityEndpoint)
-	config.DomainName = env.GetOrDefaultString(EnvDomainName, defaultDomainName)
-	config.DNSEndpoint = env.GetOrDefaultString(EnvDNSEndpoint, defaultDNSEndpoint)
+	config.IdentityEndpoint = src.GetOrDefaultString(EnvIdentityEndpoint, defaultIdentityEndpoint)
+	config.DomainName = src.GetOrDefaultString(EnvDomainName, defaultDomainName)
+	config.DNSEndpoint = src.GetOrDefaultString(EnvDNSEndpoint, defaultDNSEndpoint)
 
 	return NewDNSProviderConfig(config)
 }
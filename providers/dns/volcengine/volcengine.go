@@ -57,15 +57,19 @@ type Config struct {
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
+	return newDefaultConfig(env.Environment())
+}
+
+func newDefaultConfig(src *env.Source) *Config {
 	return &Config{
-		Scheme: env.GetOrDefaultString(EnvScheme, "https"),
-		Host:   env.GetOrDefaultString(EnvHost, "open.volcengineapi.com"),
-		Region: env.GetOrDefaultString(EnvRegion, volc.DefaultRegion),
-
-		TTL:                env.GetOrDefaultInt(EnvTTL, defaultTTL),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 4*time.Minute),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
-		HTTPTimeout:        env.GetOrDefaultSecond(EnvHTTPTimeout, volc.Timeout*time.Second),
+		Scheme: src.GetOrDefaultString(EnvScheme, "https"),
+		Host:   src.GetOrDefaultString(EnvHost, "open.volcengineapi.com"),
+		Region: src.GetOrDefaultString(EnvRegion, volc.DefaultRegion),
+
+		TTL:                src.GetOrDefaultInt(EnvTTL, defaultTTL),
+		PropagationTimeout: src.GetOrDefaultSecond(EnvPropagationTimeout, 4*time.Minute),
+		PollingInterval:    src.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		HTTPTimeout:        src.GetOrDefaultSecond(EnvHTTPTimeout, volc.Timeout*time.Second),
 	}
 }
 
@@ -81,12 +85,18 @@ type DNSProvider struct {
 // NewDNSProvider returns a DNSProvider instance configured for Volcano Engine.
 // Credentials must be passed in the environment variable: VOLC_ACCESSKEY, VOLC_SECRETKEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvAccessKey, EnvSecretKey)
+	return NewDNSProviderFrom(env.Environment())
+}
+
+// NewDNSProviderFrom returns a DNSProvider instance configured with the values of the source
+// instead of the environment variables.
+func NewDNSProviderFrom(src *env.Source) (*DNSProvider, error) {
+	values, err := src.Get(EnvAccessKey, EnvSecretKey)
 	if err != nil {
 		return nil, fmt.Errorf("volcengine: %w", err)
 	}
 
-	config := NewDefaultConfig()
+	config := newDefaultConfig(src)
 	config.AccessKey = values[EnvAccessKey]
 	config.SecretKey = values[EnvSecretKey]
 
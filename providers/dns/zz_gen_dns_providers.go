@@ -4,9 +4,11 @@ package dns
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/providers/dns/acmedns"
 	"github.com/go-acme/lego/v4/providers/dns/alidns"
 	"github.com/go-acme/lego/v4/providers/dns/allinkl"
@@ -129,6 +131,7 @@ import (
 	"github.com/go-acme/lego/v4/providers/dns/simply"
 	"github.com/go-acme/lego/v4/providers/dns/sonic"
 	"github.com/go-acme/lego/v4/providers/dns/stackpath"
+	"github.com/go-acme/lego/v4/providers/dns/standalone"
 	"github.com/go-acme/lego/v4/providers/dns/technitium"
 	"github.com/go-acme/lego/v4/providers/dns/tencentcloud"
 	"github.com/go-acme/lego/v4/providers/dns/timewebcloud"
@@ -155,7 +158,12 @@ import (
 )
 
 // NewDNSChallengeProviderByName Factory for DNS providers.
+// Several provider names separated by "+" (i.e. "route53+cloudflare") create a FanOutProvider.
 func NewDNSChallengeProviderByName(name string) (challenge.Provider, error) {
+	if strings.Contains(name, fanOutSeparator) {
+		return newFanOutProviderByName(name, NewDNSChallengeProviderByName)
+	}
+
 	switch name {
 	case "manual":
 		return dns01.NewDNSProviderManual()
@@ -244,7 +252,8 @@ func NewDNSChallengeProviderByName(name string) (challenge.Provider, error) {
 	case "epik":
 		return epik.NewDNSProvider()
 	case "exec":
-		return exec.NewDNSProvider()
+		// The challenges of an order are solved in one call of the program in the JSON mode.
+		return exec.NewChallengeProvider()
 	case "exoscale":
 		return exoscale.NewDNSProvider()
 	case "freemyip":
@@ -403,6 +412,8 @@ func NewDNSChallengeProviderByName(name string) (challenge.Provider, error) {
 		return sonic.NewDNSProvider()
 	case "stackpath":
 		return stackpath.NewDNSProvider()
+	case "standalone":
+		return standalone.NewDNSProvider()
 	case "technitium":
 		return technitium.NewDNSProvider()
 	case "tencentcloud":
@@ -453,3 +464,588 @@ func NewDNSChallengeProviderByName(name string) (challenge.Provider, error) {
 		return nil, fmt.Errorf("unrecognized DNS provider: %s", name)
 	}
 }
+
+// newDNSProviderFrom creates a DNS provider configured with the values of the source.
+// The providers reading the environment through a third-party client (i.e. an SDK) are not supported.
+func newDNSProviderFrom(name string, src *env.Source) (challenge.Provider, error) {
+	switch name {
+	case "acme-dns", "acmedns":
+		return acmedns.NewDNSProviderFrom(src)
+	case "alidns":
+		return alidns.NewDNSProviderFrom(src)
+	case "allinkl":
+		return allinkl.NewDNSProviderFrom(src)
+	case "arvancloud":
+		return arvancloud.NewDNSProviderFrom(src)
+	case "auroradns":
+		return auroradns.NewDNSProviderFrom(src)
+	case "autodns":
+		return autodns.NewDNSProviderFrom(src)
+	case "azuredns":
+		return azuredns.NewDNSProviderFrom(src)
+	case "bindman":
+		return bindman.NewDNSProviderFrom(src)
+	case "bluecat":
+		return bluecat.NewDNSProviderFrom(src)
+	case "brandit":
+		return brandit.NewDNSProviderFrom(src)
+	case "bunny":
+		return bunny.NewDNSProviderFrom(src)
+	case "checkdomain":
+		return checkdomain.NewDNSProviderFrom(src)
+	case "civo":
+		return civo.NewDNSProviderFrom(src)
+	case "clouddns":
+		return clouddns.NewDNSProviderFrom(src)
+	case "cloudflare":
+		return cloudflare.NewDNSProviderFrom(src)
+	case "cloudns":
+		return cloudns.NewDNSProviderFrom(src)
+	case "cloudru":
+		return cloudru.NewDNSProviderFrom(src)
+	case "conoha":
+		return conoha.NewDNSProviderFrom(src)
+	case "constellix":
+		return constellix.NewDNSProviderFrom(src)
+	case "corenetworks":
+		return corenetworks.NewDNSProviderFrom(src)
+	case "cpanel":
+		return cpanel.NewDNSProviderFrom(src)
+	case "derak":
+		return derak.NewDNSProviderFrom(src)
+	case "desec":
+		return desec.NewDNSProviderFrom(src)
+	case "digitalocean":
+		return digitalocean.NewDNSProviderFrom(src)
+	case "directadmin":
+		return directadmin.NewDNSProviderFrom(src)
+	case "dnshomede":
+		return dnshomede.NewDNSProviderFrom(src)
+	case "dnsimple":
+		return dnsimple.NewDNSProviderFrom(src)
+	case "dnsmadeeasy":
+		return dnsmadeeasy.NewDNSProviderFrom(src)
+	case "dnspod":
+		return dnspod.NewDNSProviderFrom(src)
+	case "dode":
+		return dode.NewDNSProviderFrom(src)
+	case "domeneshop", "domainnameshop":
+		return domeneshop.NewDNSProviderFrom(src)
+	case "dreamhost":
+		return dreamhost.NewDNSProviderFrom(src)
+	case "duckdns":
+		return duckdns.NewDNSProviderFrom(src)
+	case "dyn":
+		return dyn.NewDNSProviderFrom(src)
+	case "dynu":
+		return dynu.NewDNSProviderFrom(src)
+	case "easydns":
+		return easydns.NewDNSProviderFrom(src)
+	case "efficientip":
+		return efficientip.NewDNSProviderFrom(src)
+	case "epik":
+		return epik.NewDNSProviderFrom(src)
+	case "exec":
+		return exec.NewChallengeProviderFrom(src)
+	case "exoscale":
+		return exoscale.NewDNSProviderFrom(src)
+	case "freemyip":
+		return freemyip.NewDNSProviderFrom(src)
+	case "gandi":
+		return gandi.NewDNSProviderFrom(src)
+	case "gandiv5":
+		return gandiv5.NewDNSProviderFrom(src)
+	case "gcloud":
+		return gcloud.NewDNSProviderFrom(src)
+	case "gcore":
+		return gcore.NewDNSProviderFrom(src)
+	case "glesys":
+		return glesys.NewDNSProviderFrom(src)
+	case "godaddy":
+		return godaddy.NewDNSProviderFrom(src)
+	case "googledomains":
+		return googledomains.NewDNSProviderFrom(src)
+	case "hetzner":
+		return hetzner.NewDNSProviderFrom(src)
+	case "hostingde":
+		return hostingde.NewDNSProviderFrom(src)
+	case "hosttech":
+		return hosttech.NewDNSProviderFrom(src)
+	case "httpnet":
+		return httpnet.NewDNSProviderFrom(src)
+	case "httpreq":
+		return httpreq.NewDNSProviderFrom(src)
+	case "huaweicloud":
+		return huaweicloud.NewDNSProviderFrom(src)
+	case "hurricane":
+		return hurricane.NewDNSProviderFrom(src)
+	case "hyperone":
+		return hyperone.NewDNSProviderFrom(src)
+	case "iij":
+		return iij.NewDNSProviderFrom(src)
+	case "iijdpf":
+		return iijdpf.NewDNSProviderFrom(src)
+	case "infoblox":
+		return infoblox.NewDNSProviderFrom(src)
+	case "infomaniak":
+		return infomaniak.NewDNSProviderFrom(src)
+	case "internetbs":
+		return internetbs.NewDNSProviderFrom(src)
+	case "inwx":
+		return inwx.NewDNSProviderFrom(src)
+	case "ionos":
+		return ionos.NewDNSProviderFrom(src)
+	case "ipv64":
+		return ipv64.NewDNSProviderFrom(src)
+	case "iwantmyname":
+		return iwantmyname.NewDNSProviderFrom(src)
+	case "joker":
+		return joker.NewDNSProviderFrom(src)
+	case "liara":
+		return liara.NewDNSProviderFrom(src)
+	case "limacity":
+		return limacity.NewDNSProviderFrom(src)
+	case "linode", "linodev4":
+		return linode.NewDNSProviderFrom(src)
+	case "liquidweb":
+		return liquidweb.NewDNSProviderFrom(src)
+	case "loopia":
+		return loopia.NewDNSProviderFrom(src)
+	case "luadns":
+		return luadns.NewDNSProviderFrom(src)
+	case "mailinabox":
+		return mailinabox.NewDNSProviderFrom(src)
+	case "manageengine":
+		return manageengine.NewDNSProviderFrom(src)
+	case "metaname":
+		return metaname.NewDNSProviderFrom(src)
+	case "mijnhost":
+		return mijnhost.NewDNSProviderFrom(src)
+	case "mittwald":
+		return mittwald.NewDNSProviderFrom(src)
+	case "myaddr":
+		return myaddr.NewDNSProviderFrom(src)
+	case "mydnsjp":
+		return mydnsjp.NewDNSProviderFrom(src)
+	case "mythicbeasts":
+		return mythicbeasts.NewDNSProviderFrom(src)
+	case "namecheap":
+		return namecheap.NewDNSProviderFrom(src)
+	case "namedotcom":
+		return namedotcom.NewDNSProviderFrom(src)
+	case "namesilo":
+		return namesilo.NewDNSProviderFrom(src)
+	case "nearlyfreespeech":
+		return nearlyfreespeech.NewDNSProviderFrom(src)
+	case "netcup":
+		return netcup.NewDNSProviderFrom(src)
+	case "netlify":
+		return netlify.NewDNSProviderFrom(src)
+	case "nicmanager":
+		return nicmanager.NewDNSProviderFrom(src)
+	case "nifcloud":
+		return nifcloud.NewDNSProviderFrom(src)
+	case "njalla":
+		return njalla.NewDNSProviderFrom(src)
+	case "nodion":
+		return nodion.NewDNSProviderFrom(src)
+	case "ns1":
+		return ns1.NewDNSProviderFrom(src)
+	case "otc":
+		return otc.NewDNSProviderFrom(src)
+	case "ovh":
+		return ovh.NewDNSProviderFrom(src)
+	case "pdns":
+		return pdns.NewDNSProviderFrom(src)
+	case "plesk":
+		return plesk.NewDNSProviderFrom(src)
+	case "porkbun":
+		return porkbun.NewDNSProviderFrom(src)
+	case "rackspace":
+		return rackspace.NewDNSProviderFrom(src)
+	case "rainyun":
+		return rainyun.NewDNSProviderFrom(src)
+	case "rcodezero":
+		return rcodezero.NewDNSProviderFrom(src)
+	case "regfish":
+		return regfish.NewDNSProviderFrom(src)
+	case "regru":
+		return regru.NewDNSProviderFrom(src)
+	case "rfc2136":
+		return rfc2136.NewDNSProviderFrom(src)
+	case "rimuhosting":
+		return rimuhosting.NewDNSProviderFrom(src)
+	case "route53":
+		return route53.NewDNSProviderFrom(src)
+	case "safedns":
+		return safedns.NewDNSProviderFrom(src)
+	case "scaleway":
+		return scaleway.NewDNSProviderFrom(src)
+	case "selectel":
+		return selectel.NewDNSProviderFrom(src)
+	case "selectelv2":
+		return selectelv2.NewDNSProviderFrom(src)
+	case "selfhostde":
+		return selfhostde.NewDNSProviderFrom(src)
+	case "servercow":
+		return servercow.NewDNSProviderFrom(src)
+	case "shellrent":
+		return shellrent.NewDNSProviderFrom(src)
+	case "simply":
+		return simply.NewDNSProviderFrom(src)
+	case "sonic":
+		return sonic.NewDNSProviderFrom(src)
+	case "stackpath":
+		return stackpath.NewDNSProviderFrom(src)
+	case "standalone":
+		return standalone.NewDNSProviderFrom(src)
+	case "technitium":
+		return technitium.NewDNSProviderFrom(src)
+	case "tencentcloud":
+		return tencentcloud.NewDNSProviderFrom(src)
+	case "timewebcloud":
+		return timewebcloud.NewDNSProviderFrom(src)
+	case "transip":
+		return transip.NewDNSProviderFrom(src)
+	case "ultradns":
+		return ultradns.NewDNSProviderFrom(src)
+	case "variomedia":
+		return variomedia.NewDNSProviderFrom(src)
+	case "vegadns":
+		return vegadns.NewDNSProviderFrom(src)
+	case "vercel":
+		return vercel.NewDNSProviderFrom(src)
+	case "versio":
+		return versio.NewDNSProviderFrom(src)
+	case "vinyldns":
+		return vinyldns.NewDNSProviderFrom(src)
+	case "vkcloud":
+		return vkcloud.NewDNSProviderFrom(src)
+	case "volcengine":
+		return volcengine.NewDNSProviderFrom(src)
+	case "vscale":
+		return vscale.NewDNSProviderFrom(src)
+	case "vultr":
+		return vultr.NewDNSProviderFrom(src)
+	case "webnames":
+		return webnames.NewDNSProviderFrom(src)
+	case "websupport":
+		return websupport.NewDNSProviderFrom(src)
+	case "wedos":
+		return wedos.NewDNSProviderFrom(src)
+	case "westcn":
+		return westcn.NewDNSProviderFrom(src)
+	case "yandex":
+		return yandex.NewDNSProviderFrom(src)
+	case "yandex360":
+		return yandex360.NewDNSProviderFrom(src)
+	case "yandexcloud":
+		return yandexcloud.NewDNSProviderFrom(src)
+	case "zoneee":
+		return zoneee.NewDNSProviderFrom(src)
+	case "zonomi":
+		return zonomi.NewDNSProviderFrom(src)
+	default:
+		return nil, fmt.Errorf("the DNS provider %q cannot be configured from values, only from the environment", name)
+	}
+}
+
+// dnsProviderEnvVars returns the names of the environment variables of a DNS provider, as defined by its descriptor.
+func dnsProviderEnvVars(name string) []string {
+	switch name {
+	case "acme-dns", "acmedns":
+		return []string{"ACME_DNS_API_BASE", "ACME_DNS_STORAGE_BASE_URL", "ACME_DNS_STORAGE_PATH"}
+	case "alidns":
+		return []string{"ALICLOUD_ACCESS_KEY", "ALICLOUD_HTTP_TIMEOUT", "ALICLOUD_POLLING_INTERVAL", "ALICLOUD_PROPAGATION_TIMEOUT", "ALICLOUD_RAM_ROLE", "ALICLOUD_SECRET_KEY", "ALICLOUD_SECURITY_TOKEN", "ALICLOUD_TTL"}
+	case "allinkl":
+		return []string{"ALL_INKL_HTTP_TIMEOUT", "ALL_INKL_LOGIN", "ALL_INKL_PASSWORD", "ALL_INKL_POLLING_INTERVAL", "ALL_INKL_PROPAGATION_TIMEOUT"}
+	case "arvancloud":
+		return []string{"ARVANCLOUD_API_KEY", "ARVANCLOUD_HTTP_TIMEOUT", "ARVANCLOUD_POLLING_INTERVAL", "ARVANCLOUD_PROPAGATION_TIMEOUT", "ARVANCLOUD_TTL"}
+	case "auroradns":
+		return []string{"AURORA_API_KEY", "AURORA_ENDPOINT", "AURORA_POLLING_INTERVAL", "AURORA_PROPAGATION_TIMEOUT", "AURORA_SECRET", "AURORA_TTL"}
+	case "autodns":
+		return []string{"AUTODNS_API_PASSWORD", "AUTODNS_API_USER", "AUTODNS_CONTEXT", "AUTODNS_ENDPOINT", "AUTODNS_HTTP_TIMEOUT", "AUTODNS_POLLING_INTERVAL", "AUTODNS_PROPAGATION_TIMEOUT", "AUTODNS_TTL"}
+	case "azure":
+		return []string{"AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_ENVIRONMENT", "AZURE_METADATA_ENDPOINT", "AZURE_POLLING_INTERVAL", "AZURE_PRIVATE_ZONE", "AZURE_PROPAGATION_TIMEOUT", "AZURE_RESOURCE_GROUP", "AZURE_SUBSCRIPTION_ID", "AZURE_TENANT_ID", "AZURE_TTL", "AZURE_ZONE_NAME", "instance metadata service"}
+	case "azuredns":
+		return []string{"AZURE_AUTH_METHOD", "AZURE_AUTH_MSI_TIMEOUT", "AZURE_CLIENT_CERTIFICATE_PATH", "AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_ENVIRONMENT", "AZURE_POLLING_INTERVAL", "AZURE_PRIVATE_ZONE", "AZURE_PROPAGATION_TIMEOUT", "AZURE_RESOURCE_GROUP", "AZURE_SERVICEDISCOVERY_FILTER", "AZURE_SUBSCRIPTION_ID", "AZURE_TENANT_ID", "AZURE_TTL", "AZURE_ZONE_NAME"}
+	case "bindman":
+		return []string{"BINDMAN_HTTP_TIMEOUT", "BINDMAN_MANAGER_ADDRESS", "BINDMAN_POLLING_INTERVAL", "BINDMAN_PROPAGATION_TIMEOUT"}
+	case "bluecat":
+		return []string{"BLUECAT_CONFIG_NAME", "BLUECAT_DNS_VIEW", "BLUECAT_HTTP_TIMEOUT", "BLUECAT_PASSWORD", "BLUECAT_POLLING_INTERVAL", "BLUECAT_PROPAGATION_TIMEOUT", "BLUECAT_SERVER_URL", "BLUECAT_SKIP_DEPLOY", "BLUECAT_TTL", "BLUECAT_USER_NAME"}
+	case "brandit":
+		return []string{"BRANDIT_API_KEY", "BRANDIT_API_USERNAME", "BRANDIT_HTTP_TIMEOUT", "BRANDIT_POLLING_INTERVAL", "BRANDIT_PROPAGATION_TIMEOUT", "BRANDIT_TTL"}
+	case "bunny":
+		return []string{"BUNNY_API_KEY", "BUNNY_POLLING_INTERVAL", "BUNNY_PROPAGATION_TIMEOUT", "BUNNY_TTL"}
+	case "checkdomain":
+		return []string{"CHECKDOMAIN_ENDPOINT", "CHECKDOMAIN_HTTP_TIMEOUT", "CHECKDOMAIN_POLLING_INTERVAL", "CHECKDOMAIN_PROPAGATION_TIMEOUT", "CHECKDOMAIN_TOKEN", "CHECKDOMAIN_TTL"}
+	case "civo":
+		return []string{"CIVO_POLLING_INTERVAL", "CIVO_PROPAGATION_TIMEOUT", "CIVO_TOKEN", "CIVO_TTL"}
+	case "clouddns":
+		return []string{"CLOUDDNS_CLIENT_ID", "CLOUDDNS_EMAIL", "CLOUDDNS_HTTP_TIMEOUT", "CLOUDDNS_PASSWORD", "CLOUDDNS_POLLING_INTERVAL", "CLOUDDNS_PROPAGATION_TIMEOUT", "CLOUDDNS_TTL"}
+	case "cloudflare":
+		return []string{"CF_API_EMAIL", "CF_API_KEY", "CF_DNS_API_TOKEN", "CF_ZONE_API_TOKEN", "CLOUDFLARE_API_KEY", "CLOUDFLARE_DNS_API_TOKEN", "CLOUDFLARE_EMAIL", "CLOUDFLARE_HTTP_TIMEOUT", "CLOUDFLARE_POLLING_INTERVAL", "CLOUDFLARE_PROPAGATION_TIMEOUT", "CLOUDFLARE_TTL", "CLOUDFLARE_ZONE_API_TOKEN"}
+	case "cloudns":
+		return []string{"CLOUDNS_AUTH_ID", "CLOUDNS_AUTH_PASSWORD", "CLOUDNS_HTTP_TIMEOUT", "CLOUDNS_POLLING_INTERVAL", "CLOUDNS_PROPAGATION_TIMEOUT", "CLOUDNS_SUB_AUTH_ID", "CLOUDNS_TTL"}
+	case "cloudru":
+		return []string{"CLOUDRU_HTTP_TIMEOUT", "CLOUDRU_KEY_ID", "CLOUDRU_POLLING_INTERVAL", "CLOUDRU_PROPAGATION_TIMEOUT", "CLOUDRU_SECRET", "CLOUDRU_SEQUENCE_INTERVAL", "CLOUDRU_SERVICE_INSTANCE_ID", "CLOUDRU_TTL"}
+	case "cloudxns":
+		return []string{"CLOUDXNS_API_KEY", "CLOUDXNS_HTTP_TIMEOUT", "CLOUDXNS_POLLING_INTERVAL", "CLOUDXNS_PROPAGATION_TIMEOUT", "CLOUDXNS_SECRET_KEY", "CLOUDXNS_TTL"}
+	case "conoha":
+		return []string{"CONOHA_API_PASSWORD", "CONOHA_API_USERNAME", "CONOHA_HTTP_TIMEOUT", "CONOHA_POLLING_INTERVAL", "CONOHA_PROPAGATION_TIMEOUT", "CONOHA_REGION", "CONOHA_TENANT_ID", "CONOHA_TTL"}
+	case "constellix":
+		return []string{"CONSTELLIX_API_KEY", "CONSTELLIX_HTTP_TIMEOUT", "CONSTELLIX_POLLING_INTERVAL", "CONSTELLIX_PROPAGATION_TIMEOUT", "CONSTELLIX_SECRET_KEY", "CONSTELLIX_TTL"}
+	case "corenetworks":
+		return []string{"CORENETWORKS_HTTP_TIMEOUT", "CORENETWORKS_LOGIN", "CORENETWORKS_PASSWORD", "CORENETWORKS_POLLING_INTERVAL", "CORENETWORKS_PROPAGATION_TIMEOUT", "CORENETWORKS_SEQUENCE_INTERVAL", "CORENETWORKS_TTL"}
+	case "cpanel":
+		return []string{"CPANEL_BASE_URL", "CPANEL_HTTP_TIMEOUT", "CPANEL_MODE", "CPANEL_POLLING_INTERVAL", "CPANEL_PROPAGATION_TIMEOUT", "CPANEL_TOKEN", "CPANEL_TTL", "CPANEL_USERNAME"}
+	case "derak":
+		return []string{"DERAK_API_KEY", "DERAK_HTTP_TIMEOUT", "DERAK_POLLING_INTERVAL", "DERAK_PROPAGATION_TIMEOUT", "DERAK_TTL", "DERAK_WEBSITE_ID"}
+	case "desec":
+		return []string{"DESEC_HTTP_TIMEOUT", "DESEC_POLLING_INTERVAL", "DESEC_PROPAGATION_TIMEOUT", "DESEC_TOKEN", "DESEC_TTL"}
+	case "designate":
+		return []string{"DESIGNATE_POLLING_INTERVAL", "DESIGNATE_PROPAGATION_TIMEOUT", "DESIGNATE_TTL", "DESIGNATE_ZONE_NAME", "OS_APPLICATION_CREDENTIAL_ID", "OS_APPLICATION_CREDENTIAL_NAME", "OS_APPLICATION_CREDENTIAL_SECRET", "OS_AUTH_URL", "OS_PASSWORD", "OS_PROJECT_ID", "OS_PROJECT_NAME", "OS_REGION_NAME", "OS_TENANT_NAME", "OS_USERNAME", "OS_USER_ID"}
+	case "digitalocean":
+		return []string{"DO_API_URL", "DO_AUTH_TOKEN", "DO_HTTP_TIMEOUT", "DO_POLLING_INTERVAL", "DO_PROPAGATION_TIMEOUT", "DO_TTL"}
+	case "directadmin":
+		return []string{"DIRECTADMIN_API_URL", "DIRECTADMIN_HTTP_TIMEOUT", "DIRECTADMIN_PASSWORD", "DIRECTADMIN_POLLING_INTERVAL", "DIRECTADMIN_PROPAGATION_TIMEOUT", "DIRECTADMIN_TTL", "DIRECTADMIN_USERNAME", "DIRECTADMIN_ZONE_NAME"}
+	case "dnshomede":
+		return []string{"DNSHOMEDE_CREDENTIALS", "DNSHOMEDE_HTTP_TIMEOUT", "DNSHOMEDE_POLLING_INTERVAL", "DNSHOMEDE_PROPAGATION_TIMEOUT", "DNSHOMEDE_SEQUENCE_INTERVAL"}
+	case "dnsimple":
+		return []string{"DNSIMPLE_BASE_URL", "DNSIMPLE_OAUTH_TOKEN", "DNSIMPLE_POLLING_INTERVAL", "DNSIMPLE_PROPAGATION_TIMEOUT", "DNSIMPLE_TTL"}
+	case "dnsmadeeasy":
+		return []string{"DNSMADEEASY_API_KEY", "DNSMADEEASY_API_SECRET", "DNSMADEEASY_HTTP_TIMEOUT", "DNSMADEEASY_POLLING_INTERVAL", "DNSMADEEASY_PROPAGATION_TIMEOUT", "DNSMADEEASY_SANDBOX", "DNSMADEEASY_TTL"}
+	case "dnspod":
+		return []string{"DNSPOD_API_KEY", "DNSPOD_HTTP_TIMEOUT", "DNSPOD_POLLING_INTERVAL", "DNSPOD_PROPAGATION_TIMEOUT", "DNSPOD_TTL"}
+	case "dode":
+		return []string{"DODE_HTTP_TIMEOUT", "DODE_POLLING_INTERVAL", "DODE_PROPAGATION_TIMEOUT", "DODE_SEQUENCE_INTERVAL", "DODE_TOKEN", "DODE_TTL"}
+	case "domeneshop", "domainnameshop":
+		return []string{"DOMENESHOP_API_SECRET", "DOMENESHOP_API_TOKEN", "DOMENESHOP_HTTP_TIMEOUT", "DOMENESHOP_POLLING_INTERVAL", "DOMENESHOP_PROPAGATION_TIMEOUT"}
+	case "dreamhost":
+		return []string{"DREAMHOST_API_KEY", "DREAMHOST_HTTP_TIMEOUT", "DREAMHOST_POLLING_INTERVAL", "DREAMHOST_PROPAGATION_TIMEOUT"}
+	case "duckdns":
+		return []string{"DUCKDNS_HTTP_TIMEOUT", "DUCKDNS_POLLING_INTERVAL", "DUCKDNS_PROPAGATION_TIMEOUT", "DUCKDNS_SEQUENCE_INTERVAL", "DUCKDNS_TOKEN", "DUCKDNS_TTL"}
+	case "dyn":
+		return []string{"DYN_CUSTOMER_NAME", "DYN_HTTP_TIMEOUT", "DYN_PASSWORD", "DYN_POLLING_INTERVAL", "DYN_PROPAGATION_TIMEOUT", "DYN_TTL", "DYN_USER_NAME"}
+	case "dynu":
+		return []string{"DYNU_API_KEY", "DYNU_HTTP_TIMEOUT", "DYNU_POLLING_INTERVAL", "DYNU_PROPAGATION_TIMEOUT", "DYNU_TTL"}
+	case "easydns":
+		return []string{"EASYDNS_ENDPOINT", "EASYDNS_HTTP_TIMEOUT", "EASYDNS_KEY", "EASYDNS_POLLING_INTERVAL", "EASYDNS_PROPAGATION_TIMEOUT", "EASYDNS_SEQUENCE_INTERVAL", "EASYDNS_TOKEN", "EASYDNS_TTL"}
+	case "edgedns", "fastdns":
+		return []string{"AKAMAI_ACCESS_TOKEN", "AKAMAI_CLIENT_SECRET", "AKAMAI_CLIENT_TOKEN", "AKAMAI_EDGERC", "AKAMAI_EDGERC_SECTION", "AKAMAI_HOST", "AKAMAI_POLLING_INTERVAL", "AKAMAI_PROPAGATION_TIMEOUT", "AKAMAI_TTL"}
+	case "efficientip":
+		return []string{"EFFICIENTIP_DNS_NAME", "EFFICIENTIP_HOSTNAME", "EFFICIENTIP_HTTP_TIMEOUT", "EFFICIENTIP_INSECURE_SKIP_VERIFY", "EFFICIENTIP_PASSWORD", "EFFICIENTIP_POLLING_INTERVAL", "EFFICIENTIP_PROPAGATION_TIMEOUT", "EFFICIENTIP_USERNAME", "EFFICIENTIP_VIEW_NAME"}
+	case "epik":
+		return []string{"EPIK_HTTP_TIMEOUT", "EPIK_POLLING_INTERVAL", "EPIK_PROPAGATION_TIMEOUT", "EPIK_SIGNATURE", "EPIK_TTL"}
+	case "exec":
+		return []string{"EXEC_MODE", "EXEC_PATH", "EXEC_POLLING_INTERVAL", "EXEC_PROPAGATION_TIMEOUT", "EXEC_SEQUENCE_INTERVAL", "EXEC_TIMEOUT", "EXEC_TTL"}
+	case "exoscale":
+		return []string{"EXOSCALE_API_KEY", "EXOSCALE_API_SECRET", "EXOSCALE_ENDPOINT", "EXOSCALE_HTTP_TIMEOUT", "EXOSCALE_POLLING_INTERVAL", "EXOSCALE_PROPAGATION_TIMEOUT", "EXOSCALE_TTL"}
+	case "freemyip":
+		return []string{"FREEMYIP_HTTP_TIMEOUT", "FREEMYIP_POLLING_INTERVAL", "FREEMYIP_PROPAGATION_TIMEOUT", "FREEMYIP_SEQUENCE_INTERVAL", "FREEMYIP_TOKEN", "FREEMYIP_TTL"}
+	case "gandi":
+		return []string{"GANDI_API_KEY", "GANDI_HTTP_TIMEOUT", "GANDI_POLLING_INTERVAL", "GANDI_PROPAGATION_TIMEOUT", "GANDI_TTL"}
+	case "gandiv5":
+		return []string{"GANDIV5_API_KEY", "GANDIV5_HTTP_TIMEOUT", "GANDIV5_PERSONAL_ACCESS_TOKEN", "GANDIV5_POLLING_INTERVAL", "GANDIV5_PROPAGATION_TIMEOUT", "GANDIV5_TTL"}
+	case "gcloud":
+		return []string{"Application Default Credentials", "GCE_ALLOW_PRIVATE_ZONE", "GCE_POLLING_INTERVAL", "GCE_PROJECT", "GCE_PROPAGATION_TIMEOUT", "GCE_SERVICE_ACCOUNT", "GCE_SERVICE_ACCOUNT_FILE", "GCE_TTL", "GCE_ZONE_ID"}
+	case "gcore":
+		return []string{"GCORE_HTTP_TIMEOUT", "GCORE_PERMANENT_API_TOKEN", "GCORE_POLLING_INTERVAL", "GCORE_PROPAGATION_TIMEOUT", "GCORE_TTL"}
+	case "glesys":
+		return []string{"GLESYS_API_KEY", "GLESYS_API_USER", "GLESYS_HTTP_TIMEOUT", "GLESYS_POLLING_INTERVAL", "GLESYS_PROPAGATION_TIMEOUT", "GLESYS_TTL"}
+	case "godaddy":
+		return []string{"GODADDY_API_KEY", "GODADDY_API_SECRET", "GODADDY_HTTP_TIMEOUT", "GODADDY_POLLING_INTERVAL", "GODADDY_PROPAGATION_TIMEOUT", "GODADDY_TTL"}
+	case "googledomains":
+		return []string{"GOOGLE_DOMAINS_ACCESS_TOKEN", "GOOGLE_DOMAINS_HTTP_TIMEOUT", "GOOGLE_DOMAINS_POLLING_INTERVAL", "GOOGLE_DOMAINS_PROPAGATION_TIMEOUT"}
+	case "hetzner":
+		return []string{"HETZNER_API_KEY", "HETZNER_HTTP_TIMEOUT", "HETZNER_POLLING_INTERVAL", "HETZNER_PROPAGATION_TIMEOUT", "HETZNER_TTL"}
+	case "hostingde":
+		return []string{"HOSTINGDE_API_KEY", "HOSTINGDE_HTTP_TIMEOUT", "HOSTINGDE_POLLING_INTERVAL", "HOSTINGDE_PROPAGATION_TIMEOUT", "HOSTINGDE_TTL", "HOSTINGDE_ZONE_NAME"}
+	case "hosttech":
+		return []string{"HOSTTECH_API_KEY", "HOSTTECH_HTTP_TIMEOUT", "HOSTTECH_PASSWORD", "HOSTTECH_POLLING_INTERVAL", "HOSTTECH_PROPAGATION_TIMEOUT", "HOSTTECH_TTL"}
+	case "httpnet":
+		return []string{"HTTPNET_API_KEY", "HTTPNET_HTTP_TIMEOUT", "HTTPNET_POLLING_INTERVAL", "HTTPNET_PROPAGATION_TIMEOUT", "HTTPNET_TTL", "HTTPNET_ZONE_NAME"}
+	case "httpreq":
+		return []string{"HTTPREQ_ENDPOINT", "HTTPREQ_HTTP_TIMEOUT", "HTTPREQ_MODE", "HTTPREQ_PASSWORD", "HTTPREQ_POLLING_INTERVAL", "HTTPREQ_PROPAGATION_TIMEOUT", "HTTPREQ_USERNAME"}
+	case "huaweicloud":
+		return []string{"HUAWEICLOUD_ACCESS_KEY_ID", "HUAWEICLOUD_HTTP_TIMEOUT", "HUAWEICLOUD_POLLING_INTERVAL", "HUAWEICLOUD_PROPAGATION_TIMEOUT", "HUAWEICLOUD_REGION", "HUAWEICLOUD_SECRET_ACCESS_KEY", "HUAWEICLOUD_TTL"}
+	case "hurricane":
+		return []string{"HURRICANE_HTTP_TIMEOUT", "HURRICANE_POLLING_INTERVAL", "HURRICANE_PROPAGATION_TIMEOUT", "HURRICANE_SEQUENCE_INTERVAL", "HURRICANE_TOKENS"}
+	case "hyperone":
+		return []string{"HYPERONE_API_URL", "HYPERONE_HTTP_TIMEOUT", "HYPERONE_LOCATION_ID", "HYPERONE_PASSPORT_LOCATION", "HYPERONE_POLLING_INTERVAL", "HYPERONE_PROPAGATION_TIMEOUT", "HYPERONE_TTL"}
+	case "ibmcloud":
+		return []string{"SOFTLAYER_API_KEY", "SOFTLAYER_POLLING_INTERVAL", "SOFTLAYER_PROPAGATION_TIMEOUT", "SOFTLAYER_TIMEOUT", "SOFTLAYER_TTL", "SOFTLAYER_USERNAME"}
+	case "iij":
+		return []string{"IIJ_API_ACCESS_KEY", "IIJ_API_SECRET_KEY", "IIJ_DO_SERVICE_CODE", "IIJ_POLLING_INTERVAL", "IIJ_PROPAGATION_TIMEOUT", "IIJ_TTL"}
+	case "iijdpf":
+		return []string{"IIJ_DPF_API_ENDPOINT", "IIJ_DPF_API_TOKEN", "IIJ_DPF_DPM_SERVICE_CODE", "IIJ_DPF_POLLING_INTERVAL", "IIJ_DPF_PROPAGATION_TIMEOUT", "IIJ_DPF_TTL"}
+	case "infoblox":
+		return []string{"INFOBLOX_DNS_VIEW", "INFOBLOX_HOST", "INFOBLOX_HTTP_TIMEOUT", "INFOBLOX_PASSWORD", "INFOBLOX_POLLING_INTERVAL", "INFOBLOX_PORT", "INFOBLOX_PROPAGATION_TIMEOUT", "INFOBLOX_SSL_VERIFY", "INFOBLOX_TTL", "INFOBLOX_USERNAME", "INFOBLOX_WAPI_VERSION"}
+	case "infomaniak":
+		return []string{"INFOMANIAK_ACCESS_TOKEN", "INFOMANIAK_ENDPOINT", "INFOMANIAK_HTTP_TIMEOUT", "INFOMANIAK_POLLING_INTERVAL", "INFOMANIAK_PROPAGATION_TIMEOUT", "INFOMANIAK_TTL"}
+	case "internetbs":
+		return []string{"INTERNET_BS_API_KEY", "INTERNET_BS_HTTP_TIMEOUT", "INTERNET_BS_PASSWORD", "INTERNET_BS_POLLING_INTERVAL", "INTERNET_BS_PROPAGATION_TIMEOUT", "INTERNET_BS_TTL"}
+	case "inwx":
+		return []string{"INWX_PASSWORD", "INWX_POLLING_INTERVAL", "INWX_PROPAGATION_TIMEOUT", "INWX_SANDBOX", "INWX_SHARED_SECRET", "INWX_TTL", "INWX_USERNAME"}
+	case "ionos":
+		return []string{"IONOS_API_KEY", "IONOS_HTTP_TIMEOUT", "IONOS_POLLING_INTERVAL", "IONOS_PROPAGATION_TIMEOUT", "IONOS_TTL"}
+	case "ipv64":
+		return []string{"IPV64_API_KEY", "IPV64_HTTP_TIMEOUT", "IPV64_POLLING_INTERVAL", "IPV64_PROPAGATION_TIMEOUT"}
+	case "iwantmyname":
+		return []string{"IWANTMYNAME_HTTP_TIMEOUT", "IWANTMYNAME_PASSWORD", "IWANTMYNAME_POLLING_INTERVAL", "IWANTMYNAME_PROPAGATION_TIMEOUT", "IWANTMYNAME_TTL", "IWANTMYNAME_USERNAME"}
+	case "joker":
+		return []string{"JOKER_API_KEY", "JOKER_API_MODE", "JOKER_HTTP_TIMEOUT", "JOKER_PASSWORD", "JOKER_POLLING_INTERVAL", "JOKER_PROPAGATION_TIMEOUT", "JOKER_SEQUENCE_INTERVAL", "JOKER_TTL", "JOKER_USERNAME"}
+	case "liara":
+		return []string{"LIARA_API_KEY", "LIARA_HTTP_TIMEOUT", "LIARA_POLLING_INTERVAL", "LIARA_PROPAGATION_TIMEOUT", "LIARA_TTL"}
+	case "lightsail":
+		return []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SHARED_CREDENTIALS_FILE", "DNS_ZONE", "LIGHTSAIL_POLLING_INTERVAL", "LIGHTSAIL_PROPAGATION_TIMEOUT"}
+	case "limacity":
+		return []string{"LIMACITY_API_KEY", "LIMACITY_HTTP_TIMEOUT", "LIMACITY_POLLING_INTERVAL", "LIMACITY_PROPAGATION_TIMEOUT", "LIMACITY_SEQUENCE_INTERVAL", "LIMACITY_TTL"}
+	case "linode", "linodev4":
+		return []string{"LINODE_HTTP_TIMEOUT", "LINODE_POLLING_INTERVAL", "LINODE_PROPAGATION_TIMEOUT", "LINODE_TOKEN", "LINODE_TTL"}
+	case "liquidweb":
+		return []string{"LWAPI_HTTP_TIMEOUT", "LWAPI_PASSWORD", "LWAPI_POLLING_INTERVAL", "LWAPI_PROPAGATION_TIMEOUT", "LWAPI_TTL", "LWAPI_URL", "LWAPI_USERNAME", "LWAPI_ZONE"}
+	case "loopia":
+		return []string{"LOOPIA_API_PASSWORD", "LOOPIA_API_URL", "LOOPIA_API_USER", "LOOPIA_HTTP_TIMEOUT", "LOOPIA_POLLING_INTERVAL", "LOOPIA_PROPAGATION_TIMEOUT", "LOOPIA_TTL"}
+	case "luadns":
+		return []string{"LUADNS_API_TOKEN", "LUADNS_API_USERNAME", "LUADNS_HTTP_TIMEOUT", "LUADNS_POLLING_INTERVAL", "LUADNS_PROPAGATION_TIMEOUT", "LUADNS_TTL"}
+	case "mailinabox":
+		return []string{"MAILINABOX_BASE_URL", "MAILINABOX_EMAIL", "MAILINABOX_PASSWORD", "MAILINABOX_POLLING_INTERVAL", "MAILINABOX_PROPAGATION_TIMEOUT"}
+	case "manageengine":
+		return []string{"MANAGEENGINE_CLIENT_ID", "MANAGEENGINE_CLIENT_SECRET", "MANAGEENGINE_POLLING_INTERVAL", "MANAGEENGINE_PROPAGATION_TIMEOUT", "MANAGEENGINE_TTL"}
+	case "metaname":
+		return []string{"METANAME_ACCOUNT_REFERENCE", "METANAME_API_KEY", "METANAME_POLLING_INTERVAL", "METANAME_PROPAGATION_TIMEOUT", "METANAME_TTL"}
+	case "mijnhost":
+		return []string{"MIJNHOST_API_KEY", "MIJNHOST_HTTP_TIMEOUT", "MIJNHOST_POLLING_INTERVAL", "MIJNHOST_PROPAGATION_TIMEOUT", "MIJNHOST_SEQUENCE_INTERVAL", "MIJNHOST_TTL"}
+	case "mittwald":
+		return []string{"MITTWALD_HTTP_TIMEOUT", "MITTWALD_POLLING_INTERVAL", "MITTWALD_PROPAGATION_TIMEOUT", "MITTWALD_SEQUENCE_INTERVAL", "MITTWALD_TOKEN", "MITTWALD_TTL"}
+	case "myaddr":
+		return []string{"MYADDR_HTTP_TIMEOUT", "MYADDR_POLLING_INTERVAL", "MYADDR_PRIVATE_KEYS_MAPPING", "MYADDR_PROPAGATION_TIMEOUT", "MYADDR_SEQUENCE_INTERVAL", "MYADDR_TTL"}
+	case "mydnsjp":
+		return []string{"MYDNSJP_HTTP_TIMEOUT", "MYDNSJP_MASTER_ID", "MYDNSJP_PASSWORD", "MYDNSJP_POLLING_INTERVAL", "MYDNSJP_PROPAGATION_TIMEOUT"}
+	case "mythicbeasts":
+		return []string{"MYTHICBEASTS_API_ENDPOINT", "MYTHICBEASTS_AUTH_API_ENDPOINT", "MYTHICBEASTS_HTTP_TIMEOUT", "MYTHICBEASTS_PASSWORD", "MYTHICBEASTS_POLLING_INTERVAL", "MYTHICBEASTS_PROPAGATION_TIMEOUT", "MYTHICBEASTS_TTL", "MYTHICBEASTS_USERNAME"}
+	case "namecheap":
+		return []string{"NAMECHEAP_API_KEY", "NAMECHEAP_API_USER", "NAMECHEAP_HTTP_TIMEOUT", "NAMECHEAP_POLLING_INTERVAL", "NAMECHEAP_PROPAGATION_TIMEOUT", "NAMECHEAP_SANDBOX", "NAMECHEAP_TTL"}
+	case "namedotcom":
+		return []string{"NAMECOM_API_TOKEN", "NAMECOM_HTTP_TIMEOUT", "NAMECOM_POLLING_INTERVAL", "NAMECOM_PROPAGATION_TIMEOUT", "NAMECOM_TTL", "NAMECOM_USERNAME"}
+	case "namesilo":
+		return []string{"NAMESILO_API_KEY", "NAMESILO_POLLING_INTERVAL", "NAMESILO_PROPAGATION_TIMEOUT", "NAMESILO_TTL"}
+	case "nearlyfreespeech":
+		return []string{"NEARLYFREESPEECH_API_KEY", "NEARLYFREESPEECH_HTTP_TIMEOUT", "NEARLYFREESPEECH_LOGIN", "NEARLYFREESPEECH_POLLING_INTERVAL", "NEARLYFREESPEECH_PROPAGATION_TIMEOUT", "NEARLYFREESPEECH_SEQUENCE_INTERVAL", "NEARLYFREESPEECH_TTL"}
+	case "netcup":
+		return []string{"NETCUP_API_KEY", "NETCUP_API_PASSWORD", "NETCUP_CUSTOMER_NUMBER", "NETCUP_HTTP_TIMEOUT", "NETCUP_POLLING_INTERVAL", "NETCUP_PROPAGATION_TIMEOUT"}
+	case "netlify":
+		return []string{"NETLIFY_HTTP_TIMEOUT", "NETLIFY_POLLING_INTERVAL", "NETLIFY_PROPAGATION_TIMEOUT", "NETLIFY_TOKEN", "NETLIFY_TTL"}
+	case "nicmanager":
+		return []string{"NICMANAGER_API_EMAIL", "NICMANAGER_API_LOGIN", "NICMANAGER_API_MODE", "NICMANAGER_API_OTP", "NICMANAGER_API_PASSWORD", "NICMANAGER_API_USERNAME", "NICMANAGER_HTTP_TIMEOUT", "NICMANAGER_POLLING_INTERVAL", "NICMANAGER_PROPAGATION_TIMEOUT", "NICMANAGER_TTL"}
+	case "nifcloud":
+		return []string{"NIFCLOUD_ACCESS_KEY_ID", "NIFCLOUD_HTTP_TIMEOUT", "NIFCLOUD_POLLING_INTERVAL", "NIFCLOUD_PROPAGATION_TIMEOUT", "NIFCLOUD_SECRET_ACCESS_KEY", "NIFCLOUD_TTL"}
+	case "njalla":
+		return []string{"NJALLA_HTTP_TIMEOUT", "NJALLA_POLLING_INTERVAL", "NJALLA_PROPAGATION_TIMEOUT", "NJALLA_TOKEN", "NJALLA_TTL"}
+	case "nodion":
+		return []string{"NODION_API_TOKEN", "NODION_HTTP_TIMEOUT", "NODION_POLLING_INTERVAL", "NODION_PROPAGATION_TIMEOUT", "NODION_TTL"}
+	case "ns1":
+		return []string{"NS1_API_KEY", "NS1_HTTP_TIMEOUT", "NS1_POLLING_INTERVAL", "NS1_PROPAGATION_TIMEOUT", "NS1_TTL"}
+	case "oraclecloud":
+		return []string{"OCI_COMPARTMENT_OCID", "OCI_HTTP_TIMEOUT", "OCI_POLLING_INTERVAL", "OCI_PRIVKEY_FILE", "OCI_PRIVKEY_PASS", "OCI_PROPAGATION_TIMEOUT", "OCI_PUBKEY_FINGERPRINT", "OCI_REGION", "OCI_TENANCY_OCID", "OCI_TTL", "OCI_USER_OCID"}
+	case "otc":
+		return []string{"OTC_DOMAIN_NAME", "OTC_HTTP_TIMEOUT", "OTC_IDENTITY_ENDPOINT", "OTC_PASSWORD", "OTC_POLLING_INTERVAL", "OTC_PROJECT_NAME", "OTC_PROPAGATION_TIMEOUT", "OTC_SEQUENCE_INTERVAL", "OTC_TTL", "OTC_USER_NAME"}
+	case "ovh":
+		return []string{"OVH_ACCESS_TOKEN", "OVH_APPLICATION_KEY", "OVH_APPLICATION_SECRET", "OVH_CLIENT_ID", "OVH_CLIENT_SECRET", "OVH_CONSUMER_KEY", "OVH_ENDPOINT", "OVH_HTTP_TIMEOUT", "OVH_POLLING_INTERVAL", "OVH_PROPAGATION_TIMEOUT", "OVH_TTL"}
+	case "pdns":
+		return []string{"PDNS_API_KEY", "PDNS_API_URL", "PDNS_API_VERSION", "PDNS_HTTP_TIMEOUT", "PDNS_POLLING_INTERVAL", "PDNS_PROPAGATION_TIMEOUT", "PDNS_SERVER_NAME", "PDNS_TTL"}
+	case "plesk":
+		return []string{"PLESK_HTTP_TIMEOUT", "PLESK_PASSWORD", "PLESK_POLLING_INTERVAL", "PLESK_PROPAGATION_TIMEOUT", "PLESK_SERVER_BASE_URL", "PLESK_TTL", "PLESK_USERNAME"}
+	case "porkbun":
+		return []string{"PORKBUN_API_KEY", "PORKBUN_HTTP_TIMEOUT", "PORKBUN_POLLING_INTERVAL", "PORKBUN_PROPAGATION_TIMEOUT", "PORKBUN_SECRET_API_KEY", "PORKBUN_TTL"}
+	case "rackspace":
+		return []string{"RACKSPACE_API_KEY", "RACKSPACE_HTTP_TIMEOUT", "RACKSPACE_POLLING_INTERVAL", "RACKSPACE_PROPAGATION_TIMEOUT", "RACKSPACE_TTL", "RACKSPACE_USER"}
+	case "rainyun":
+		return []string{"RAINYUN_API_KEY", "RAINYUN_HTTP_TIMEOUT", "RAINYUN_POLLING_INTERVAL", "RAINYUN_PROPAGATION_TIMEOUT", "RAINYUN_TTL"}
+	case "rcodezero":
+		return []string{"RCODEZERO_API_TOKEN", "RCODEZERO_HTTP_TIMEOUT", "RCODEZERO_POLLING_INTERVAL", "RCODEZERO_PROPAGATION_TIMEOUT", "RCODEZERO_TTL"}
+	case "regfish":
+		return []string{"REGFISH_API_KEY", "REGFISH_HTTP_TIMEOUT", "REGFISH_POLLING_INTERVAL", "REGFISH_PROPAGATION_TIMEOUT", "REGFISH_TTL"}
+	case "regru":
+		return []string{"REGRU_HTTP_TIMEOUT", "REGRU_PASSWORD", "REGRU_POLLING_INTERVAL", "REGRU_PROPAGATION_TIMEOUT", "REGRU_TLS_CERT", "REGRU_TLS_KEY", "REGRU_TTL", "REGRU_USERNAME"}
+	case "rfc2136":
+		return []string{"RFC2136_DNS_TIMEOUT", "RFC2136_NAMESERVER", "RFC2136_POLLING_INTERVAL", "RFC2136_PROPAGATION_TIMEOUT", "RFC2136_SEQUENCE_INTERVAL", "RFC2136_SIG0_FILE", "RFC2136_TSIG_ALGORITHM", "RFC2136_TSIG_FILE", "RFC2136_TSIG_KEY", "RFC2136_TSIG_SECRET", "RFC2136_TTL"}
+	case "rimuhosting":
+		return []string{"RIMUHOSTING_API_KEY", "RIMUHOSTING_HTTP_TIMEOUT", "RIMUHOSTING_POLLING_INTERVAL", "RIMUHOSTING_PROPAGATION_TIMEOUT", "RIMUHOSTING_TTL"}
+	case "route53":
+		return []string{"AWS_ACCESS_KEY_ID", "AWS_ASSUME_ROLE_ARN", "AWS_EXTERNAL_ID", "AWS_HOSTED_ZONE_ID", "AWS_MAX_RETRIES", "AWS_POLLING_INTERVAL", "AWS_PROFILE", "AWS_PROPAGATION_TIMEOUT", "AWS_REGION", "AWS_SDK_LOAD_CONFIG", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_SHARED_CREDENTIALS_FILE", "AWS_TTL", "AWS_WAIT_FOR_RECORD_SETS_CHANGED"}
+	case "safedns":
+		return []string{"SAFEDNS_AUTH_TOKEN", "SAFEDNS_HTTP_TIMEOUT", "SAFEDNS_POLLING_INTERVAL", "SAFEDNS_PROPAGATION_TIMEOUT", "SAFEDNS_TTL"}
+	case "sakuracloud":
+		return []string{"SAKURACLOUD_ACCESS_TOKEN", "SAKURACLOUD_ACCESS_TOKEN_SECRET", "SAKURACLOUD_HTTP_TIMEOUT", "SAKURACLOUD_POLLING_INTERVAL", "SAKURACLOUD_PROPAGATION_TIMEOUT", "SAKURACLOUD_TTL"}
+	case "scaleway":
+		return []string{"SCW_ACCESS_KEY", "SCW_POLLING_INTERVAL", "SCW_PROJECT_ID", "SCW_PROPAGATION_TIMEOUT", "SCW_SECRET_KEY", "SCW_TTL"}
+	case "selectel":
+		return []string{"SELECTEL_API_TOKEN", "SELECTEL_BASE_URL", "SELECTEL_HTTP_TIMEOUT", "SELECTEL_POLLING_INTERVAL", "SELECTEL_PROPAGATION_TIMEOUT", "SELECTEL_TTL"}
+	case "selectelv2":
+		return []string{"SELECTELV2_ACCOUNT_ID", "SELECTELV2_BASE_URL", "SELECTELV2_HTTP_TIMEOUT", "SELECTELV2_PASSWORD", "SELECTELV2_POLLING_INTERVAL", "SELECTELV2_PROJECT_ID", "SELECTELV2_PROPAGATION_TIMEOUT", "SELECTELV2_TTL", "SELECTELV2_USERNAME"}
+	case "selfhostde":
+		return []string{"SELFHOSTDE_HTTP_TIMEOUT", "SELFHOSTDE_PASSWORD", "SELFHOSTDE_POLLING_INTERVAL", "SELFHOSTDE_PROPAGATION_TIMEOUT", "SELFHOSTDE_RECORDS_MAPPING", "SELFHOSTDE_TTL", "SELFHOSTDE_USERNAME"}
+	case "servercow":
+		return []string{"SERVERCOW_HTTP_TIMEOUT", "SERVERCOW_PASSWORD", "SERVERCOW_POLLING_INTERVAL", "SERVERCOW_PROPAGATION_TIMEOUT", "SERVERCOW_TTL", "SERVERCOW_USERNAME"}
+	case "shellrent":
+		return []string{"SHELLRENT_HTTP_TIMEOUT", "SHELLRENT_POLLING_INTERVAL", "SHELLRENT_PROPAGATION_TIMEOUT", "SHELLRENT_TOKEN", "SHELLRENT_TTL", "SHELLRENT_USERNAME"}
+	case "simply":
+		return []string{"SIMPLY_ACCOUNT_NAME", "SIMPLY_API_KEY", "SIMPLY_HTTP_TIMEOUT", "SIMPLY_POLLING_INTERVAL", "SIMPLY_PROPAGATION_TIMEOUT", "SIMPLY_TTL"}
+	case "sonic":
+		return []string{"SONIC_API_KEY", "SONIC_HTTP_TIMEOUT", "SONIC_POLLING_INTERVAL", "SONIC_PROPAGATION_TIMEOUT", "SONIC_SEQUENCE_INTERVAL", "SONIC_TTL", "SONIC_USER_ID"}
+	case "stackpath":
+		return []string{"STACKPATH_CLIENT_ID", "STACKPATH_CLIENT_SECRET", "STACKPATH_POLLING_INTERVAL", "STACKPATH_PROPAGATION_TIMEOUT", "STACKPATH_STACK_ID", "STACKPATH_TTL"}
+	case "standalone":
+		return []string{"STANDALONE_ADDRESS", "STANDALONE_MBOX", "STANDALONE_NAMESERVERS", "STANDALONE_POLLING_INTERVAL", "STANDALONE_PROPAGATION_TIMEOUT", "STANDALONE_TTL", "STANDALONE_ZONE"}
+	case "technitium":
+		return []string{"TECHNITIUM_API_TOKEN", "TECHNITIUM_HTTP_TIMEOUT", "TECHNITIUM_POLLING_INTERVAL", "TECHNITIUM_PROPAGATION_TIMEOUT", "TECHNITIUM_SERVER_BASE_URL", "TECHNITIUM_TTL"}
+	case "tencentcloud":
+		return []string{"TENCENTCLOUD_HTTP_TIMEOUT", "TENCENTCLOUD_POLLING_INTERVAL", "TENCENTCLOUD_PROPAGATION_TIMEOUT", "TENCENTCLOUD_REGION", "TENCENTCLOUD_SECRET_ID", "TENCENTCLOUD_SECRET_KEY", "TENCENTCLOUD_SESSION_TOKEN", "TENCENTCLOUD_TTL"}
+	case "timewebcloud":
+		return []string{"TIMEWEBCLOUD_AUTH_TOKEN", "TIMEWEBCLOUD_HTTP_TIMEOUT", "TIMEWEBCLOUD_POLLING_INTERVAL", "TIMEWEBCLOUD_PROPAGATION_TIMEOUT"}
+	case "transip":
+		return []string{"TRANSIP_ACCOUNT_NAME", "TRANSIP_POLLING_INTERVAL", "TRANSIP_PRIVATE_KEY_PATH", "TRANSIP_PROPAGATION_TIMEOUT", "TRANSIP_TTL"}
+	case "ultradns":
+		return []string{"ULTRADNS_ENDPOINT", "ULTRADNS_PASSWORD", "ULTRADNS_POLLING_INTERVAL", "ULTRADNS_PROPAGATION_TIMEOUT", "ULTRADNS_TTL", "ULTRADNS_USERNAME"}
+	case "variomedia":
+		return []string{"VARIOMEDIA_API_TOKEN", "VARIOMEDIA_HTTP_TIMEOUT", "VARIOMEDIA_POLLING_INTERVAL", "VARIOMEDIA_PROPAGATION_TIMEOUT", "VARIOMEDIA_SEQUENCE_INTERVAL", "VARIOMEDIA_TTL"}
+	case "vegadns":
+		return []string{"SECRET_VEGADNS_KEY", "SECRET_VEGADNS_SECRET", "VEGADNS_POLLING_INTERVAL", "VEGADNS_PROPAGATION_TIMEOUT", "VEGADNS_TTL", "VEGADNS_URL"}
+	case "vercel":
+		return []string{"VERCEL_API_TOKEN", "VERCEL_HTTP_TIMEOUT", "VERCEL_POLLING_INTERVAL", "VERCEL_PROPAGATION_TIMEOUT", "VERCEL_TEAM_ID", "VERCEL_TTL"}
+	case "versio":
+		return []string{"VERSIO_ENDPOINT", "VERSIO_HTTP_TIMEOUT", "VERSIO_PASSWORD", "VERSIO_POLLING_INTERVAL", "VERSIO_PROPAGATION_TIMEOUT", "VERSIO_SEQUENCE_INTERVAL", "VERSIO_TTL", "VERSIO_USERNAME"}
+	case "vinyldns":
+		return []string{"VINYLDNS_ACCESS_KEY", "VINYLDNS_HOST", "VINYLDNS_POLLING_INTERVAL", "VINYLDNS_PROPAGATION_TIMEOUT", "VINYLDNS_SECRET_KEY", "VINYLDNS_TTL"}
+	case "vkcloud":
+		return []string{"VK_CLOUD_DNS_ENDPOINT", "VK_CLOUD_DOMAIN_NAME", "VK_CLOUD_IDENTITY_ENDPOINT", "VK_CLOUD_PASSWORD", "VK_CLOUD_POLLING_INTERVAL", "VK_CLOUD_PROJECT_ID", "VK_CLOUD_PROPAGATION_TIMEOUT", "VK_CLOUD_TTL", "VK_CLOUD_USERNAME"}
+	case "volcengine":
+		return []string{"VOLC_ACCESSKEY", "VOLC_HOST", "VOLC_HTTP_TIMEOUT", "VOLC_POLLING_INTERVAL", "VOLC_PROPAGATION_TIMEOUT", "VOLC_REGION", "VOLC_SCHEME", "VOLC_SECRETKEY", "VOLC_TTL"}
+	case "vscale":
+		return []string{"VSCALE_API_TOKEN", "VSCALE_BASE_URL", "VSCALE_HTTP_TIMEOUT", "VSCALE_POLLING_INTERVAL", "VSCALE_PROPAGATION_TIMEOUT", "VSCALE_TTL"}
+	case "vultr":
+		return []string{"VULTR_API_KEY", "VULTR_HTTP_TIMEOUT", "VULTR_POLLING_INTERVAL", "VULTR_PROPAGATION_TIMEOUT", "VULTR_TTL"}
+	case "webnames":
+		return []string{"WEBNAMES_API_KEY", "WEBNAMES_HTTP_TIMEOUT", "WEBNAMES_POLLING_INTERVAL", "WEBNAMES_PROPAGATION_TIMEOUT"}
+	case "websupport":
+		return []string{"WEBSUPPORT_API_KEY", "WEBSUPPORT_HTTP_TIMEOUT", "WEBSUPPORT_POLLING_INTERVAL", "WEBSUPPORT_PROPAGATION_TIMEOUT", "WEBSUPPORT_SECRET", "WEBSUPPORT_SEQUENCE_INTERVAL", "WEBSUPPORT_TTL"}
+	case "wedos":
+		return []string{"WEDOS_HTTP_TIMEOUT", "WEDOS_POLLING_INTERVAL", "WEDOS_PROPAGATION_TIMEOUT", "WEDOS_TTL", "WEDOS_USERNAME", "WEDOS_WAPI_PASSWORD"}
+	case "westcn":
+		return []string{"WESTCN_HTTP_TIMEOUT", "WESTCN_PASSWORD", "WESTCN_POLLING_INTERVAL", "WESTCN_PROPAGATION_TIMEOUT", "WESTCN_TTL", "WESTCN_USERNAME"}
+	case "yandex":
+		return []string{"YANDEX_HTTP_TIMEOUT", "YANDEX_PDD_TOKEN", "YANDEX_POLLING_INTERVAL", "YANDEX_PROPAGATION_TIMEOUT", "YANDEX_TTL"}
+	case "yandex360":
+		return []string{"YANDEX360_HTTP_TIMEOUT", "YANDEX360_OAUTH_TOKEN", "YANDEX360_ORG_ID", "YANDEX360_POLLING_INTERVAL", "YANDEX360_PROPAGATION_TIMEOUT", "YANDEX360_TTL"}
+	case "yandexcloud":
+		return []string{"YANDEX_CLOUD_FOLDER_ID", "YANDEX_CLOUD_IAM_TOKEN", "YANDEX_CLOUD_POLLING_INTERVAL", "YANDEX_CLOUD_PROPAGATION_TIMEOUT", "YANDEX_CLOUD_TTL"}
+	case "zoneee":
+		return []string{"ZONEEE_API_KEY", "ZONEEE_API_USER", "ZONEEE_ENDPOINT", "ZONEEE_HTTP_TIMEOUT", "ZONEEE_POLLING_INTERVAL", "ZONEEE_PROPAGATION_TIMEOUT"}
+	case "zonomi":
+		return []string{"ZONOMI_API_KEY", "ZONOMI_HTTP_TIMEOUT", "ZONOMI_POLLING_INTERVAL", "ZONOMI_PROPAGATION_TIMEOUT", "ZONOMI_TTL"}
+	default:
+		return nil
+	}
+}
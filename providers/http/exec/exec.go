@@ -0,0 +1,69 @@
+// Package exec implements an HTTP provider for solving the HTTP-01 challenge using an external program.
+//
+// The program uses the JSON protocol of the exec DNS provider:
+// it receives the request on its standard input, and writes the response on its standard output.
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/providers/internal/execprotocol"
+)
+
+// HTTPProvider implements ChallengeProvider for `http-01` challenge.
+type HTTPProvider struct {
+	program string
+	timeout time.Duration
+}
+
+// NewHTTPProvider returns a HTTPProvider instance running the given program.
+// The timeout is the time allowed to each call of the program (0: no limit).
+func NewHTTPProvider(program string, timeout time.Duration) (*HTTPProvider, error) {
+	if program == "" {
+		return nil, errors.New("exec: the program is not defined")
+	}
+
+	return &HTTPProvider{program: program, timeout: timeout}, nil
+}
+
+// Present asks the program to make the key authorization available at `HTTP01ChallengePath(token)`.
+func (h *HTTPProvider) Present(domain, token, keyAuth string) error {
+	err := h.run(execprotocol.ActionPresent, domain, token, keyAuth)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp asks the program to remove the key authorization of the challenge.
+func (h *HTTPProvider) CleanUp(domain, token, keyAuth string) error {
+	err := h.run(execprotocol.ActionCleanUp, domain, token, keyAuth)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (h *HTTPProvider) run(action, domain, token, keyAuth string) error {
+	request := execprotocol.Request{
+		Challenge: string(challenge.HTTP01),
+		Action:    action,
+		Records: []execprotocol.Record{{
+			Domain:  domain,
+			Token:   token,
+			KeyAuth: keyAuth,
+			Path:    http01.ChallengePath(token),
+		}},
+	}
+
+	_, err := execprotocol.Run(context.Background(), h.program, h.timeout, request)
+
+	return err
+}
@@ -0,0 +1,76 @@
+package exec
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/internal/execprotocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPProvider(t *testing.T) {
+	_, err := NewHTTPProvider("", 0)
+	require.EqualError(t, err, "exec: the program is not defined")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	dir := t.TempDir()
+
+	requestFile := filepath.Join(dir, "request.json")
+	program := filepath.Join(dir, "program.sh")
+
+	err := os.WriteFile(program, []byte("#!/bin/sh\ncat > "+requestFile+"\n"), 0o700)
+	require.NoError(t, err)
+
+	provider, err := NewHTTPProvider(program, 10*time.Second)
+	require.NoError(t, err)
+
+	for _, action := range []string{"present", "cleanup"} {
+		if action == "present" {
+			err = provider.Present("example.com", "token", "keyAuth")
+		} else {
+			err = provider.CleanUp("example.com", "token", "keyAuth")
+		}
+		require.NoError(t, err)
+
+		raw, err := os.ReadFile(requestFile)
+		require.NoError(t, err)
+
+		var request execprotocol.Request
+
+		err = json.Unmarshal(raw, &request)
+		require.NoError(t, err)
+
+		expected := execprotocol.Request{
+			Version:   execprotocol.Version,
+			Challenge: "http-01",
+			Action:    action,
+			Timeout:   10,
+			Records: []execprotocol.Record{{
+				Domain:  "example.com",
+				Token:   "token",
+				KeyAuth: "keyAuth",
+				Path:    "/.well-known/acme-challenge/token",
+			}},
+		}
+
+		assert.Equal(t, expected, request)
+	}
+}
+
+func TestHTTPProvider_error(t *testing.T) {
+	program := filepath.Join(t.TempDir(), "program.sh")
+
+	err := os.WriteFile(program, []byte("#!/bin/sh\necho '{\"error\":{\"message\":\"permission denied\"}}'\nexit 1\n"), 0o700)
+	require.NoError(t, err)
+
+	provider, err := NewHTTPProvider(program, 0)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "exec: permission denied")
+}
@@ -28,6 +28,30 @@ func NewMemcachedProvider(hosts []string) (*HTTPProvider, error) {
 	return c, nil
 }
 
+// Check verifies that at least one of the memcached hosts is reachable.
+func (w *HTTPProvider) Check(_ string) error {
+	var errs []error
+
+	for _, host := range w.hosts {
+		mc, err := memcache.New(host)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		_, err = mc.Get(path.Join("/", http01.ChallengePath("lego-check")))
+		if err != nil && !errors.Is(err, memcache.ErrCacheMiss) {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == len(w.hosts) {
+		return fmt.Errorf("unable to reach any of the memcache hosts: %v", errs)
+	}
+
+	return nil
+}
+
 // Present makes the token available at `HTTP01ChallengePath(token)` by creating a file in the given webroot path.
 func (w *HTTPProvider) Present(domain, token, keyAuth string) error {
 	var errs []error
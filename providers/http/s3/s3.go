@@ -42,6 +42,16 @@ func NewHTTPProvider(bucket string) (*HTTPProvider, error) {
 	}, nil
 }
 
+// Check verifies the credentials and the access to the s3 bucket.
+func (s *HTTPProvider) Check(_ string) error {
+	_, err := s.client.HeadBucket(context.Background(), &s3.HeadBucketInput{Bucket: aws.String(s.bucket)})
+	if err != nil {
+		return fmt.Errorf("s3: unable to access the bucket %s: %w", s.bucket, err)
+	}
+
+	return nil
+}
+
 // Present makes the token available at `HTTP01ChallengePath(token)` by creating a file in the given s3 bucket.
 func (s *HTTPProvider) Present(domain, token, keyAuth string) error {
 	ctx := context.Background()
@@ -4,6 +4,7 @@ package webroot
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 
@@ -24,6 +25,59 @@ func NewHTTPProvider(path string) (*HTTPProvider, error) {
 	return &HTTPProvider{path: path}, nil
 }
 
+// Check verifies that a file can be written in the challenge directory of the webroot path.
+// The directories created by the check are removed.
+func (w *HTTPProvider) Check(_ string) error {
+	challengeDir := filepath.Join(w.path, http01.ChallengePath(""))
+
+	created, err := missingDirs(challengeDir)
+	if err != nil {
+		return fmt.Errorf("could not check the webroot for HTTP challenge: %w", err)
+	}
+
+	defer func() {
+		for _, dir := range created {
+			_ = os.Remove(dir)
+		}
+	}()
+
+	err = os.MkdirAll(challengeDir, 0o755)
+	if err != nil {
+		return fmt.Errorf("could not create required directories in webroot for HTTP challenge: %w", err)
+	}
+
+	file, err := os.CreateTemp(challengeDir, "lego-check-")
+	if err != nil {
+		return fmt.Errorf("could not write file in webroot for HTTP challenge: %w", err)
+	}
+
+	_ = file.Close()
+
+	return os.Remove(file.Name())
+}
+
+// missingDirs returns the directories of the path that don't exist, from the deepest.
+func missingDirs(path string) ([]string, error) {
+	var dirs []string
+
+	for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+		_, err := os.Stat(dir)
+		if err == nil {
+			return dirs, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		dirs = append(dirs, dir)
+
+		if filepath.Dir(dir) == dir {
+			return dirs, nil
+		}
+	}
+}
+
 // Present makes the token available at `HTTP01ChallengePath(token)` by creating a file in the given webroot path.
 func (w *HTTPProvider) Present(domain, token, keyAuth string) error {
 	var err error
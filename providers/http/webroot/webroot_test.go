@@ -2,6 +2,7 @@ package webroot
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,3 +39,38 @@ func TestHTTPProvider(t *testing.T) {
 	err = provider.CleanUp(domain, token, keyAuth)
 	require.NoError(t, err)
 }
+
+func TestHTTPProvider_Check(t *testing.T) {
+	webroot := t.TempDir()
+
+	provider, err := NewHTTPProvider(webroot)
+	require.NoError(t, err)
+
+	err = provider.Check("domain")
+	require.NoError(t, err)
+
+	// The directories created by the check are removed.
+	entries, err := os.ReadDir(webroot)
+	require.NoError(t, err)
+
+	assert.Empty(t, entries)
+}
+
+func TestHTTPProvider_Check_existingDirectories(t *testing.T) {
+	webroot := t.TempDir()
+
+	err := os.Mkdir(filepath.Join(webroot, ".well-known"), 0o755)
+	require.NoError(t, err)
+
+	provider, err := NewHTTPProvider(webroot)
+	require.NoError(t, err)
+
+	err = provider.Check("domain")
+	require.NoError(t, err)
+
+	// The existing directories are kept.
+	entries, err := os.ReadDir(filepath.Join(webroot, ".well-known"))
+	require.NoError(t, err)
+
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,187 @@
+// Package execprotocol implements the JSON protocol between lego and an external program solving challenges.
+//
+// The program receives a Request as JSON on its standard input,
+// and writes a Response as JSON on its standard output (an empty output is a success).
+// The standard error of the program is logged.
+package execprotocol
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/log"
+)
+
+// Version is the version of the protocol.
+const Version = 1
+
+// waitDelay is the time to wait for the outputs to be closed after the program is killed.
+const waitDelay = time.Second
+
+// Actions.
+const (
+	ActionPresent = "present"
+	ActionCleanUp = "cleanup"
+)
+
+// Request is the message written on the standard input of the program.
+type Request struct {
+	// Version is the version of the protocol.
+	Version int `json:"version"`
+	// Challenge is the type of the challenge (i.e. "dns-01").
+	Challenge string `json:"challenge"`
+	// Action is the action to perform: "present" or "cleanup".
+	Action string `json:"action"`
+	// Timeout is the time allowed to the program, in seconds (0: no limit).
+	Timeout int `json:"timeout,omitempty"`
+	// Records are the challenges to present or to clean up.
+	Records []Record `json:"records"`
+}
+
+// Record is a challenge to present or to clean up.
+type Record struct {
+	Domain  string `json:"domain"`
+	Token   string `json:"token"`
+	KeyAuth string `json:"keyAuth"`
+
+	// DNS-01.
+	FQDN  string `json:"fqdn,omitempty"`
+	Zone  string `json:"zone,omitempty"`
+	Value string `json:"value,omitempty"`
+	TTL   int    `json:"ttl,omitempty"`
+
+	// HTTP-01.
+	Path string `json:"path,omitempty"`
+}
+
+// Response is the message read from the standard output of the program.
+type Response struct {
+	// Version is the version of the protocol (optional).
+	Version int `json:"version,omitempty"`
+	// Error is the error of the program, if any.
+	Error *Error `json:"error,omitempty"`
+	// Propagation contains the propagation hints of the program (DNS-01).
+	Propagation *Propagation `json:"propagation,omitempty"`
+}
+
+// Error is an error returned by the program.
+type Error struct {
+	// Code is an optional identifier of the error (i.e. "unauthorized").
+	Code string `json:"code,omitempty"`
+	// Message describes the error.
+	Message string `json:"message"`
+	// Domain is the domain of the record in error, if any.
+	Domain string `json:"domain,omitempty"`
+}
+
+func (e *Error) Error() string {
+	var parts []string
+
+	if e.Domain != "" {
+		parts = append(parts, e.Domain)
+	}
+
+	if e.Code != "" {
+		parts = append(parts, e.Code)
+	}
+
+	return strings.Join(append(parts, e.Message), ": ")
+}
+
+// Propagation contains the propagation hints of the program, in seconds.
+type Propagation struct {
+	// Timeout is the maximum time to wait for the propagation of the records.
+	Timeout int `json:"timeout,omitempty"`
+	// Interval is the time between the propagation checks.
+	Interval int `json:"interval,omitempty"`
+}
+
+// Run runs the program with the request, and returns its response.
+// The program is killed when the timeout is reached (0: no limit).
+func Run(ctx context.Context, program string, timeout time.Duration, request Request) (*Response, error) {
+	request.Version = Version
+	// Rounded up: a sub-second timeout must not be sent as 0 (no limit).
+	request.Timeout = int(math.Ceil(timeout.Seconds()))
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	cmd := exec.CommandContext(ctx, program)
+	cmd.Stdin = bytes.NewReader(input)
+
+	stdout := new(bytes.Buffer)
+	cmd.Stdout = stdout
+
+	stderr := new(bytes.Buffer)
+	cmd.Stderr = stderr
+
+	// The children of the program can keep the outputs open after the program is killed.
+	cmd.WaitDelay = waitDelay
+
+	errWait := cmd.Run()
+
+	scanner := bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		log.Println(scanner.Text())
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("command timeout after %s", timeout)
+	}
+
+	response, err := readResponse(stdout)
+	if err != nil {
+		return nil, errors.Join(err, errWait)
+	}
+
+	if response.Error != nil {
+		return nil, response.Error
+	}
+
+	if errWait != nil {
+		return nil, fmt.Errorf("run command: %w", errWait)
+	}
+
+	return response, nil
+}
+
+func readResponse(r io.Reader) (*Response, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	response := &Response{}
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return response, nil
+	}
+
+	err = json.Unmarshal(raw, response)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w: %s", err, string(raw))
+	}
+
+	if response.Version > Version {
+		return nil, fmt.Errorf("unsupported protocol version: %d", response.Version)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,146 @@
+package execprotocol
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeScript writes an executable shell script in a temporary directory.
+// The request is stored in the file "request.json" of the same directory.
+func writeScript(t *testing.T, body string) (program, requestFile string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	requestFile = filepath.Join(dir, "request.json")
+	program = filepath.Join(dir, "program.sh")
+
+	script := "#!/bin/sh\ncat > " + requestFile + "\n" + body + "\n"
+
+	err := os.WriteFile(program, []byte(script), 0o700)
+	require.NoError(t, err)
+
+	return program, requestFile
+}
+
+func readRequest(t *testing.T, filename string) Request {
+	t.Helper()
+
+	raw, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	var request Request
+
+	err = json.Unmarshal(raw, &request)
+	require.NoError(t, err)
+
+	return request
+}
+
+func TestRun(t *testing.T) {
+	program, requestFile := writeScript(t, `echo '{"version":1,"propagation":{"timeout":30,"interval":5}}'`)
+
+	request := Request{
+		Challenge: "dns-01",
+		Action:    ActionPresent,
+		Records: []Record{
+			{Domain: "example.com", Token: "token1", KeyAuth: "keyAuth1", FQDN: "_acme-challenge.example.com.", Value: "value1", TTL: 120},
+			{Domain: "example.org", Token: "token2", KeyAuth: "keyAuth2", FQDN: "_acme-challenge.example.org.", Value: "value2", TTL: 120},
+		},
+	}
+
+	response, err := Run(context.Background(), program, 10*time.Second, request)
+	require.NoError(t, err)
+
+	expected := &Response{Version: 1, Propagation: &Propagation{Timeout: 30, Interval: 5}}
+	assert.Equal(t, expected, response)
+
+	request.Version = Version
+	request.Timeout = 10
+	assert.Equal(t, request, readRequest(t, requestFile))
+}
+
+func TestRun_timeout(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		timeout  time.Duration
+		expected int
+	}{
+		{desc: "no limit", timeout: 0, expected: 0},
+		{desc: "sub-second", timeout: 500 * time.Millisecond, expected: 1},
+		{desc: "rounded up", timeout: 2500 * time.Millisecond, expected: 3},
+		{desc: "seconds", timeout: 10 * time.Second, expected: 10},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			program, requestFile := writeScript(t, "")
+
+			_, err := Run(context.Background(), program, test.timeout, Request{Action: ActionPresent})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, readRequest(t, requestFile).Timeout)
+		})
+	}
+}
+
+func TestRun_emptyResponse(t *testing.T) {
+	program, _ := writeScript(t, "")
+
+	response, err := Run(context.Background(), program, 0, Request{Action: ActionCleanUp})
+	require.NoError(t, err)
+
+	assert.Equal(t, &Response{}, response)
+}
+
+func TestRun_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		body     string
+		timeout  time.Duration
+		expected string
+	}{
+		{
+			desc:     "error response",
+			body:     `echo '{"version":1,"error":{"code":"unauthorized","message":"invalid token","domain":"example.com"}}'; exit 1`,
+			expected: "example.com: unauthorized: invalid token",
+		},
+		{
+			desc:     "exit code",
+			body:     "exit 3",
+			expected: "run command: exit status 3",
+		},
+		{
+			desc:     "invalid response",
+			body:     "echo 'not JSON'",
+			expected: "unmarshal response: invalid character 'o' in literal null (expecting 'u'): not JSON\n",
+		},
+		{
+			desc:     "unsupported version",
+			body:     `echo '{"version":2}'`,
+			expected: "unsupported protocol version: 2",
+		},
+		{
+			desc:     "timeout",
+			body:     "sleep 5",
+			timeout:  100 * time.Millisecond,
+			expected: "command timeout after 100ms",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			program, _ := writeScript(t, test.body)
+
+			_, err := Run(context.Background(), program, test.timeout, Request{Action: ActionPresent})
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}